@@ -48,6 +48,7 @@ val services = mapOf(
     "codecommit" to ServiceSpec("CodeCommit"),
     "codeguruprofiler" to ServiceSpec("CodeGuru Profiler"),
     "codegurureviewer" to ServiceSpec("CodeGuru Reviewer"),
+    "codegurusecurity" to ServiceSpec("CodeGuru Security"),
     "codepipeline" to ServiceSpec("CodePipeline"),
     "codestarconnections" to ServiceSpec("CodeStar Connections"),
     "codestarnotifications" to ServiceSpec("CodeStar Notifications"),
@@ -92,6 +93,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -119,6 +121,7 @@ val services = mapOf(
     "iotevents" to ServiceSpec("IoT Events"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
+    "ivsrealtime" to ServiceSpec("IVS (Interactive Video) Real-Time"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
     "kafkaconnect" to ServiceSpec("Managed Streaming for Kafka Connect"),
     "keyspaces" to ServiceSpec("Keyspaces (for Apache Cassandra)"),
@@ -213,6 +216,7 @@ val services = mapOf(
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
@@ -9,6 +9,7 @@ require (
 	github.com/aws/aws-sdk-go v1.51.1
 	github.com/aws/aws-sdk-go-v2 v1.25.3
 	github.com/aws/aws-sdk-go-v2/config v1.27.7
+	github.com/aws/aws-sdk-go-v2/credentials v1.17.7
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.15.3
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.16.11
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.28.3
@@ -44,6 +45,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/codedeploy v1.25.2
 	github.com/aws/aws-sdk-go-v2/service/codeguruprofiler v1.20.2
 	github.com/aws/aws-sdk-go-v2/service/codegurureviewer v1.25.2
+	github.com/aws/aws-sdk-go-v2/service/codegurusecurity v1.0.1
 	github.com/aws/aws-sdk-go-v2/service/codepipeline v1.26.2
 	github.com/aws/aws-sdk-go-v2/service/codestarconnections v1.25.0
 	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.22.2
@@ -68,6 +70,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.30.3
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.2
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.17.3
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.8.0
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.2
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.22.2
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.28.2
@@ -79,6 +82,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.24.2
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.12.2
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.12.2
+	github.com/aws/aws-sdk-go-v2/service/ivsrealtime v1.8.0
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.31.0
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.49.2
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.10.2
@@ -140,6 +144,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sts v1.28.4
 	github.com/aws/aws-sdk-go-v2/service/swf v1.22.2
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.24.2
+	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.3
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.36.2
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.44.0
@@ -198,7 +203,6 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.1 // indirect
-	github.com/aws/aws-sdk-go-v2/credentials v1.17.7 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
@@ -62,6 +62,7 @@ variable "service_labels" {
     "codecommit",
     "codeguruprofiler",
     "codegurureviewer",
+    "codegurusecurity",
     "codepipeline",
     "codestar",
     "codestarconnections",
@@ -120,6 +121,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -167,6 +169,7 @@ variable "service_labels" {
     "ipam",
     "ivs",
     "ivschat",
+    "ivsrealtime",
     "kafka",
     "kafkaconnect",
     "kendra",
@@ -315,6 +318,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
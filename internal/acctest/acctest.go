@@ -32,6 +32,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -968,6 +969,58 @@ func PreCheckRegionNot(t *testing.T, regions ...string) {
 	}
 }
 
+// regionServiceAvailability caches PreCheckRegionHasService results, keyed by "region/service", for the duration of a test run.
+var regionServiceAvailability sync.Map
+
+// PreCheckRegionHasService checks that the test region supports the specified service.
+// Availability is read from the AWS global infrastructure public parameters in AWS Systems Manager Parameter Store,
+// so newly supported regions are picked up without changes to the tests.
+// The service name is the one used by the public parameters, e.g. "devicefarm".
+func PreCheckRegionHasService(ctx context.Context, t *testing.T, service string) {
+	t.Helper()
+
+	region := Region()
+	key := region + "/" + service
+
+	v, ok := regionServiceAvailability.Load(key)
+
+	if !ok {
+		available, err := regionHasService(ctx, Provider.Meta().(*conns.AWSClient).SSMConn(ctx), region, service)
+
+		if PreCheckSkipError(err) {
+			t.Skipf("skipping tests; probing %s (%s) for %s service: %s", envvar.DefaultRegion, region, service, err)
+		}
+
+		if err != nil {
+			t.Fatalf("probing %s (%s) for %s service: %s", envvar.DefaultRegion, region, service, err)
+		}
+
+		v, _ = regionServiceAvailability.LoadOrStore(key, available)
+	}
+
+	if !v.(bool) {
+		t.Skipf("skipping tests; %s (%s) does not support %s service", envvar.DefaultRegion, region, service)
+	}
+}
+
+func regionHasService(ctx context.Context, conn *ssm.SSM, region, service string) (bool, error) {
+	input := &ssm.GetParameterInput{
+		Name: aws.String(fmt.Sprintf("/aws/service/global-infrastructure/regions/%s/services/%s", region, service)),
+	}
+
+	_, err := conn.GetParameterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // PreCheckAlternateRegionIs checks that the alternate test region is the specified AWS Region.
 func PreCheckAlternateRegionIs(t *testing.T, region string) {
 	t.Helper()
@@ -23,6 +23,7 @@ import (
 const (
 	PEMBlockTypeCertificate        = `CERTIFICATE`
 	PEMBlockTypeCertificateRequest = `CERTIFICATE REQUEST`
+	PEMBlockTypeCRL                = `X509 CRL`
 	PEMBlockTypeECPrivateKey       = `EC PRIVATE KEY`
 	PEMBlockTypeRSAPrivateKey      = `RSA PRIVATE KEY`
 	PEMBlockTypePublicKey          = `PUBLIC KEY`
@@ -343,6 +344,69 @@ func TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t *testing.
 	return string(pem.EncodeToMemory(certificateBlock))
 }
 
+// TLSRSAX509CertificateRevocationListPEM generates a x509 certificate revocation list PEM string
+// signed by the specified CA, revoking a single random serial number.
+// The CA certificate must have the CRL signing key usage, e.g. from
+// TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM().
+// Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
+// configurations such as: crl_data = "%[1]s"
+func TLSRSAX509CertificateRevocationListPEM(t *testing.T, caKeyPem, caCertificatePem string) string {
+	t.Helper()
+
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, tlsX509CertificateSerialNumberLimit)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	revokedSerialNumber, err := rand.Int(rand.Reader, tlsX509CertificateSerialNumberLimit)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.RevocationList{
+		NextUpdate: time.Now().Add(24 * time.Hour), //nolint:gomnd
+		Number:     serialNumber,
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{
+				RevocationTime: time.Now(),
+				SerialNumber:   revokedSerialNumber,
+			},
+		},
+		ThisUpdate: time.Now(),
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, template, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlBlock := &pem.Block{
+		Bytes: crlBytes,
+		Type:  PEMBlockTypeCRL,
+	}
+
+	return string(pem.EncodeToMemory(crlBlock))
+}
+
 // TLSRSAX509SelfSignedCertificatePEM generates a x509 certificate PEM string.
 // Wrap with TLSPEMEscapeNewlines() to allow simple fmt.Sprintf()
 // configurations such as: private_key_pem = "%[1]s"
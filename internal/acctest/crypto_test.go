@@ -66,6 +66,18 @@ func TestTLSRSAX509SelfSignedCertificatePEM(t *testing.T) {
 	}
 }
 
+func TestTLSRSAX509CertificateRevocationListPEM(t *testing.T) {
+	t.Parallel()
+
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl := acctest.TLSRSAX509CertificateRevocationListPEM(t, caKey, caCertificate)
+
+	if !strings.Contains(crl, acctest.PEMBlockTypeCRL) {
+		t.Errorf("CRL does not contain X509 CRL: %s", crl)
+	}
+}
+
 func TestTLSRSAX509CertificateRequestPEM(t *testing.T) {
 	t.Parallel()
 
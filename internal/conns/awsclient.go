@@ -23,18 +23,19 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-	Session           *session_sdkv1.Session
-	TerraformVersion  string
+	AccountID          string
+	DefaultTagsConfig  *tftags.DefaultConfig
+	IgnoreTagsConfig   *tftags.IgnoreConfig
+	Partition          string
+	Region             string
+	RequiredTagsConfig *tftags.RequiredConfig
+	ServicePackages    map[string]ServicePackage
+	Session            *session_sdkv1.Session
+	TerraformVersion   string
 
 	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
+	clients                   map[string]*lazyClient // Default AWS SDK for Go v2 API clients, keyed by service package name.
+	conns                     map[string]*lazyClient // Default AWS SDK for Go v1 API clients, keyed by service package name.
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -261,24 +262,37 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is constructed once, on first use, and cached.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
-	isDefault := len(extra) == 0
 	// Default service client is cached.
-	if isDefault {
-		c.lock.Lock()
-		defer c.lock.Unlock() // Runs at function exit, NOT block.
-
-		if raw, ok := c.conns[servicePackageName]; ok {
-			if conn, ok := raw.(T); ok {
-				return conn, nil
-			} else {
+	if len(extra) == 0 {
+		v := c.lazyClientFor(&c.conns, servicePackageName)
+		v.mu.Lock()
+		defer v.mu.Unlock() // Runs at function exit, NOT block.
+
+		if v.client == nil {
+			conn, err := newConn[T](ctx, c, servicePackageName, nil)
+			if err != nil {
 				var zero T
-				return zero, fmt.Errorf("AWS SDK v1 API client (%s): %T, want %T", servicePackageName, raw, zero)
+				return zero, err
 			}
+
+			v.client = conn
+		}
+
+		if conn, ok := v.client.(T); ok {
+			return conn, nil
+		} else {
+			var zero T
+			return zero, fmt.Errorf("AWS SDK v1 API client (%s): %T, want %T", servicePackageName, v.client, zero)
 		}
 	}
 
+	return newConn[T](ctx, c, servicePackageName, extra)
+}
+
+// newConn constructs a new AWS SDK for Go v1 API client for the specified service.
+func newConn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	sp, ok := c.ServicePackages[servicePackageName]
 	if !ok {
 		var zero T
@@ -311,33 +325,41 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 		}
 	}
 
-	// Default service client is cached.
-	if isDefault {
-		c.conns[servicePackageName] = conn
-	}
-
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is constructed once, on first use, and cached.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
-	isDefault := len(extra) == 0
 	// Default service client is cached.
-	if isDefault {
-		c.lock.Lock()
-		defer c.lock.Unlock() // Runs at function exit, NOT block.
-
-		if raw, ok := c.clients[servicePackageName]; ok {
-			if client, ok := raw.(T); ok {
-				return client, nil
-			} else {
+	if len(extra) == 0 {
+		v := c.lazyClientFor(&c.clients, servicePackageName)
+		v.mu.Lock()
+		defer v.mu.Unlock() // Runs at function exit, NOT block.
+
+		if v.client == nil {
+			client, err := newClient[T](ctx, c, servicePackageName, nil)
+			if err != nil {
 				var zero T
-				return zero, fmt.Errorf("AWS SDK v2 API client (%s): %T, want %T", servicePackageName, raw, zero)
+				return zero, err
 			}
+
+			v.client = client
+		}
+
+		if client, ok := v.client.(T); ok {
+			return client, nil
+		} else {
+			var zero T
+			return zero, fmt.Errorf("AWS SDK v2 API client (%s): %T, want %T", servicePackageName, v.client, zero)
 		}
 	}
 
+	return newClient[T](ctx, c, servicePackageName, extra)
+}
+
+// newClient constructs a new AWS SDK for Go v2 API client for the specified service.
+func newClient[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	sp, ok := c.ServicePackages[servicePackageName]
 	if !ok {
 		var zero T
@@ -362,9 +384,5 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
-	if isDefault {
-		c.clients[servicePackageName] = client
-	}
-
 	return client, nil
 }
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -44,6 +45,7 @@ type Config struct {
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -153,6 +155,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	// Credentials from an external credential_process are cached without an expiry window,
+	// so long-running operations can fail when the cached credentials expire mid-request.
+	// Refresh them ahead of expiry. All AWS API clients share the single cached provider.
+	if creds, err := cfg.Credentials.Retrieve(ctx); err == nil && creds.Source == processcreds.ProviderName {
+		tflog.Debug(ctx, "Enabling proactive refresh of credential_process credentials")
+		cfg.Credentials = newExpiryWindowCredentialsProvider(cfg.Credentials, processCredentialsExpiryWindow)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -202,14 +212,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.SetHTTPClient(ctx, sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]any, 0)
-	client.conns = make(map[string]any, 0)
+	client.clients = make(map[string]*lazyClient, 0)
+	client.conns = make(map[string]*lazyClient, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	// processCredentialsExpiryWindow is how long before expiry credentials sourced
+	// from an external credential_process are refreshed.
+	processCredentialsExpiryWindow = 5 * time.Minute
+)
+
+// invalidator is implemented by credentials providers, such as aws.CredentialsCache,
+// whose cached value can be discarded.
+type invalidator interface {
+	Invalidate()
+}
+
+// newExpiryWindowCredentialsProvider returns a CredentialsProvider that proactively refreshes
+// credentials cached by the specified provider once they are within the expiry window.
+// All AWS API clients share the returned provider, so an external process is invoked only
+// when no cached credentials are valid rather than once per client.
+func newExpiryWindowCredentialsProvider(provider aws.CredentialsProvider, expiryWindow time.Duration) aws.CredentialsProvider {
+	return &expiryWindowCredentialsProvider{
+		provider:     provider,
+		expiryWindow: expiryWindow,
+		now:          time.Now,
+	}
+}
+
+type expiryWindowCredentialsProvider struct {
+	provider     aws.CredentialsProvider
+	expiryWindow time.Duration
+	mu           sync.Mutex
+	now          func() time.Time
+	// refreshed is the expiry time of the most recently refreshed credentials.
+	// Credentials issued with a lifetime shorter than the expiry window are not refreshed again.
+	refreshed time.Time
+}
+
+func (p *expiryWindowCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	creds, err := p.provider.Retrieve(ctx)
+
+	if err != nil || !p.expiresSoon(creds) {
+		return creds, err
+	}
+
+	v, ok := p.provider.(invalidator)
+
+	if !ok {
+		return creds, nil
+	}
+
+	// Serialize refreshes so that concurrent callers do not each invoke the underlying provider.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	creds, err = p.provider.Retrieve(ctx)
+
+	if err != nil || !p.expiresSoon(creds) || creds.Expires.Equal(p.refreshed) {
+		return creds, err
+	}
+
+	v.Invalidate()
+
+	creds, err = p.provider.Retrieve(ctx)
+
+	if err != nil {
+		return creds, err
+	}
+
+	p.refreshed = creds.Expires
+
+	return creds, nil
+}
+
+func (p *expiryWindowCredentialsProvider) expiresSoon(creds aws.Credentials) bool {
+	return creds.CanExpire && !p.now().Add(p.expiryWindow).Before(creds.Expires)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+type countingCredentialsProvider struct {
+	calls   int
+	expires []time.Time
+}
+
+func (p *countingCredentialsProvider) Retrieve(context.Context) (aws.Credentials, error) {
+	expires := p.expires[min(p.calls, len(p.expires)-1)]
+	p.calls++
+
+	return aws.Credentials{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		CanExpire:       true,
+		Expires:         expires,
+	}, nil
+}
+
+func TestExpiryWindowCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	testCases := map[string]struct {
+		expires       []time.Time
+		retrieves     int
+		expectedCalls int
+	}{
+		"not expiring": {
+			expires:       []time.Time{now.Add(time.Hour)},
+			retrieves:     3,
+			expectedCalls: 1,
+		},
+		"expiring refreshed once": {
+			expires:       []time.Time{now.Add(time.Minute), now.Add(time.Hour)},
+			retrieves:     3,
+			expectedCalls: 2,
+		},
+		"short lifetime refreshed once": {
+			expires:       []time.Time{now.Add(time.Minute), now.Add(2 * time.Minute)},
+			retrieves:     3,
+			expectedCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &countingCredentialsProvider{expires: testCase.expires}
+			cache := aws.NewCredentialsCache(inner)
+			provider := newExpiryWindowCredentialsProvider(cache, processCredentialsExpiryWindow).(*expiryWindowCredentialsProvider)
+			provider.now = func() time.Time { return now }
+
+			var creds aws.Credentials
+			for i := 0; i < testCase.retrieves; i++ {
+				var err error
+				creds, err = provider.Retrieve(ctx)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if got, want := creds.Expires, testCase.expires[len(testCase.expires)-1]; !got.Equal(want) {
+				t.Errorf("Expires = %s, want %s", got, want)
+			}
+
+			if got, want := inner.calls, testCase.expectedCalls; got != want {
+				t.Errorf("underlying provider calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
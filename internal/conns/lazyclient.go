@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"sync"
+)
+
+// lazyClient holds a default API client that is constructed on first use.
+// Only a successfully constructed client is cached; a construction error is returned to the caller and construction is retried on next use.
+type lazyClient struct {
+	mu     sync.Mutex
+	client any
+}
+
+// lazyClientFor returns the lazily constructed default API client entry for the specified service.
+// The AWSClient lock is held only while looking up or adding the entry, so that constructing
+// one service's client does not block callers using other services' clients.
+func (c *AWSClient) lazyClientFor(clients *map[string]*lazyClient, servicePackageName string) *lazyClient {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if *clients == nil {
+		*clients = make(map[string]*lazyClient)
+	}
+
+	v, ok := (*clients)[servicePackageName]
+	if !ok {
+		v = &lazyClient{}
+		(*clients)[servicePackageName] = v
+	}
+
+	return v
+}
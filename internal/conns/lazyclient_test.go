@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type testClient struct {
+	endpoint string
+}
+
+type testServicePackage struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (p *testServicePackage) NewClient(_ context.Context, config map[string]any) (*testClient, error) {
+	p.calls.Add(1)
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return &testClient{endpoint: config["endpoint"].(string)}, nil
+}
+
+func TestClientConstructedOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sp := &testServicePackage{}
+	c := &AWSClient{
+		ServicePackages: map[string]ServicePackage{"test": sp},
+		endpoints:       map[string]string{"test": "https://test.example.com"},
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	clients := make([]*testClient, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			clients[i] = noError(t, func() (*testClient, error) { return client[*testClient](ctx, c, "test", nil) })
+		}(i)
+	}
+
+	wg.Wait()
+
+	if got, want := sp.calls.Load(), int32(1); got != want {
+		t.Errorf("NewClient calls = %d, want %d", got, want)
+	}
+
+	for i := 1; i < n; i++ {
+		if clients[i] != clients[0] {
+			t.Errorf("client %d is not the cached default client", i)
+		}
+	}
+
+	// Non-default clients are not cached.
+	extra := noError(t, func() (*testClient, error) {
+		return client[*testClient](ctx, c, "test", map[string]any{"endpoint": "https://other.example.com"})
+	})
+
+	if extra == clients[0] {
+		t.Error("non-default client is the cached default client")
+	}
+
+	if got, want := extra.endpoint, "https://other.example.com"; got != want {
+		t.Errorf("endpoint = %q, want %q", got, want)
+	}
+
+	if got, want := sp.calls.Load(), int32(2); got != want {
+		t.Errorf("NewClient calls = %d, want %d", got, want)
+	}
+}
+
+func TestClientConstructionError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sp := &testServicePackage{err: errors.New("test error")}
+	c := &AWSClient{
+		ServicePackages: map[string]ServicePackage{"test": sp},
+		endpoints:       map[string]string{"test": "https://test.example.com"},
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client[*testClient](ctx, c, "test", nil); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	if got, want := sp.calls.Load(), int32(2); got != want {
+		t.Errorf("NewClient calls = %d, want %d", got, want)
+	}
+
+	// Construction errors are not cached.
+	sp.err = nil
+	first := noError(t, func() (*testClient, error) { return client[*testClient](ctx, c, "test", nil) })
+	second := noError(t, func() (*testClient, error) { return client[*testClient](ctx, c, "test", nil) })
+
+	if first == nil || first != second {
+		t.Error("client constructed after an error is not cached")
+	}
+
+	if got, want := sp.calls.Load(), int32(3); got != want {
+		t.Errorf("NewClient calls = %d, want %d", got, want)
+	}
+
+	if _, err := client[*testClient](ctx, c, "unknown", nil); err == nil {
+		t.Fatal("expected error for unknown service package")
+	}
+}
+
+func noError[T any](t *testing.T, f func() (T, error)) T {
+	t.Helper()
+
+	v, err := f()
+
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	return v
+}
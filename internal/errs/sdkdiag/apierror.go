@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag
+
+import (
+	"fmt"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// apiErrorDetail returns structured details of the last AWS API error in the specified values.
+// Each detail is on its own line as "Key: value" so that it can be parsed by tooling.
+// An empty string is returned if no value is an AWS API error.
+func apiErrorDetail(a ...any) string {
+	for i := len(a) - 1; i >= 0; i-- {
+		if err, ok := a[i].(error); ok && err != nil {
+			return formatAPIErrorDetail(err)
+		}
+	}
+
+	return ""
+}
+
+func formatAPIErrorDetail(err error) string {
+	var (
+		code, message, requestID string
+		statusCode               int
+	)
+
+	// AWS SDK for Go v2.
+	if apiErr, ok := errs.As[smithy.APIError](err); ok {
+		code, message = apiErr.ErrorCode(), apiErr.ErrorMessage()
+	}
+	if respErr, ok := errs.As[*awshttp.ResponseError](err); ok {
+		requestID, statusCode = respErr.ServiceRequestID(), respErr.HTTPStatusCode()
+	}
+
+	// AWS SDK for Go v1.
+	if awsErr, ok := errs.As[awserr.Error](err); ok && code == "" {
+		code, message = awsErr.Code(), awsErr.Message()
+	}
+	if reqErr, ok := errs.As[awserr.RequestFailure](err); ok && requestID == "" {
+		requestID, statusCode = reqErr.RequestID(), reqErr.StatusCode()
+	}
+
+	if code == "" && requestID == "" {
+		return ""
+	}
+
+	var lines []string
+	if code != "" {
+		lines = append(lines, fmt.Sprintf("AWS Error Code: %s", code))
+	}
+	if message != "" {
+		lines = append(lines, fmt.Sprintf("AWS Error Message: %s", message))
+	}
+	if requestID != "" {
+		lines = append(lines, fmt.Sprintf("AWS Request ID: %s", requestID))
+	}
+	if statusCode != 0 {
+		lines = append(lines, fmt.Sprintf("HTTP Status Code: %d", statusCode))
+	}
+
+	return strings.Join(lines, "\n")
+}
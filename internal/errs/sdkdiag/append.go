@@ -17,15 +17,27 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error Diagnostic with a formatted summary.
+// If any of the arguments is an AWS API error, its error code, message and request ID are added as the Diagnostic's detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+		Detail:   apiErrorDetail(a...),
+	})
 }
 
+// AppendFromErr appends an error Diagnostic for the specified error.
+// If the error is an AWS API error, its error code, message and request ID are added as the Diagnostic's detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   apiErrorDetail(err),
+	})
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
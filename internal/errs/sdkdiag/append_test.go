@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	sdkv2Err := &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+			Err:      &smithy.GenericAPIError{Code: "ValidationException", Message: "invalid name"},
+		},
+		RequestID: "11111111-2222-3333-4444-555555555555",
+	}
+	sdkv1Err := awserr.NewRequestFailure(awserr.New("ResourceNotFoundException", "not found", nil), http.StatusNotFound, "66666666-7777-8888-9999-000000000000")
+
+	testCases := []struct {
+		testName    string
+		args        []any
+		wantSummary string
+		wantDetail  string
+	}{
+		{
+			testName:    "no error",
+			args:        []any{"example"},
+			wantSummary: "reading Thing (example): %!s(MISSING)",
+		},
+		{
+			testName:    "non-AWS error",
+			args:        []any{"example", errors.New("test error")},
+			wantSummary: "reading Thing (example): test error",
+		},
+		{
+			testName:    "AWS SDK for Go v2 error",
+			args:        []any{"example", sdkv2Err},
+			wantSummary: fmt.Sprintf("reading Thing (example): %s", sdkv2Err),
+			wantDetail: `AWS Error Code: ValidationException
+AWS Error Message: invalid name
+AWS Request ID: 11111111-2222-3333-4444-555555555555
+HTTP Status Code: 400`,
+		},
+		{
+			testName:    "wrapped AWS SDK for Go v1 error",
+			args:        []any{"example", fmt.Errorf("wrapped: %w", sdkv1Err)},
+			wantSummary: fmt.Sprintf("reading Thing (example): wrapped: %s", sdkv1Err),
+			wantDetail: `AWS Error Code: ResourceNotFoundException
+AWS Error Message: not found
+AWS Request ID: 66666666-7777-8888-9999-000000000000
+HTTP Status Code: 404`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := sdkdiag.AppendErrorf(nil, "reading Thing (%s): %s", testCase.args...)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("len(diags) = %d, want %d", got, want)
+			}
+
+			d := diags[0]
+
+			if got, want := d.Severity, diag.Error; got != want {
+				t.Errorf("Severity = %v, want %v", got, want)
+			}
+
+			if got, want := d.Summary, testCase.wantSummary; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+
+			if got, want := d.Detail, testCase.wantDetail; got != want {
+				t.Errorf("Detail = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAppendFromErr(t *testing.T) {
+	t.Parallel()
+
+	if diags := sdkdiag.AppendFromErr(nil, nil); len(diags) != 0 {
+		t.Fatalf("len(diags) = %d, want 0", len(diags))
+	}
+
+	err := awserr.NewRequestFailure(awserr.New("ThrottlingException", "rate exceeded", nil), http.StatusBadRequest, "request-id")
+	diags := sdkdiag.AppendFromErr(nil, err)
+
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("len(diags) = %d, want %d", got, want)
+	}
+
+	if got, want := diags[0].Summary, err.Error(); got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+
+	want := `AWS Error Code: ThrottlingException
+AWS Error Message: rate exceeded
+AWS Request ID: request-id
+HTTP Status Code: 400`
+	if got := diags[0].Detail; got != want {
+		t.Errorf("Detail = %q, want %q", got, want)
+	}
+}
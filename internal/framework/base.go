@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -89,6 +91,15 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
+
+			if r.tagsChanged(ctx, request, planTags) {
+				if missing := r.Meta().RequiredTagsConfig.Missing(defaultTagsConfig.MergeTags(resourceTags)); len(missing) > 0 {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Missing required tags", fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")))
+
+					return
+				}
+			}
+
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -100,6 +111,21 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	}
 }
 
+// tagsChanged returns whether the resource is planned for creation or its planned tags differ from those in state.
+func (r *ResourceWithConfigure) tagsChanged(ctx context.Context, request resource.ModifyPlanRequest, planTags types.Map) bool {
+	if request.State.Raw.IsNull() {
+		return true
+	}
+
+	var stateTags types.Map
+
+	if diags := request.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags); diags.HasError() {
+		return true
+	}
+
+	return !planTags.Equal(stateTags)
+}
+
 // WithImportByID is intended to be embedded in resources which import state via the "id" attribute.
 // See https://developer.hashicorp.com/terraform/plugin/framework/resources/import.
 type WithImportByID struct{}
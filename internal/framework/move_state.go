@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RenamedResourceStateMover returns a StateMover that moves the state of a resource of one of the specified
+// (previous) resource types from this provider into the target resource, allowing `moved` blocks to migrate
+// state between a renamed resource type and its replacement.
+// The source state must be compatible with the target resource's current schema. Source attributes that are
+// not defined in the target schema are dropped.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/state-move.
+func RenamedResourceStateMover(sourceTypeNames ...string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			// Returning without setting state or diagnostics lets other state movers handle the request.
+			if !slices.Contains(sourceTypeNames, request.SourceTypeName) || !isThisProviderAddress(request.SourceProviderAddress) {
+				return
+			}
+
+			if request.SourceRawState == nil {
+				response.Diagnostics.AddError("moving resource state", fmt.Sprintf("no state for source resource type %q", request.SourceTypeName))
+
+				return
+			}
+
+			opts := tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			}
+			value, err := request.SourceRawState.UnmarshalWithOpts(response.TargetState.Schema.Type().TerraformType(ctx), opts)
+
+			if err != nil {
+				response.Diagnostics.AddError("moving resource state", fmt.Sprintf("unmarshaling %q state: %s", request.SourceTypeName, err))
+
+				return
+			}
+
+			response.TargetState.Raw = value
+		},
+	}
+}
+
+// providerTypeName is the type name of this provider.
+const providerTypeName = "aws"
+
+// isThisProviderAddress returns whether the specified provider address refers to this provider.
+// Only the type name is compared, as the hostname and namespace differ for mirrored, private registry
+// and alternative registry (e.g. registry.opentofu.org) sources.
+func isThisProviderAddress(address string) bool {
+	return address[strings.LastIndex(address, "/")+1:] == providerTypeName
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+func TestRenamedResourceStateMover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	targetSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	targetType := targetSchema.Type().TerraformType(ctx)
+	nullState := tftypes.NewValue(targetType, nil)
+	movedState := tftypes.NewValue(targetType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "example"),
+	})
+	rawState := &tfprotov6.RawState{
+		JSON: []byte(`{"id":"abc123","name":"example","timeouts":null}`),
+	}
+
+	testCases := map[string]struct {
+		request     resource.MoveStateRequest
+		expected    tftypes.Value
+		expectError bool
+	}{
+		"renamed resource": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_old",
+			},
+			expected: movedState,
+		},
+		"other resource": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_other",
+			},
+			expected: nullState,
+		},
+		"other provider": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_old",
+			},
+			expected: nullState,
+		},
+		"other namespace": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/example/aws",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_old",
+			},
+			expected: movedState,
+		},
+		"other registry": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.opentofu.org/hashicorp/aws",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_old",
+			},
+			expected: movedState,
+		},
+		"other provider with same suffix": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/example/notaws",
+				SourceRawState:        rawState,
+				SourceTypeName:        "aws_old",
+			},
+			expected: nullState,
+		},
+		"incompatible state": {
+			request: resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+				SourceRawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":"abc123","name":["example"]}`),
+				},
+				SourceTypeName: "aws_old",
+			},
+			expected:    nullState,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema,
+					Raw:    nullState,
+				},
+			}
+
+			framework.RenamedResourceStateMover("aws_old").StateMover(ctx, testCase.request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if got, want := response.TargetState.Raw, testCase.expected; !got.Equal(want) {
+				t.Errorf("TargetState = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var costMetadataResultAttrTypes = map[string]attr.Type{
+	"count":           types.Int64Type,
+	"instance_type":   types.StringType,
+	"storage_size_gb": types.Int64Type,
+}
+
+// costMetadataAttributes maps a resource type to the names of the resource's
+// attributes that hold its cost-relevant values.
+type costMetadataAttributes struct {
+	count        string
+	instanceType string
+	storageSize  string
+}
+
+var costMetadataResourceTypes = map[string]costMetadataAttributes{
+	"aws_db_instance":                   {instanceType: "instance_class", storageSize: "allocated_storage"},
+	"aws_dms_replication_instance":      {instanceType: "replication_instance_class", storageSize: "allocated_storage"},
+	"aws_docdb_cluster_instance":        {instanceType: "instance_class"},
+	"aws_ebs_volume":                    {storageSize: "size"},
+	"aws_elasticache_cluster":           {instanceType: "node_type", count: "num_cache_nodes"},
+	"aws_elasticache_replication_group": {instanceType: "node_type", count: "num_cache_clusters"},
+	"aws_fsx_lustre_file_system":        {storageSize: "storage_capacity"},
+	"aws_fsx_windows_file_system":       {storageSize: "storage_capacity"},
+	"aws_instance":                      {instanceType: "instance_type"},
+	"aws_launch_template":               {instanceType: "instance_type"},
+	"aws_mq_broker":                     {instanceType: "host_instance_type"},
+	"aws_neptune_cluster_instance":      {instanceType: "instance_class"},
+	"aws_rds_cluster_instance":          {instanceType: "instance_class"},
+	"aws_redshift_cluster":              {instanceType: "node_type", count: "number_of_nodes"},
+	"aws_sagemaker_notebook_instance":   {instanceType: "instance_type", storageSize: "volume_size"},
+}
+
+var _ function.Function = costMetadataFunction{}
+
+func NewCostMetadataFunction() function.Function {
+	return &costMetadataFunction{}
+}
+
+type costMetadataFunction struct{}
+
+func (f costMetadataFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cost_metadata"
+}
+
+func (f costMetadataFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cost_metadata Function",
+		MarkdownDescription: "Extracts cost-relevant metadata from a resource's attributes into a consistent structure",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Resource type, e.g. aws_instance",
+			},
+			// A resource object can't be passed directly: dynamic parameters require plugin-framework v1.7.0,
+			// and an object parameter only accepts objects with a fixed set of attributes.
+			function.MapParameter{
+				Name:                "attributes",
+				MarkdownDescription: "Attributes of the resource",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: costMetadataResultAttrTypes,
+		},
+	}
+}
+
+func (f costMetadataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+	var attributes map[string]*string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceType, &attributes))
+	if resp.Error != nil {
+		return
+	}
+
+	names, ok := costMetadataResourceTypes[resourceType]
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("unsupported resource type: %s", resourceType)))
+		return
+	}
+
+	count, err := costMetadataInt64Value(attributes, names.count)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+	if count.IsNull() {
+		count = types.Int64Value(1)
+	}
+
+	storageSize, err := costMetadataInt64Value(attributes, names.storageSize)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"count":           count,
+		"instance_type":   costMetadataStringValue(attributes, names.instanceType),
+		"storage_size_gb": storageSize,
+	}
+
+	result, d := types.ObjectValue(costMetadataResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func costMetadataStringValue(attributes map[string]*string, name string) types.String {
+	if v, ok := attributes[name]; ok && name != "" && v != nil && *v != "" {
+		return types.StringValue(*v)
+	}
+
+	return types.StringNull()
+}
+
+func costMetadataInt64Value(attributes map[string]*string, name string) (types.Int64, error) {
+	v := costMetadataStringValue(attributes, name)
+	if v.IsNull() {
+		return types.Int64Null(), nil
+	}
+
+	i, err := strconv.ParseInt(v.ValueString(), 10, 64)
+	if err != nil {
+		return types.Int64Null(), fmt.Errorf("attribute %q is not an integer: %s", name, v.ValueString())
+	}
+
+	return types.Int64Value(i), nil
+}
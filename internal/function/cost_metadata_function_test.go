@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCostMetadataFunction_instance(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCostMetadataFunctionConfig("aws_instance", `{ instance_type = "t3.micro" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput("instance_type", "t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestCostMetadataFunction_dbInstance(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCostMetadataFunctionConfig("aws_db_instance", `{ instance_class = "db.t3.micro", allocated_storage = 20 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput("instance_type", "db.t3.micro"),
+					resource.TestCheckOutput("storage_size_gb", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCostMetadataFunction_unsupportedResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCostMetadataFunctionConfig("aws_vpc", `{ cidr_block = "10.0.0.0/16" }`),
+				ExpectError: regexache.MustCompile("unsupported resource type: aws_vpc"),
+			},
+		},
+	})
+}
+
+func TestCostMetadataFunction_invalidStorageSize(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCostMetadataFunctionConfig("aws_ebs_volume", `{ size = "large" }`),
+				ExpectError: regexache.MustCompile(`attribute "size" is not an integer`),
+			},
+		},
+	})
+}
+
+func testCostMetadataFunctionConfig(resourceType, attributes string) string {
+	return fmt.Sprintf(`
+locals {
+  metadata = provider::aws::cost_metadata(%[1]q, %[2]s)
+}
+
+output "count" {
+  value = local.metadata.count
+}
+
+output "instance_type" {
+  value = local.metadata.instance_type
+}
+
+output "storage_size_gb" {
+  value = local.metadata.storage_size_gb
+}
+`, resourceType, attributes)
+}
@@ -34,10 +34,11 @@ func main() {
 
 		switch packageName {
 		case "cloudfrontkeyvaluestore", // Endpoint includes account ID
-			"codecatalyst",    // Bearer auth token needs special handling
-			"mwaa",            // Resolver modifies URL
-			"s3control",       // Resolver modifies URL
-			"timestreamwrite": // Uses endpoint discovery
+			"codecatalyst",     // Bearer auth token needs special handling
+			"codegurusecurity", // Client predates endpoint resolution v2
+			"mwaa",             // Resolver modifies URL
+			"s3control",        // Resolver modifies URL
+			"timestreamwrite":  // Uses endpoint discovery
 			continue
 		}
 
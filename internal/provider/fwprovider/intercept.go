@@ -315,6 +315,16 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
+func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
+	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+
+		return v.MoveState(ctx)
+	}
+
+	return nil
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
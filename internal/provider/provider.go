@@ -158,6 +158,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present on all resources.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -567,6 +583,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.RequiredTagsConfig = expandRequiredTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -870,6 +890,20 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRequiredTags(ctx context.Context, tfMap map[string]interface{}) *tftags.RequiredConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	if v, ok := tfMap["keys"].(*schema.Set); ok {
+		requiredConfig.Keys = tftags.New(ctx, v.List())
+	}
+
+	return requiredConfig
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
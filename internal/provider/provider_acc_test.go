@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -132,6 +133,48 @@ func TestAccProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	})
 }
 
+func TestAccProvider_RequiredTags_missing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_requiredTags(rName, "", "Owner", "Team"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`missing required tags: Owner, Team`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_RequiredTags_defaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_requiredTags(rName, "Owner", "Owner"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "provider"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProvider_endpoints(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -1125,6 +1168,39 @@ provider "aws" {
 `)
 }
 
+// testAccProviderConfig_requiredTags returns a configuration that requires the specified tag keys.
+// If defaultTagKey is set, the provider's default_tags supply that tag.
+func testAccProviderConfig_requiredTags(rName, defaultTagKey string, requiredTagKeys ...string) string {
+	var defaultTags string
+	if defaultTagKey != "" {
+		defaultTags = fmt.Sprintf(`
+  default_tags {
+    tags = {
+      %[1]q = "provider"
+    }
+  }
+`, defaultTagKey)
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+%[2]s
+  required_tags {
+    keys = [%[3]s]
+  }
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, defaultTags, `"`+strings.Join(requiredTagKeys, `", "`)+`"`)
+}
+
 func testAccProviderConfig_region(region string) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
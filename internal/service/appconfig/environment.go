@@ -5,7 +5,9 @@ package appconfig
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -32,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -92,6 +95,9 @@ func (r *resourceEnvironment) Schema(ctx context.Context, request resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"monitor_role_policy": schema.StringAttribute{
+				Computed: true,
+			},
 			"name": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -312,19 +318,46 @@ func (r *resourceEnvironment) ImportState(ctx context.Context, request resource.
 
 func (r *resourceEnvironment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEnvironmentData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The monitor role policy is known at plan time whenever all the monitored alarm ARNs are.
+	var monitors []monitorData
+	if !plan.Monitors.IsUnknown() {
+		response.Diagnostics.Append(plan.Monitors.ElementsAs(ctx, &monitors, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Monitors.IsUnknown() || slices.ContainsFunc(monitors, func(v monitorData) bool { return v.AlarmARN.IsUnknown() }) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("monitor_role_policy"), types.StringUnknown())...)
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("monitor_role_policy"), environmentMonitorRolePolicy(monitors, &response.Diagnostics))...)
 }
 
 type resourceEnvironmentData struct {
-	ApplicationID types.String `tfsdk:"application_id"`
-	ARN           types.String `tfsdk:"arn"`
-	Description   types.String `tfsdk:"description"`
-	EnvironmentID types.String `tfsdk:"environment_id"`
-	ID            types.String `tfsdk:"id"`
-	Monitors      types.Set    `tfsdk:"monitor"`
-	Name          types.String `tfsdk:"name"`
-	State         types.String `tfsdk:"state"`
-	Tags          types.Map    `tfsdk:"tags"`
-	TagsAll       types.Map    `tfsdk:"tags_all"`
+	ApplicationID     types.String `tfsdk:"application_id"`
+	ARN               types.String `tfsdk:"arn"`
+	Description       types.String `tfsdk:"description"`
+	EnvironmentID     types.String `tfsdk:"environment_id"`
+	ID                types.String `tfsdk:"id"`
+	Monitors          types.Set    `tfsdk:"monitor"`
+	MonitorRolePolicy types.String `tfsdk:"monitor_role_policy"`
+	Name              types.String `tfsdk:"name"`
+	State             types.String `tfsdk:"state"`
+	Tags              types.Map    `tfsdk:"tags"`
+	TagsAll           types.Map    `tfsdk:"tags_all"`
 }
 
 func (d *resourceEnvironmentData) refreshFromCreateOutput(ctx context.Context, meta *conns.AWSClient, out *appconfig.CreateEnvironmentOutput) diag.Diagnostics {
@@ -343,6 +376,7 @@ func (d *resourceEnvironmentData) refreshFromCreateOutput(ctx context.Context, m
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
+	d.MonitorRolePolicy = environmentMonitorRolePolicy(flattenMonitorsData(ctx, out.Monitors), &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
 
@@ -365,6 +399,7 @@ func (d *resourceEnvironmentData) refreshFromGetOutput(ctx context.Context, meta
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
+	d.MonitorRolePolicy = environmentMonitorRolePolicy(flattenMonitorsData(ctx, out.Monitors), &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
 
@@ -387,6 +422,7 @@ func (d *resourceEnvironmentData) refreshFromUpdateOutput(ctx context.Context, m
 	d.EnvironmentID = types.StringValue(envID)
 	d.ID = types.StringValue(fmt.Sprintf("%s:%s", envID, appID))
 	d.Monitors = flattenMonitors(ctx, out.Monitors, &diags)
+	d.MonitorRolePolicy = environmentMonitorRolePolicy(flattenMonitorsData(ctx, out.Monitors), &diags)
 	d.Name = flex.StringToFramework(ctx, out.Name)
 	d.State = flex.StringValueToFramework(ctx, out.State)
 
@@ -450,6 +486,50 @@ func flattenMonitors(ctx context.Context, apiObjects []awstypes.Monitor, diags *
 	return result
 }
 
+func flattenMonitorsData(ctx context.Context, apiObjects []awstypes.Monitor) []monitorData {
+	monitors := make([]monitorData, len(apiObjects))
+	for i, o := range apiObjects {
+		monitors[i] = *flattenMonitorData(ctx, o)
+	}
+	return monitors
+}
+
+// environmentMonitorRolePolicy returns the IAM policy document that the monitors' alarm roles require
+// to describe the monitored (metric or composite) alarms, or null if there are no monitors.
+func environmentMonitorRolePolicy(monitors []monitorData, diags *diag.Diagnostics) types.String {
+	var alarmARNs []string
+	for _, monitor := range monitors {
+		if v := monitor.AlarmARN.ValueString(); !slices.Contains(alarmARNs, v) {
+			alarmARNs = append(alarmARNs, v)
+		}
+	}
+
+	if len(alarmARNs) == 0 {
+		return types.StringNull()
+	}
+
+	slices.Sort(alarmARNs)
+
+	policy := tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "cloudwatch:DescribeAlarms",
+				Resources: alarmARNs,
+			},
+		},
+	}
+
+	b, err := json.Marshal(policy)
+	if err != nil {
+		diags.AddError("building AppConfig Environment monitor role policy", err.Error())
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(b))
+}
+
 type monitorData struct {
 	AlarmARN     fwtypes.ARN `tfsdk:"alarm_arn"`
 	AlarmRoleARN fwtypes.ARN `tfsdk:"alarm_role_arn"`
@@ -217,6 +217,36 @@ func TestAccAppConfigEnvironment_monitors(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigEnvironment_monitorRolePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_monitorRolePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor.*.alarm_arn", "aws_cloudwatch_composite_alarm.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "monitor.*.alarm_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_role_policy", "aws_iam_role_policy.test", "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppConfigEnvironment_multipleEnvironments(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -564,6 +594,67 @@ resource "aws_appconfig_environment" "test" {
 `, rName, count))
 }
 
+func testAccEnvironmentConfig_monitorRolePolicy(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_name(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appconfig.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = "2"
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = "120"
+  statistic           = "Average"
+  threshold           = "80"
+
+  dimensions = {
+    InstanceId = "i-abc123"
+  }
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test.alarm_name})"
+}
+
+resource "aws_appconfig_environment" "test" {
+  name           = %[1]q
+  application_id = aws_appconfig_application.test.id
+
+  monitor {
+    alarm_arn      = aws_cloudwatch_composite_alarm.test.arn
+    alarm_role_arn = aws_iam_role.test.arn
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.id
+  policy = aws_appconfig_environment.test.monitor_role_policy
+}
+`, rName))
+}
+
 func testAccEnvironmentConfig_multiple(rName string) string {
 	return acctest.ConfigCompose(testAccApplicationConfig_name(rName), fmt.Sprintf(`
 resource "aws_appconfig_environment" "test" {
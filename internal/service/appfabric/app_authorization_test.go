@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAppAuthorization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_basic("TestApiKey1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "apiKey"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key", "TestApiKey1"),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.oauth2_credential.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_display_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_identifier", "test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential"},
+			},
+		},
+	})
+}
+
+func testAccAppAuthorization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_basic("TestApiKey1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceAppAuthorization, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAppAuthorization_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_basic("TestApiKey1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key", "TestApiKey1"),
+				),
+			},
+			{
+				Config: testAccAppAuthorizationConfig_basic("TestApiKey2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "credential.0.api_key_credential.0.api_key", "TestApiKey2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppAuthorization_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential"},
+			},
+			{
+				Config: testAccAppAuthorizationConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAppAuthorizationConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_app_authorization" {
+				continue
+			}
+
+			_, err := tfappfabric.FindAppAuthorizationByTwoPartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric App Authorization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAppAuthorizationExists(ctx context.Context, n string, v *awstypes.AppAuthorization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		output, err := tfappfabric.FindAppAuthorizationByTwoPartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAppAuthorizationConfig_basic(apiKey string) string {
+	return fmt.Sprintf(`
+resource "aws_appfabric_app_bundle" "test" {}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = %[1]q
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+}
+`, apiKey)
+}
+
+func testAccAppAuthorizationConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appfabric_app_bundle" "test" {}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = "TestApiKey1"
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAppAuthorizationConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appfabric_app_bundle" "test" {}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = "TestApiKey1"
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Only one App Bundle can exist per account and Region, so all tests are serialized.
+func TestAccAppFabric_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"AppBundle": {
+			"basic":      testAccAppBundle_basic,
+			"disappears": testAccAppBundle_disappears,
+			"cmk":        testAccAppBundle_cmk,
+			"tags":       testAccAppBundle_tags,
+		},
+		"AppAuthorization": {
+			"basic":      testAccAppAuthorization_basic,
+			"disappears": testAccAppAuthorization_disappears,
+			"update":     testAccAppAuthorization_update,
+			"tags":       testAccAppAuthorization_tags,
+		},
+		"Ingestion": {
+			"basic":      testAccIngestion_basic,
+			"disappears": testAccIngestion_disappears,
+			"tags":       testAccIngestion_tags,
+		},
+		"IngestionDestination": {
+			"basic":          testAccIngestionDestination_basic,
+			"disappears":     testAccIngestionDestination_disappears,
+			"firehoseStream": testAccIngestionDestination_firehoseStream,
+			"tags":           testAccIngestionDestination_tags,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	acctest.PreCheckRegionHasService(ctx, t, names.AppFabric)
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+	input := &appfabric.ListAppBundlesInput{}
+
+	_, err := conn.ListAppBundles(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
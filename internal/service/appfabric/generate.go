@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ServiceTagsSlice -ListTags -ListTagsInIDElem=ResourceArn -UpdateTags -TagInIDElem=ResourceArn
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package appfabric
@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appfabric"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Ingestion Destination")
+// @Tags(identifierAttribute="arn")
+func newIngestionDestinationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ingestionDestinationResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type ingestionDestinationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*ingestionDestinationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appfabric_ingestion_destination"
+}
+
+func (r *ingestionDestinationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_bundle_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"ingestion_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"destination_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[destinationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"audit_log": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[auditLogDestinationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"destination": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[destinationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"firehose_stream": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[firehoseStreamModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"stream_name": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"s3_bucket": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[s3BucketModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"bucket_name": schema.StringAttribute{
+																Required: true,
+															},
+															"prefix": schema.StringAttribute{
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processing_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[processingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"audit_log": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[auditLogProcessingConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Format](),
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"schema": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Schema](),
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ingestionDestinationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	// We can't use AutoFlEx with the top-level resource model because the API structure uses Go interfaces.
+	destinationConfiguration, diags := expandDestinationConfiguration(ctx, data.DestinationConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	processingConfiguration, diags := expandProcessingConfiguration(ctx, data.ProcessingConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &appfabric.CreateIngestionDestinationInput{
+		AppBundleIdentifier:      fwflex.StringFromFramework(ctx, data.AppBundleARN),
+		ClientToken:              aws.String(sdkid.UniqueId()),
+		DestinationConfiguration: destinationConfiguration,
+		IngestionIdentifier:      fwflex.StringFromFramework(ctx, data.IngestionARN),
+		ProcessingConfiguration:  processingConfiguration,
+		Tags:                     getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateIngestionDestination(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating AppFabric Ingestion Destination", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.IngestionDestination.Arn)
+	data.setID()
+
+	if _, err := waitIngestionDestinationActive(ctx, conn, data.AppBundleARN.ValueString(), data.IngestionARN.ValueString(), data.ARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for AppFabric Ingestion Destination (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingestionDestinationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	output, err := findIngestionDestinationByThreePartKey(ctx, conn, data.AppBundleARN.ValueString(), data.IngestionARN.ValueString(), data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFabric Ingestion Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	destinationConfiguration, diags := flattenDestinationConfiguration(ctx, output.DestinationConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.DestinationConfiguration = destinationConfiguration
+
+	processingConfiguration, diags := flattenProcessingConfiguration(ctx, output.ProcessingConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ProcessingConfiguration = processingConfiguration
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingestionDestinationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	if !new.DestinationConfiguration.Equal(old.DestinationConfiguration) {
+		destinationConfiguration, diags := expandDestinationConfiguration(ctx, new.DestinationConfiguration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &appfabric.UpdateIngestionDestinationInput{
+			AppBundleIdentifier:            fwflex.StringFromFramework(ctx, new.AppBundleARN),
+			DestinationConfiguration:       destinationConfiguration,
+			IngestionDestinationIdentifier: fwflex.StringFromFramework(ctx, new.ARN),
+			IngestionIdentifier:            fwflex.StringFromFramework(ctx, new.IngestionARN),
+		}
+
+		_, err := conn.UpdateIngestionDestination(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppFabric Ingestion Destination (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitIngestionDestinationActive(ctx, conn, new.AppBundleARN.ValueString(), new.IngestionARN.ValueString(), new.ARN.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for AppFabric Ingestion Destination (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ingestionDestinationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	_, err := conn.DeleteIngestionDestination(ctx, &appfabric.DeleteIngestionDestinationInput{
+		AppBundleIdentifier:            fwflex.StringFromFramework(ctx, data.AppBundleARN),
+		IngestionDestinationIdentifier: fwflex.StringFromFramework(ctx, data.ARN),
+		IngestionIdentifier:            fwflex.StringFromFramework(ctx, data.IngestionARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppFabric Ingestion Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIngestionDestinationDeleted(ctx, conn, data.AppBundleARN.ValueString(), data.IngestionARN.ValueString(), data.ARN.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for AppFabric Ingestion Destination (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ingestionDestinationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIngestionDestinationByThreePartKey(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string) (*awstypes.IngestionDestination, error) {
+	input := &appfabric.GetIngestionDestinationInput{
+		AppBundleIdentifier:            aws.String(appBundleARN),
+		IngestionDestinationIdentifier: aws.String(arn),
+		IngestionIdentifier:            aws.String(ingestionARN),
+	}
+
+	output, err := conn.GetIngestionDestination(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IngestionDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IngestionDestination, nil
+}
+
+func statusIngestionDestination(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIngestionDestinationByThreePartKey(ctx, conn, appBundleARN, ingestionARN, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIngestionDestinationActive(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string, timeout time.Duration) (*awstypes.IngestionDestination, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  enum.Slice(awstypes.IngestionDestinationStatusActive),
+		Refresh: statusIngestionDestination(ctx, conn, appBundleARN, ingestionARN, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.IngestionDestination); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngestionDestinationDeleted(ctx context.Context, conn *appfabric.Client, appBundleARN, ingestionARN, arn string, timeout time.Duration) (*awstypes.IngestionDestination, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngestionDestinationStatusActive),
+		Target:  []string{},
+		Refresh: statusIngestionDestination(ctx, conn, appBundleARN, ingestionARN, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.IngestionDestination); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDestinationConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[destinationConfigurationModel]) (awstypes.DestinationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	auditLog, d := data.AuditLog.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || auditLog == nil {
+		return nil, diags
+	}
+
+	destination, d := auditLog.Destination.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || destination == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.DestinationConfigurationMemberAuditLog{}
+
+	if v, d := destination.FirehoseStream.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+
+		value := &awstypes.DestinationMemberFirehoseStream{}
+		diags.Append(fwflex.Expand(ctx, v, &value.Value)...)
+		apiObject.Value.Destination = value
+
+		return apiObject, diags
+	}
+
+	if v, d := destination.S3Bucket.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+
+		value := &awstypes.DestinationMemberS3Bucket{}
+		diags.Append(fwflex.Expand(ctx, v, &value.Value)...)
+		apiObject.Value.Destination = value
+
+		return apiObject, diags
+	}
+
+	diags.AddError("invalid destination", "exactly one of firehose_stream or s3_bucket must be configured")
+
+	return nil, diags
+}
+
+func expandProcessingConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[processingConfigurationModel]) (awstypes.ProcessingConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	auditLog, d := data.AuditLog.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || auditLog == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.ProcessingConfigurationMemberAuditLog{}
+	diags.Append(fwflex.Expand(ctx, auditLog, &apiObject.Value)...)
+
+	return apiObject, diags
+}
+
+func flattenDestinationConfiguration(ctx context.Context, apiObject awstypes.DestinationConfiguration) (fwtypes.ListNestedObjectValueOf[destinationConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := apiObject.(*awstypes.DestinationConfigurationMemberAuditLog)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[destinationConfigurationModel](ctx), diags
+	}
+
+	destination := &destinationModel{
+		FirehoseStream: fwtypes.NewListNestedObjectValueOfNull[firehoseStreamModel](ctx),
+		S3Bucket:       fwtypes.NewListNestedObjectValueOfNull[s3BucketModel](ctx),
+	}
+
+	switch v := v.Value.Destination.(type) {
+	case *awstypes.DestinationMemberFirehoseStream:
+		var firehoseStream firehoseStreamModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &firehoseStream)...)
+		destination.FirehoseStream = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &firehoseStream)
+
+	case *awstypes.DestinationMemberS3Bucket:
+		var s3Bucket s3BucketModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &s3Bucket)...)
+		destination.S3Bucket = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3Bucket)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[destinationConfigurationModel](ctx), diags
+	}
+
+	auditLog := &auditLogDestinationConfigurationModel{
+		Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, destination),
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &destinationConfigurationModel{
+		AuditLog: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, auditLog),
+	}), diags
+}
+
+func flattenProcessingConfiguration(ctx context.Context, apiObject awstypes.ProcessingConfiguration) (fwtypes.ListNestedObjectValueOf[processingConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := apiObject.(*awstypes.ProcessingConfigurationMemberAuditLog)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[processingConfigurationModel](ctx), diags
+	}
+
+	var auditLog auditLogProcessingConfigurationModel
+	diags.Append(fwflex.Flatten(ctx, &v.Value, &auditLog)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[processingConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &processingConfigurationModel{
+		AuditLog: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &auditLog),
+	}), diags
+}
+
+type ingestionDestinationResourceModel struct {
+	AppBundleARN             fwtypes.ARN                                                    `tfsdk:"app_bundle_arn"`
+	ARN                      types.String                                                   `tfsdk:"arn"`
+	DestinationConfiguration fwtypes.ListNestedObjectValueOf[destinationConfigurationModel] `tfsdk:"destination_configuration"`
+	ID                       types.String                                                   `tfsdk:"id"`
+	IngestionARN             fwtypes.ARN                                                    `tfsdk:"ingestion_arn"`
+	ProcessingConfiguration  fwtypes.ListNestedObjectValueOf[processingConfigurationModel]  `tfsdk:"processing_configuration"`
+	Tags                     types.Map                                                      `tfsdk:"tags"`
+	TagsAll                  types.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                                 `tfsdk:"timeouts"`
+}
+
+const (
+	ingestionDestinationResourceIDPartCount = 3
+)
+
+func (data *ingestionDestinationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), ingestionDestinationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	appBundleARN, diags := fwtypes.ARNValue(parts[0])
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	ingestionARN, diags := fwtypes.ARNValue(parts[1])
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	data.AppBundleARN = appBundleARN
+	data.IngestionARN = ingestionARN
+	data.ARN = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *ingestionDestinationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AppBundleARN.ValueString(), data.IngestionARN.ValueString(), data.ARN.ValueString()}, ingestionDestinationResourceIDPartCount, false)))
+}
+
+type destinationConfigurationModel struct {
+	AuditLog fwtypes.ListNestedObjectValueOf[auditLogDestinationConfigurationModel] `tfsdk:"audit_log"`
+}
+
+type auditLogDestinationConfigurationModel struct {
+	Destination fwtypes.ListNestedObjectValueOf[destinationModel] `tfsdk:"destination"`
+}
+
+type destinationModel struct {
+	FirehoseStream fwtypes.ListNestedObjectValueOf[firehoseStreamModel] `tfsdk:"firehose_stream"`
+	S3Bucket       fwtypes.ListNestedObjectValueOf[s3BucketModel]       `tfsdk:"s3_bucket"`
+}
+
+type firehoseStreamModel struct {
+	StreamName types.String `tfsdk:"stream_name"`
+}
+
+type s3BucketModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Prefix     types.String `tfsdk:"prefix"`
+}
+
+type processingConfigurationModel struct {
+	AuditLog fwtypes.ListNestedObjectValueOf[auditLogProcessingConfigurationModel] `tfsdk:"audit_log"`
+}
+
+type auditLogProcessingConfigurationModel struct {
+	Format fwtypes.StringEnum[awstypes.Format] `tfsdk:"format"`
+	Schema fwtypes.StringEnum[awstypes.Schema] `tfsdk:"schema"`
+}
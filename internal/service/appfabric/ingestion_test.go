@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIngestion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Ingestion
+	resourceName := "aws_appfabric_ingestion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "ingestion_type", "auditLog"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIngestion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Ingestion
+	resourceName := "aws_appfabric_ingestion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceIngestion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIngestion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Ingestion
+	resourceName := "aws_appfabric_ingestion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngestionConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccIngestionConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_ingestion" {
+				continue
+			}
+
+			_, err := tfappfabric.FindIngestionByTwoPartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric Ingestion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionExists(ctx context.Context, n string, v *awstypes.Ingestion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		output, err := tfappfabric.FindIngestionByTwoPartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIngestionConfig_base() string {
+	return testAccAppAuthorizationConfig_basic("TestApiKey1")
+}
+
+func testAccIngestionConfig_basic() string {
+	return acctest.ConfigCompose(testAccIngestionConfig_base(), `
+resource "aws_appfabric_ingestion" "test" {
+  app            = aws_appfabric_app_authorization.test.app
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  tenant_id      = aws_appfabric_app_authorization.test.tenant[0].tenant_identifier
+  ingestion_type = "auditLog"
+}
+`)
+}
+
+func testAccIngestionConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_base(), fmt.Sprintf(`
+resource "aws_appfabric_ingestion" "test" {
+  app            = aws_appfabric_app_authorization.test.app
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  tenant_id      = aws_appfabric_app_authorization.test.tenant[0].tenant_identifier
+  ingestion_type = "auditLog"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccIngestionConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_base(), fmt.Sprintf(`
+resource "aws_appfabric_ingestion" "test" {
+  app            = aws_appfabric_app_authorization.test.app
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  tenant_id      = aws_appfabric_app_authorization.test.tenant[0].tenant_identifier
+  ingestion_type = "auditLog"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
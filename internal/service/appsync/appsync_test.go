@@ -20,20 +20,23 @@ func TestAccAppSync_serial(t *testing.T) {
 			"expires":     testAccAPIKey_expires,
 		},
 		"DataSource": {
-			"basic":                         testAccDataSource_basic,
-			"description":                   testAccDataSource_description,
-			"DynamoDB_region":               testAccDataSource_DynamoDB_region,
-			"DynamoDB_useCallerCredentials": testAccDataSource_DynamoDB_useCallerCredentials,
-			"HTTP_endpoint":                 testAccDataSource_HTTP_endpoint,
-			"type":                          testAccDataSource_type,
-			"Type_dynamoDB":                 testAccDataSource_Type_dynamoDB,
-			"Type_http":                     testAccDataSource_Type_http,
-			"Type_http_auth":                testAccDataSource_Type_httpAuth,
-			"Type_lambda":                   testAccDataSource_Type_lambda,
-			"Type_none":                     testAccDataSource_Type_none,
-			"Type_rdbms":                    testAccDataSource_Type_relationalDatabase,
-			"Type_rdbms_options":            testAccDataSource_Type_relationalDatabaseWithOptions,
-			"Type_eventBridge":              testAccDataSource_Type_eventBridge,
+			"basic":                          testAccDataSource_basic,
+			"description":                    testAccDataSource_description,
+			"DynamoDB_region":                testAccDataSource_DynamoDB_region,
+			"DynamoDB_useCallerCredentials":  testAccDataSource_DynamoDB_useCallerCredentials,
+			"HTTP_endpoint":                  testAccDataSource_HTTP_endpoint,
+			"type":                           testAccDataSource_type,
+			"Type_dynamoDB":                  testAccDataSource_Type_dynamoDB,
+			"Type_http":                      testAccDataSource_Type_http,
+			"Type_http_auth":                 testAccDataSource_Type_httpAuth,
+			"Type_lambda":                    testAccDataSource_Type_lambda,
+			"Type_none":                      testAccDataSource_Type_none,
+			"Type_rdbms":                     testAccDataSource_Type_relationalDatabase,
+			"Type_rdbms_options":             testAccDataSource_Type_relationalDatabaseWithOptions,
+			"Type_eventBridge":               testAccDataSource_Type_eventBridge,
+			"Type_rdbms_update":              testAccDataSource_Type_relationalDatabaseUpdate,
+			"Type_http_openSearchServerless": testAccDataSource_Type_httpOpenSearchServerless,
+			"HTTP_openSearchServerlessAuth":  testAccDataSource_HTTP_openSearchServerlessAuthorizationRequired,
 		},
 		"GraphQLAPI": {
 			"basic":                     testAccGraphQLAPI_basic,
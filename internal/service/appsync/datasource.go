@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -35,6 +36,8 @@ func ResourceDataSource() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceDataSourceCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -94,7 +97,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"elasticsearch_config", "http_config", "lambda_config", "relational_database_config", "opensearchservice_config"},
+				ConflictsWith: []string{"elasticsearch_config", "event_bridge_config", "http_config", "lambda_config", "relational_database_config", "opensearchservice_config"},
 			},
 			"elasticsearch_config": {
 				Type:     schema.TypeList,
@@ -113,7 +116,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "http_config", "lambda_config", "opensearchservice_config"},
+				ConflictsWith: []string{"dynamodb_config", "event_bridge_config", "http_config", "lambda_config", "opensearchservice_config"},
 			},
 			"event_bridge_config": {
 				Type:     schema.TypeList,
@@ -128,7 +131,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "lambda_config", "relational_database_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "http_config", "lambda_config", "opensearchservice_config", "relational_database_config"},
 			},
 			"http_config": {
 				Type:     schema.TypeList,
@@ -174,7 +177,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "opensearchservice_config", "lambda_config", "relational_database_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "opensearchservice_config", "lambda_config", "relational_database_config"},
 			},
 			"lambda_config": {
 				Type:     schema.TypeList,
@@ -189,7 +192,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "opensearchservice_config", "http_config", "relational_database_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "opensearchservice_config", "http_config", "relational_database_config"},
 			},
 			"opensearchservice_config": {
 				Type:     schema.TypeList,
@@ -208,7 +211,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "http_config", "lambda_config", "elasticsearch_config"},
+				ConflictsWith: []string{"dynamodb_config", "event_bridge_config", "http_config", "lambda_config", "elasticsearch_config"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -260,7 +263,7 @@ func ResourceDataSource() *schema.Resource {
 						},
 					},
 				},
-				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "opensearchservice_config", "http_config", "lambda_config"},
+				ConflictsWith: []string{"dynamodb_config", "elasticsearch_config", "event_bridge_config", "opensearchservice_config", "http_config", "lambda_config"},
 			},
 			"service_role_arn": {
 				Type:         schema.TypeString,
@@ -420,6 +423,10 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.ElasticsearchConfig = expandElasticsearchDataSourceConfig(v.([]interface{}), region)
 	}
 
+	if v, ok := d.GetOk("event_bridge_config"); ok {
+		input.EventBridgeConfig = expandEventBridgeDataSourceConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("http_config"); ok {
 		input.HttpConfig = expandHTTPDataSourceConfig(v.([]interface{}))
 	}
@@ -477,6 +484,63 @@ func resourceDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+const (
+	openSearchServerlessSigningServiceName = "aoss"
+)
+
+// resourceDataSourceCustomizeDiff validates that HTTP data sources targeting
+// an Amazon OpenSearch Serverless collection endpoint sign requests with SigV4
+// for the "aoss" service, as the collection rejects unsigned requests.
+func resourceDataSourceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("http_config") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("http_config")
+	if !ok {
+		return nil
+	}
+
+	tfList := v.([]interface{})
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	endpoint := tfMap["endpoint"].(string)
+
+	if !isOpenSearchServerlessEndpoint(endpoint) {
+		return nil
+	}
+
+	config := expandHTTPDataSourceAuthorizationConfig(tfMap["authorization_config"].([]interface{}))
+
+	if config == nil || !strings.EqualFold(aws.StringValue(config.AuthorizationType), appsync.AuthorizationTypeAwsIam) || config.AwsIamConfig == nil {
+		return fmt.Errorf("OpenSearch Serverless endpoint (%s) requires http_config.authorization_config with authorization_type %s and aws_iam_config", endpoint, appsync.AuthorizationTypeAwsIam)
+	}
+
+	if v := aws.StringValue(config.AwsIamConfig.SigningServiceName); v != openSearchServerlessSigningServiceName {
+		return fmt.Errorf("OpenSearch Serverless endpoint (%s) requires aws_iam_config.signing_service_name %q, got %q", endpoint, openSearchServerlessSigningServiceName, v)
+	}
+
+	if aws.StringValue(config.AwsIamConfig.SigningRegion) == "" {
+		return fmt.Errorf("OpenSearch Serverless endpoint (%s) requires aws_iam_config.signing_region", endpoint)
+	}
+
+	return nil
+}
+
+// isOpenSearchServerlessEndpoint returns whether the specified endpoint is an Amazon OpenSearch Serverless
+// collection endpoint, e.g. https://0123456789abcdef.us-west-2.aoss.amazonaws.com.
+func isOpenSearchServerlessEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	return regexache.MustCompile(`\.aoss\.amazonaws\.com(\.cn)?$`).MatchString(u.Hostname())
+}
+
 func FindDataSourceByTwoPartKey(ctx context.Context, conn *appsync.AppSync, apiID, name string) (*appsync.DataSource, error) {
 	input := &appsync.GetDataSourceInput{
 		ApiId: aws.String(apiID),
@@ -813,7 +877,7 @@ func expandRelationalDatabaseDataSourceConfig(l []interface{}, currentRegion str
 
 	result := &appsync.RelationalDatabaseDataSourceConfig{
 		RelationalDatabaseSourceType: aws.String(configured["source_type"].(string)),
-		RdsHttpEndpointConfig:        testAccDataSourceConfig_expandRDSHTTPEndpoint(configured["http_endpoint_config"].([]interface{}), currentRegion),
+		RdsHttpEndpointConfig:        expandRDSHTTPEndpointConfig(configured["http_endpoint_config"].([]interface{}), currentRegion),
 	}
 
 	return result
@@ -858,7 +922,7 @@ func flattenEventBridgeDataSourceConfig(config *appsync.EventBridgeDataSourceCon
 	return []map[string]interface{}{result}
 }
 
-func testAccDataSourceConfig_expandRDSHTTPEndpoint(l []interface{}, currentRegion string) *appsync.RdsHttpEndpointConfig {
+func expandRDSHTTPEndpointConfig(l []interface{}, currentRegion string) *appsync.RdsHttpEndpointConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -229,6 +229,23 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"alarm_specification": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"alarms": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 10,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
 									"auto_rollback": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -298,6 +315,10 @@ func ResourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"last_instance_refresh_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"launch_configuration": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1262,6 +1283,20 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
 	}
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		instanceRefresh, err := findLastInstanceRefresh(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("last_instance_refresh_status", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			d.Set("last_instance_refresh_status", instanceRefresh.Status)
+		}
+	} else {
+		d.Set("last_instance_refresh_status", nil)
+	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set("launch_template", []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
@@ -2088,6 +2123,34 @@ func findInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, inp
 	return output[0], nil
 }
 
+// findLastInstanceRefresh returns the most recent instance refresh of the specified Auto Scaling group.
+// Instance refreshes are described in descending order of start time.
+func findLastInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, name string) (*autoscaling.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int64(1),
+	}
+
+	output, err := conn.DescribeInstanceRefreshesWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, errCodeValidationError, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 || output.InstanceRefreshes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.InstanceRefreshes[0], nil
+}
+
 func FindInstanceRefreshes(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.DescribeInstanceRefreshesInput) ([]*autoscaling.InstanceRefresh, error) {
 	var output []*autoscaling.InstanceRefresh
 
@@ -3369,6 +3432,10 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 
 	apiObject := &autoscaling.RefreshPreferences{}
 
+	if v, ok := tfMap["alarm_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AlarmSpecification = expandAlarmSpecification(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["auto_rollback"].(bool); ok {
 		apiObject.AutoRollback = aws.Bool(v)
 	}
@@ -3412,6 +3479,20 @@ func expandRefreshPreferences(tfMap map[string]interface{}) *autoscaling.Refresh
 	return apiObject
 }
 
+func expandAlarmSpecification(tfMap map[string]interface{}) *autoscaling.AlarmSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.AlarmSpecification{}
+
+	if v, ok := tfMap["alarms"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Alarms = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
 func expandVPCZoneIdentifiers(tfList []interface{}) *string {
 	vpcZoneIDs := make([]string, len(tfList))
 
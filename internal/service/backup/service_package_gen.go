@@ -62,6 +62,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGlobalSettings,
 			TypeName: "aws_backup_global_settings",
 		},
+		{
+			Factory:  resourceLogicallyAirGappedVault,
+			TypeName: "aws_backup_logically_air_gapped_vault",
+			Name:     "Logically Air Gapped Vault",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourcePlan,
 			TypeName: "aws_backup_plan",
@@ -6,12 +6,16 @@ package ce
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -57,8 +61,8 @@ func ResourceAnomalyMonitor() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ValidateFunc:     validAnomalyMonitorSpecification,
+				DiffSuppressFunc: suppressEquivalentAnomalyMonitorSpecification,
 				ConflictsWith:    []string{"monitor_dimension"},
 			},
 			"monitor_type": {
@@ -71,7 +75,10 @@ func ResourceAnomalyMonitor() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceAnomalyMonitorCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -89,23 +96,15 @@ func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 	switch d.Get("monitor_type").(string) {
 	case costexplorer.MonitorTypeDimensional:
-		if v, ok := d.GetOk("monitor_dimension"); ok {
-			input.AnomalyMonitor.MonitorDimension = aws.String(v.(string))
-		} else {
-			return sdkdiag.AppendErrorf(diags, "If Monitor Type is %s, dimension attrribute is required", costexplorer.MonitorTypeDimensional)
-		}
+		input.AnomalyMonitor.MonitorDimension = aws.String(d.Get("monitor_dimension").(string))
 	case costexplorer.MonitorTypeCustom:
-		if v, ok := d.GetOk("monitor_specification"); ok {
-			expression := costexplorer.Expression{}
-
-			if err := json.Unmarshal([]byte(v.(string)), &expression); err != nil {
-				return sdkdiag.AppendErrorf(diags, "parsing specification: %s", err)
-			}
+		expression, err := expandAnomalyMonitorSpecification(d.Get("monitor_specification").(string))
 
-			input.AnomalyMonitor.MonitorSpecification = &expression
-		} else {
-			return sdkdiag.AppendErrorf(diags, "If Monitor Type is %s, dimension attrribute is required", costexplorer.MonitorTypeCustom)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing specification: %s", err)
 		}
+
+		input.AnomalyMonitor.MonitorSpecification = expression
 	}
 
 	resp, err := conn.CreateAnomalyMonitorWithContext(ctx, input)
@@ -205,3 +204,127 @@ func resourceAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+func resourceAnomalyMonitorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Values may be unknown until apply.
+	if !d.NewValueKnown("monitor_type") || !d.NewValueKnown("monitor_dimension") || !d.NewValueKnown("monitor_specification") {
+		return nil
+	}
+
+	switch monitorType := d.Get("monitor_type").(string); monitorType {
+	case costexplorer.MonitorTypeDimensional:
+		if d.Get("monitor_dimension").(string) == "" {
+			return fmt.Errorf(`"monitor_dimension" is required when "monitor_type" is %q`, monitorType)
+		}
+	case costexplorer.MonitorTypeCustom:
+		if d.Get("monitor_specification").(string) == "" {
+			return fmt.Errorf(`"monitor_specification" is required when "monitor_type" is %q`, monitorType)
+		}
+	}
+
+	return nil
+}
+
+func expandAnomalyMonitorSpecification(s string) (*costexplorer.Expression, error) {
+	expression := &costexplorer.Expression{}
+
+	if err := json.NewDecoder(strings.NewReader(s)).Decode(expression); err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+// suppressEquivalentAnomalyMonitorSpecification suppresses differences between equivalent specifications,
+// e.g. where one omits the operators that the API returns as null.
+func suppressEquivalentAnomalyMonitorSpecification(k, old, new string, d *schema.ResourceData) bool {
+	oldExpression, err := expandAnomalyMonitorSpecification(old)
+	if err != nil {
+		return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+	}
+
+	newExpression, err := expandAnomalyMonitorSpecification(new)
+	if err != nil {
+		return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+	}
+
+	return reflect.DeepEqual(oldExpression, newExpression)
+}
+
+// validAnomalyMonitorSpecification validates a custom monitor specification.
+// Each expression must set exactly one of And, Or, Not, Dimensions, Tags or CostCategories.
+// Custom monitors support linked account dimensions, cost allocation tags and cost categories.
+func validAnomalyMonitorSpecification(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	expression, err := expandAnomalyMonitorSpecification(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid Cost Explorer expression: %w", k, err))
+		return
+	}
+
+	errors = append(errors, validAnomalyMonitorExpression(expression, k)...)
+
+	return
+}
+
+func validAnomalyMonitorExpression(expression *costexplorer.Expression, k string) []error {
+	var errors []error
+
+	n := 0
+	for _, set := range []bool{
+		expression.And != nil,
+		expression.Or != nil,
+		expression.Not != nil,
+		expression.Dimensions != nil,
+		expression.Tags != nil,
+		expression.CostCategories != nil,
+	} {
+		if set {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return append(errors, fmt.Errorf("%q expressions must contain exactly one of And, Or, Not, Dimensions, Tags or CostCategories", k))
+	}
+
+	switch {
+	case expression.And != nil:
+		for _, v := range expression.And {
+			if v != nil {
+				errors = append(errors, validAnomalyMonitorExpression(v, k)...)
+			}
+		}
+	case expression.Or != nil:
+		for _, v := range expression.Or {
+			if v != nil {
+				errors = append(errors, validAnomalyMonitorExpression(v, k)...)
+			}
+		}
+	case expression.Not != nil:
+		errors = append(errors, validAnomalyMonitorExpression(expression.Not, k)...)
+	case expression.Dimensions != nil:
+		if key := aws.StringValue(expression.Dimensions.Key); key != costexplorer.DimensionLinkedAccount {
+			errors = append(errors, fmt.Errorf("%q Dimensions key must be %s, got: %q", k, costexplorer.DimensionLinkedAccount, key))
+		}
+		if len(expression.Dimensions.Values) == 0 {
+			errors = append(errors, fmt.Errorf("%q Dimensions must contain at least one value", k))
+		}
+	case expression.Tags != nil:
+		if aws.StringValue(expression.Tags.Key) == "" {
+			errors = append(errors, fmt.Errorf("%q Tags key must be set", k))
+		}
+	case expression.CostCategories != nil:
+		if aws.StringValue(expression.CostCategories.Key) == "" {
+			errors = append(errors, fmt.Errorf("%q CostCategories key must be set", k))
+		}
+	}
+
+	return errors
+}
@@ -22,6 +22,120 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidAnomalyMonitorSpecification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value       string
+		expectError bool
+	}{
+		{
+			value:       `{"Tags":{"Key":"CostCenter","Values":["10000"]}}`,
+			expectError: false,
+		},
+		{
+			value:       `{"And":null,"CostCategories":null,"Dimensions":null,"Not":null,"Or":null,"Tags":{"Key":"CostCenter","MatchOptions":null,"Values":["10000"]}}`,
+			expectError: false,
+		},
+		{
+			value:       `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}}`,
+			expectError: false,
+		},
+		{
+			value:       `{"CostCategories":{"Key":"Team","Values":["Platform"]}}`,
+			expectError: false,
+		},
+		{
+			value:       `{"Dimensions":{"Key":"SERVICE","Values":["Amazon Elastic Compute Cloud - Compute"]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":[]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{"Tags":{"Values":["10000"]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{"Tags":{"Key":"CostCenter","Values":["10000"]},"CostCategories":{"Key":"Team","Values":["Platform"]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{"Or":[{"Tags":{"Key":"CostCenter","Values":["10000"]}},{"Tags":{"Key":"CostCenter","Values":["20000"]}}]}`,
+			expectError: false,
+		},
+		{
+			value:       `{"And":[{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}},{"Not":{"Tags":{"Key":"CostCenter","Values":["10000"]}}}]}`,
+			expectError: false,
+		},
+		{
+			value:       `{"Or":[{"Dimensions":{"Key":"SERVICE","Values":["Amazon Elastic Compute Cloud - Compute"]}}]}`,
+			expectError: true,
+		},
+		{
+			value:       `{"And":[{"Tags":{"Key":"CostCenter","Values":["10000"]}}],"Tags":{"Key":"CostCenter","Values":["20000"]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{"Tags":{"Key":"CostCenter","Values":["10000"],"Unknown":true}}`,
+			expectError: false,
+		},
+		{
+			value:       `{"Tag":{"Key":"CostCenter","Values":["10000"]}}`,
+			expectError: true,
+		},
+		{
+			value:       `{}`,
+			expectError: true,
+		},
+		{
+			value:       `not json`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, errors := tfce.ValidAnomalyMonitorSpecification(testCase.value, "monitor_specification")
+
+		if got, want := len(errors) > 0, testCase.expectError; got != want {
+			t.Errorf("ValidAnomalyMonitorSpecification(%q) errors = %v, expected error: %t", testCase.value, errors, want)
+		}
+	}
+}
+
+func TestSuppressEquivalentAnomalyMonitorSpecification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			old:  `{"And":null,"CostCategories":null,"Dimensions":{"Key":"LINKED_ACCOUNT","MatchOptions":null,"Values":["123456789012"]},"Not":null,"Or":null,"Tags":null}`,
+			new:  `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["123456789012"]}}`,
+			want: true,
+		},
+		{
+			old:  `{"And":null,"CostCategories":null,"Dimensions":{"Key":"LINKED_ACCOUNT","MatchOptions":null,"Values":["123456789012"]},"Not":null,"Or":null,"Tags":null}`,
+			new:  `{"Dimensions":{"Key":"LINKED_ACCOUNT","Values":["210987654321"]}}`,
+			want: false,
+		},
+		{
+			old:  `{"Tags":{"Key":"CostCenter","Values":["10000"]}}`,
+			new:  `{"CostCategories":{"Key":"CostCenter","Values":["10000"]}}`,
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfce.SuppressEquivalentAnomalyMonitorSpecification("monitor_specification", testCase.old, testCase.new, nil), testCase.want; got != want {
+			t.Errorf("SuppressEquivalentAnomalyMonitorSpecification(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+		}
+	}
+}
+
 func TestAccCEAnomalyMonitor_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var monitor costexplorer.AnomalyMonitor
@@ -159,6 +273,53 @@ func TestAccCEAnomalyMonitor_tags(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalyMonitor_linkedAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var monitor costexplorer.AnomalyMonitor
+	resourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig_linkedAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(ctx, resourceName, &monitor),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_specification"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_invalidSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_serviceDimensionSpecification(rName),
+				ExpectError: regexache.MustCompile(`Dimensions key must be LINKED_ACCOUNT`),
+			},
+		},
+	})
+}
+
 // An AWS account can only have one anomaly monitor of type DIMENSIONAL. As
 // such, if additional tests are added, they should be combined with the
 // following test in a serial test
@@ -343,3 +504,37 @@ resource "aws_ce_anomaly_monitor" "test" {
 }
 `, rName)
 }
+
+func testAccAnomalyMonitorConfig_linkedAccount(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Dimensions = {
+      Key    = "LINKED_ACCOUNT"
+      Values = [data.aws_caller_identity.current.account_id]
+    }
+  })
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorConfig_serviceDimensionSpecification(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification = jsonencode({
+    Dimensions = {
+      Key    = "SERVICE"
+      Values = ["Amazon Elastic Compute Cloud - Compute"]
+    }
+  })
+}
+`, rName)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudfront_cache_policies")
+func DataSourceCachePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCachePoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.CachePolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceCachePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	input := &cloudfront.ListCachePoliciesInput{}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	var ids, names []string
+
+	err := ListCachePoliciesPages(ctx, conn, input, func(page *cloudfront.ListCachePoliciesOutput, lastPage bool) bool {
+		if page == nil || page.CachePolicyList == nil {
+			return !lastPage
+		}
+
+		for _, policySummary := range page.CachePolicyList.Items {
+			if policySummary == nil || policySummary.CachePolicy == nil || policySummary.CachePolicy.CachePolicyConfig == nil {
+				continue
+			}
+
+			cachePolicy := policySummary.CachePolicy
+			name := aws.StringValue(cachePolicy.CachePolicyConfig.Name)
+
+			if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(name) {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(cachePolicy.Id))
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing CloudFront Cache Policies: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return diags
+}
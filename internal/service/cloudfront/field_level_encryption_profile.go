@@ -6,6 +6,7 @@ package cloudfront
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -19,6 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	fieldLevelEncryptionProfileInUseTimeout = 2 * time.Minute
+)
+
 // @SDKResource("aws_cloudfront_field_level_encryption_profile")
 func ResourceFieldLevelEncryptionProfile() *schema.Resource {
 	return &schema.Resource{
@@ -193,10 +198,12 @@ func resourceFieldLevelEncryptionProfileDelete(ctx context.Context, d *schema.Re
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 
 	log.Printf("[DEBUG] Deleting CloudFront Field-level Encryption Profile: (%s)", d.Id())
-	_, err := conn.DeleteFieldLevelEncryptionProfileWithContext(ctx, &cloudfront.DeleteFieldLevelEncryptionProfileInput{
-		Id:      aws.String(d.Id()),
-		IfMatch: aws.String(d.Get("etag").(string)),
-	})
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, fieldLevelEncryptionProfileInUseTimeout, func() (interface{}, error) {
+		return conn.DeleteFieldLevelEncryptionProfileWithContext(ctx, &cloudfront.DeleteFieldLevelEncryptionProfileInput{
+			Id:      aws.String(d.Id()),
+			IfMatch: aws.String(d.Get("etag").(string)),
+		})
+	}, cloudfront.ErrCodeFieldLevelEncryptionProfileInUse)
 
 	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile) {
 		return diags
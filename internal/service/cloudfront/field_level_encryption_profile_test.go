@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -100,6 +101,45 @@ func TestAccCloudFrontFieldLevelEncryptionProfile_disappears(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFieldLevelEncryptionProfile_rotatePublicKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile cloudfront.GetFieldLevelEncryptionProfileOutput
+	resourceName := "aws_cloudfront_field_level_encryption_profile.test"
+	keyResourceName := "aws_cloudfront_public_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	publicKey1 := acctest.TLSRSAPublicKeyPEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	publicKey2 := acctest.TLSRSAPublicKeyPEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		CheckDestroy:             testAccCheckFieldLevelEncryptionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldLevelEncryptionProfileConfig_rotatePublicKey(rName, publicKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFieldLevelEncryptionProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "encryption_entities.0.items.*.public_key_id", keyResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccFieldLevelEncryptionProfileConfig_rotatePublicKey(rName, publicKey2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(keyResourceName, plancheck.ResourceActionCreateBeforeDestroy),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFieldLevelEncryptionProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "encryption_entities.0.items.*.public_key_id", keyResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFieldLevelEncryptionProfileDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
@@ -202,3 +242,31 @@ resource "aws_cloudfront_field_level_encryption_profile" "test" {
 }
 `, rName)
 }
+
+func testAccFieldLevelEncryptionProfileConfig_rotatePublicKey(rName, publicKey string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  encoded_key = %[2]q
+  name_prefix = "tf-acc-test-"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_cloudfront_field_level_encryption_profile" "test" {
+  name = %[1]q
+
+  encryption_entities {
+    items {
+      public_key_id = aws_cloudfront_public_key.test.id
+      provider_id   = %[1]q
+
+      field_patterns {
+        items = ["DateOfBirth"]
+      }
+    }
+  }
+}
+`, rName, publicKey)
+}
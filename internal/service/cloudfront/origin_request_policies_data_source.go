@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_cloudfront_origin_request_policies")
+func DataSourceOriginRequestPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOriginRequestPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cloudfront.OriginRequestPolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceOriginRequestPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
+
+	input := &cloudfront.ListOriginRequestPoliciesInput{}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	var ids, names []string
+
+	err := ListOriginRequestPoliciesPages(ctx, conn, input, func(page *cloudfront.ListOriginRequestPoliciesOutput, lastPage bool) bool {
+		if page == nil || page.OriginRequestPolicyList == nil {
+			return !lastPage
+		}
+
+		for _, policySummary := range page.OriginRequestPolicyList.Items {
+			if policySummary == nil || policySummary.OriginRequestPolicy == nil || policySummary.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+				continue
+			}
+
+			originRequestPolicy := policySummary.OriginRequestPolicy
+			name := aws.StringValue(originRequestPolicy.OriginRequestPolicyConfig.Name)
+
+			if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(name) {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(originRequestPolicy.Id))
+			names = append(names, name)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing CloudFront Origin Request Policies: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	return diags
+}
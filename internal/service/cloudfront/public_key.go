@@ -6,6 +6,7 @@ package cloudfront
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -20,6 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	publicKeyInUseTimeout = 2 * time.Minute
+)
+
 // @SDKResource("aws_cloudfront_public_key")
 func ResourcePublicKey() *schema.Resource {
 	return &schema.Resource{
@@ -170,15 +175,23 @@ func resourcePublicKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).CloudFrontConn(ctx)
 
 	log.Printf("[DEBUG] Deleting CloudFront Public Key: %s", d.Id())
-	_, err := conn.DeletePublicKeyWithContext(ctx, &cloudfront.DeletePublicKeyInput{
-		Id:      aws.String(d.Id()),
-		IfMatch: aws.String(d.Get("etag").(string)),
-	})
+	// During key rotation the referencing field-level encryption profile or key group
+	// may have only just been updated to the replacement key.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, publicKeyInUseTimeout, func() (interface{}, error) {
+		return conn.DeletePublicKeyWithContext(ctx, &cloudfront.DeletePublicKeyInput{
+			Id:      aws.String(d.Id()),
+			IfMatch: aws.String(d.Get("etag").(string)),
+		})
+	}, cloudfront.ErrCodePublicKeyInUse)
 
 	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchPublicKey) {
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodePublicKeyInUse) {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Public Key (%s): %s. To rotate a key that is in use, set create_before_destroy on the key and use name_prefix (or a new name)", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting CloudFront Public Key (%s): %s", d.Id(), err)
 	}
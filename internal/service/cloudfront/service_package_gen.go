@@ -36,6 +36,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCachePolicies,
+			TypeName: "aws_cloudfront_cache_policies",
+		},
 		{
 			Factory:  DataSourceCachePolicy,
 			TypeName: "aws_cloudfront_cache_policy",
@@ -60,6 +64,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceOriginAccessIdentity,
 			TypeName: "aws_cloudfront_origin_access_identity",
 		},
+		{
+			Factory:  DataSourceOriginRequestPolicies,
+			TypeName: "aws_cloudfront_origin_request_policies",
+		},
 		{
 			Factory:  DataSourceOriginRequestPolicy,
 			TypeName: "aws_cloudfront_origin_request_policy",
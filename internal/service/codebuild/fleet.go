@@ -0,0 +1,518 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codebuild_fleet", name="Fleet")
+// @Tags
+func resourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCreate,
+		ReadWithoutTimeout:   resourceFleetRead,
+		UpdateWithoutTimeout: resourceFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"compute_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.ComputeType](),
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.EnvironmentType](),
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"overflow_behavior": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.FleetOverflowBehavior](),
+			},
+			"scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"scaling_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.FleetScalingType](),
+						},
+						"target_tracking_scaling_configs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.FleetScalingMetricType](),
+									},
+									"target_value": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &codebuild.CreateFleetInput{
+		BaseCapacity:    aws.Int32(int32(d.Get("base_capacity").(int))),
+		ComputeType:     types.ComputeType(d.Get("compute_type").(string)),
+		EnvironmentType: types.EnvironmentType(d.Get("environment_type").(string)),
+		Name:            aws.String(name),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("overflow_behavior"); ok {
+		input.OverflowBehavior = types.FleetOverflowBehavior(v.(string))
+	}
+
+	if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScalingConfiguration = expandScalingConfigurationInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateFleet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeBuild Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Fleet.Arn))
+
+	if _, err := waitFleetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeBuild Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	fleet, err := findFleetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeBuild Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", fleet.Arn)
+	d.Set("base_capacity", fleet.BaseCapacity)
+	d.Set("compute_type", fleet.ComputeType)
+	d.Set("created", aws.ToTime(fleet.Created).Format(time.RFC3339))
+	d.Set("environment_type", fleet.EnvironmentType)
+	d.Set("last_modified", aws.ToTime(fleet.LastModified).Format(time.RFC3339))
+	d.Set("name", fleet.Name)
+	d.Set("overflow_behavior", fleet.OverflowBehavior)
+	if fleet.ScalingConfiguration != nil {
+		if err := d.Set("scaling_configuration", []interface{}{flattenScalingConfigurationOutput(fleet.ScalingConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scaling_configuration: %s", err)
+		}
+	} else {
+		d.Set("scaling_configuration", nil)
+	}
+	if fleet.Status != nil {
+		if err := d.Set("status", []interface{}{flattenFleetStatus(fleet.Status)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting status: %s", err)
+		}
+	} else {
+		d.Set("status", nil)
+	}
+
+	setTagsOut(ctx, fleet.Tags)
+
+	return diags
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	input := &codebuild.UpdateFleetInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("base_capacity") {
+		input.BaseCapacity = aws.Int32(int32(d.Get("base_capacity").(int)))
+	}
+
+	if d.HasChange("compute_type") {
+		input.ComputeType = types.ComputeType(d.Get("compute_type").(string))
+	}
+
+	if d.HasChange("environment_type") {
+		input.EnvironmentType = types.EnvironmentType(d.Get("environment_type").(string))
+	}
+
+	if d.HasChange("overflow_behavior") {
+		input.OverflowBehavior = types.FleetOverflowBehavior(d.Get("overflow_behavior").(string))
+	}
+
+	if d.HasChange("scaling_configuration") {
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfiguration = expandScalingConfigurationInput(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ScalingConfiguration = &types.ScalingConfigurationInput{}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		input.Tags = getTagsIn(ctx)
+	}
+
+	_, err := conn.UpdateFleet(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeBuild Fleet (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFleetRead(ctx, d, meta)...)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	log.Printf("[INFO] Deleting CodeBuild Fleet: %s", d.Id())
+	_, err := conn.DeleteFleet(ctx, &codebuild.DeleteFleetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeBuild Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findFleetByARN(ctx context.Context, conn *codebuild.Client, arn string) (*types.Fleet, error) {
+	input := &codebuild.BatchGetFleetsInput{
+		Names: []string{arn},
+	}
+
+	return findFleet(ctx, conn, input)
+}
+
+func findFleet(ctx context.Context, conn *codebuild.Client, input *codebuild.BatchGetFleetsInput) (*types.Fleet, error) {
+	output, err := findFleets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFleets(ctx context.Context, conn *codebuild.Client, input *codebuild.BatchGetFleetsInput) ([]types.Fleet, error) {
+	output, err := conn.BatchGetFleets(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Fleets, nil
+}
+
+func statusFleet(ctx context.Context, conn *codebuild.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFleetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.Status.StatusCode), nil
+	}
+}
+
+func waitFleetCreated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodeCreating, types.FleetStatusCodeRotating),
+		Target:  enum.Slice(types.FleetStatusCodeActive),
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetUpdated(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodeUpdating, types.FleetStatusCodeRotating),
+		Target:  enum.Slice(types.FleetStatusCodeActive),
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, timeout time.Duration) (*types.Fleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FleetStatusCodeDeleting),
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Fleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandScalingConfigurationInput(tfMap map[string]interface{}) *types.ScalingConfigurationInput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ScalingConfigurationInput{}
+
+	if v, ok := tfMap["max_capacity"].(int); ok && v != 0 {
+		apiObject.MaxCapacity = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["scaling_type"].(string); ok && v != "" {
+		apiObject.ScalingType = types.FleetScalingType(v)
+	}
+
+	if v, ok := tfMap["target_tracking_scaling_configs"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TargetTrackingScalingConfigs = expandTargetTrackingScalingConfigurations(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingConfigurations(tfList []interface{}) []types.TargetTrackingScalingConfiguration {
+	var apiObjects []types.TargetTrackingScalingConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.TargetTrackingScalingConfiguration{}
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			apiObject.MetricType = types.FleetScalingMetricType(v)
+		}
+
+		if v, ok := tfMap["target_value"].(float64); ok {
+			apiObject.TargetValue = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenScalingConfigurationOutput(apiObject *types.ScalingConfigurationOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"scaling_type": apiObject.ScalingType,
+	}
+
+	if v := apiObject.DesiredCapacity; v != nil {
+		tfMap["desired_capacity"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.MaxCapacity; v != nil {
+		tfMap["max_capacity"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.TargetTrackingScalingConfigs; v != nil {
+		tfMap["target_tracking_scaling_configs"] = flattenTargetTrackingScalingConfigurations(v)
+	}
+
+	return tfMap
+}
+
+func flattenTargetTrackingScalingConfigurations(apiObjects []types.TargetTrackingScalingConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"metric_type": apiObject.MetricType,
+		}
+
+		if v := apiObject.TargetValue; v != nil {
+			tfMap["target_value"] = aws.ToFloat64(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFleetStatus(apiObject *types.FleetStatus) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"context":     apiObject.Context,
+		"message":     aws.ToString(apiObject.Message),
+		"status_code": apiObject.StatusCode,
+	}
+
+	return tfMap
+}
@@ -273,6 +273,20 @@ func resourceProject() *schema.Resource {
 								},
 							},
 						},
+						"fleet": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fleet_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"image": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -1330,6 +1344,18 @@ func expandProjectEnvironment(tfMap map[string]interface{}) *types.ProjectEnviro
 		apiObject.ComputeType = types.ComputeType(v)
 	}
 
+	if v, ok := tfMap["fleet"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		projectFleet := &types.ProjectFleet{}
+
+		if v, ok := tfMap["fleet_arn"].(string); ok && v != "" {
+			projectFleet.FleetArn = aws.String(v)
+		}
+
+		apiObject.Fleet = projectFleet
+	}
+
 	if v, ok := tfMap["image"].(string); ok && v != "" {
 		apiObject.Image = aws.String(v)
 	}
@@ -1800,6 +1826,7 @@ func flattenProjectEnvironment(apiObject *types.ProjectEnvironment) []interface{
 	tfMap["image"] = aws.ToString(apiObject.Image)
 	tfMap["certificate"] = aws.ToString(apiObject.Certificate)
 	tfMap["privileged_mode"] = aws.ToBool(apiObject.PrivilegedMode)
+	tfMap["fleet"] = flattenProjectFleet(apiObject.Fleet)
 	tfMap["registry_credential"] = flattenRegistryCredential(apiObject.RegistryCredential)
 
 	if apiObject.EnvironmentVariables != nil {
@@ -1809,6 +1836,18 @@ func flattenProjectEnvironment(apiObject *types.ProjectEnvironment) []interface{
 	return []interface{}{tfMap}
 }
 
+func flattenProjectFleet(apiObject *types.ProjectFleet) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"fleet_arn": aws.ToString(apiObject.FleetArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenRegistryCredential(apiObject *types.RegistryCredential) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
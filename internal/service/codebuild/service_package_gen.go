@@ -28,6 +28,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceFleet,
+			TypeName: "aws_codebuild_fleet",
+			Name:     "Fleet",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  resourceProject,
 			TypeName: "aws_codebuild_project",
@@ -5,12 +5,18 @@ package codeguruprofiler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,7 +27,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -90,6 +98,47 @@ func (r *resourceProfilingGroup) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"agent_permissions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[agentPermissions](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"principals": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"notification_channel": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[notificationChannel](ctx),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"event_publishers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.EventPublisher]()),
+							},
+						},
+						"uri": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -130,6 +179,37 @@ func (r *resourceProfilingGroup) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	name := aws.ToString(out.ProfilingGroup.Name)
+
+	channels, diags := expandNotificationChannels(ctx, plan.NotificationChannel)
+	resp.Diagnostics.Append(diags...)
+	principals, diags := expandAgentPermissionsPrincipals(ctx, plan.AgentPermissions)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(channels) > 0 {
+		if err := addNotificationChannels(ctx, conn, name, channels); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameProfilingGroup, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(principals) > 0 {
+		if err := putAgentPermissions(ctx, conn, name, principals, nil); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionCreating, ResNameProfilingGroup, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	state := plan
 
 	resp.Diagnostics.Append(flex.Flatten(ctx, out.ProfilingGroup, &state)...)
@@ -167,6 +247,40 @@ func (r *resourceProfilingGroup) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	channels, err := findNotificationChannelsByProfilingGroupName(ctx, conn, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.NotificationChannel = flattenNotificationChannels(ctx, channels)
+
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, state.ID.ValueString())
+	switch {
+	case tfresource.NotFound(err):
+		state.AgentPermissions = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []agentPermissions{})
+	case err != nil:
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	default:
+		principals, err := agentPermissionsPrincipalsFromPolicy(aws.ToString(policy.Policy))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionSetting, ResNameProfilingGroup, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		state.AgentPermissions = flattenAgentPermissions(ctx, principals)
+	}
+
 	setTagsOut(ctx, out.Tags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -210,6 +324,32 @@ func (r *resourceProfilingGroup) Update(ctx context.Context, req resource.Update
 		}
 	}
 
+	if !plan.NotificationChannel.Equal(state.NotificationChannel) {
+		if err := updateNotificationChannels(ctx, conn, state.ID.ValueString(), state.NotificationChannel, plan.NotificationChannel); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameProfilingGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.AgentPermissions.Equal(state.AgentPermissions) {
+		principals, diags := expandAgentPermissionsPrincipals(ctx, plan.AgentPermissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateAgentPermissions(ctx, conn, state.ID.ValueString(), principals); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeGuruProfiler, create.ErrActionUpdating, ResNameProfilingGroup, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -273,12 +413,316 @@ func findProfilingGroupByName(ctx context.Context, conn *codeguruprofiler.Client
 	return out.ProfilingGroup, nil
 }
 
+func findNotificationChannelsByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) ([]awstypes.Channel, error) {
+	in := &codeguruprofiler.GetNotificationConfigurationInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.GetNotificationConfiguration(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.NotificationConfiguration == nil {
+		return nil, nil
+	}
+
+	return out.NotificationConfiguration.Channels, nil
+}
+
+func findPolicyByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.Client, name string) (*codeguruprofiler.GetPolicyOutput, error) {
+	in := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	out, err := conn.GetPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || aws.ToString(out.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func addNotificationChannels(ctx context.Context, conn *codeguruprofiler.Client, name string, channels []awstypes.Channel) error {
+	in := &codeguruprofiler.AddNotificationChannelsInput{
+		Channels:           channels,
+		ProfilingGroupName: aws.String(name),
+	}
+
+	_, err := conn.AddNotificationChannels(ctx, in)
+
+	return err
+}
+
+// updateNotificationChannels removes the channels that are no longer configured and adds the new ones.
+// Channels are matched on URI as channel IDs are assigned by the service.
+func updateNotificationChannels(ctx context.Context, conn *codeguruprofiler.Client, name string, o, n fwtypes.SetNestedObjectValueOf[notificationChannel]) error {
+	oldChannels, diags := expandNotificationChannels(ctx, o)
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	newChannels, diags := expandNotificationChannels(ctx, n)
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	var add, del []awstypes.Channel
+	for _, v := range newChannels {
+		if !slices.ContainsFunc(oldChannels, func(c awstypes.Channel) bool { return notificationChannelEqual(c, v) }) {
+			add = append(add, v)
+		}
+	}
+	for _, v := range oldChannels {
+		if !slices.ContainsFunc(newChannels, func(c awstypes.Channel) bool { return notificationChannelEqual(c, v) }) {
+			del = append(del, v)
+		}
+	}
+
+	if len(del) > 0 {
+		current, err := findNotificationChannelsByProfilingGroupName(ctx, conn, name)
+		if err != nil {
+			return fmt.Errorf("reading notification configuration: %w", err)
+		}
+
+		for _, v := range del {
+			for _, c := range current {
+				if aws.ToString(c.Uri) != aws.ToString(v.Uri) {
+					continue
+				}
+
+				in := &codeguruprofiler.RemoveNotificationChannelInput{
+					ChannelId:          c.Id,
+					ProfilingGroupName: aws.String(name),
+				}
+
+				_, err := conn.RemoveNotificationChannel(ctx, in)
+				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+					continue
+				}
+
+				if err != nil {
+					return fmt.Errorf("removing notification channel (%s): %w", aws.ToString(c.Id), err)
+				}
+			}
+		}
+	}
+
+	if len(add) > 0 {
+		if err := addNotificationChannels(ctx, conn, name, add); err != nil {
+			return fmt.Errorf("adding notification channels: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func notificationChannelEqual(a, b awstypes.Channel) bool {
+	if aws.ToString(a.Uri) != aws.ToString(b.Uri) || len(a.EventPublishers) != len(b.EventPublishers) {
+		return false
+	}
+
+	for _, v := range a.EventPublishers {
+		if !slices.Contains(b.EventPublishers, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func putAgentPermissions(ctx context.Context, conn *codeguruprofiler.Client, name string, principals []string, revisionID *string) error {
+	in := &codeguruprofiler.PutPermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		Principals:         principals,
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         revisionID,
+	}
+
+	if _, err := conn.PutPermission(ctx, in); err != nil {
+		return fmt.Errorf("putting agent permissions: %w", err)
+	}
+
+	return nil
+}
+
+// updateAgentPermissions replaces the agent permissions granted on the profiling group.
+// An empty list of principals removes the permissions altogether.
+func updateAgentPermissions(ctx context.Context, conn *codeguruprofiler.Client, name string, principals []string) error {
+	var revisionID *string
+
+	policy, err := findPolicyByProfilingGroupName(ctx, conn, name)
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return fmt.Errorf("reading policy: %w", err)
+	default:
+		revisionID = policy.RevisionId
+	}
+
+	if len(principals) > 0 {
+		return putAgentPermissions(ctx, conn, name, principals, revisionID)
+	}
+
+	if revisionID == nil {
+		return nil
+	}
+
+	in := &codeguruprofiler.RemovePermissionInput{
+		ActionGroup:        awstypes.ActionGroupAgentPermissions,
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         revisionID,
+	}
+
+	_, err = conn.RemovePermission(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing agent permissions: %w", err)
+	}
+
+	return nil
+}
+
+// agentPermissionsPrincipalsFromPolicy returns the principals granted access by a profiling group's resource-based policy.
+func agentPermissionsPrincipalsFromPolicy(policy string) ([]string, error) {
+	var document struct {
+		Statement []struct {
+			Principal struct {
+				AWS any `json:"AWS"`
+			} `json:"Principal"`
+		} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	var principals []string
+	for _, statement := range document.Statement {
+		switch v := statement.Principal.AWS.(type) {
+		case string:
+			principals = append(principals, v)
+		case []any:
+			for _, v := range v {
+				if v, ok := v.(string); ok {
+					principals = append(principals, v)
+				}
+			}
+		}
+	}
+
+	slices.Sort(principals)
+
+	return slices.Compact(principals), nil
+}
+
+func expandNotificationChannels(ctx context.Context, v fwtypes.SetNestedObjectValueOf[notificationChannel]) ([]awstypes.Channel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	data, d := v.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.Channel
+	for _, tfObject := range data {
+		apiObject := awstypes.Channel{
+			Uri: flex.StringFromFramework(ctx, tfObject.URI),
+		}
+
+		for _, v := range flex.ExpandFrameworkStringValueSet(ctx, tfObject.EventPublishers) {
+			apiObject.EventPublishers = append(apiObject.EventPublishers, awstypes.EventPublisher(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func flattenNotificationChannels(ctx context.Context, apiObjects []awstypes.Channel) fwtypes.SetNestedObjectValueOf[notificationChannel] {
+	tfObjects := []notificationChannel{}
+
+	for _, apiObject := range apiObjects {
+		eventPublishers := make([]attr.Value, 0, len(apiObject.EventPublishers))
+		for _, v := range apiObject.EventPublishers {
+			eventPublishers = append(eventPublishers, types.StringValue(string(v)))
+		}
+
+		tfObjects = append(tfObjects, notificationChannel{
+			EventPublishers: fwtypes.NewSetValueOfMust[types.String](ctx, eventPublishers),
+			URI:             fwtypes.ARNValueMust(aws.ToString(apiObject.Uri)),
+		})
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, tfObjects)
+}
+
+func expandAgentPermissionsPrincipals(ctx context.Context, v fwtypes.ListNestedObjectValueOf[agentPermissions]) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	return flex.ExpandFrameworkStringValueSet(ctx, data.Principals), diags
+}
+
+func flattenAgentPermissions(ctx context.Context, principals []string) fwtypes.ListNestedObjectValueOf[agentPermissions] {
+	if len(principals) == 0 {
+		return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []agentPermissions{})
+	}
+
+	elements := make([]attr.Value, 0, len(principals))
+	for _, v := range principals {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agentPermissions{
+		Principals: fwtypes.NewSetValueOfMust[types.String](ctx, elements),
+	})
+}
+
 type resourceProfilingGroupData struct {
 	ARN                      types.String                                              `tfsdk:"arn"`
 	AgentOrchestrationConfig fwtypes.ListNestedObjectValueOf[agentOrchestrationConfig] `tfsdk:"agent_orchestration_config"`
+	AgentPermissions         fwtypes.ListNestedObjectValueOf[agentPermissions]         `tfsdk:"agent_permissions"`
 	ComputePlatform          fwtypes.StringEnum[awstypes.ComputePlatform]              `tfsdk:"compute_platform"`
 	ID                       types.String                                              `tfsdk:"id"`
 	Name                     types.String                                              `tfsdk:"name"`
+	NotificationChannel      fwtypes.SetNestedObjectValueOf[notificationChannel]       `tfsdk:"notification_channel"`
 	Tags                     types.Map                                                 `tfsdk:"tags"`
 	TagsAll                  types.Map                                                 `tfsdk:"tags_all"`
 }
@@ -286,3 +730,12 @@ type resourceProfilingGroupData struct {
 type agentOrchestrationConfig struct {
 	ProfilingEnabled types.Bool `tfsdk:"profiling_enabled"`
 }
+
+type agentPermissions struct {
+	Principals fwtypes.SetValueOf[types.String] `tfsdk:"principals"`
+}
+
+type notificationChannel struct {
+	EventPublishers fwtypes.SetValueOf[types.String] `tfsdk:"event_publishers"`
+	URI             fwtypes.ARN                      `tfsdk:"uri"`
+}
@@ -182,6 +182,106 @@ func TestAccCodeGuruProfilerProfilingGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccCodeGuruProfilerProfilingGroup_notificationChannel(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_notificationChannel1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_channel.*", map[string]string{
+						"event_publishers.#": "1",
+						"event_publishers.0": "AnomalyDetection",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_notificationChannel2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test.0", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "notification_channel.*.uri", "aws_sns_topic.test.1", "arn"),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_notificationChannel0(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "notification_channel.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_agentPermissions(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var profilinggroup awstypes.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruProfilerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_agentPermissions1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_agentPermissions0(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &profilinggroup),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProfilingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerClient(ctx)
@@ -305,3 +405,137 @@ resource "aws_codeguruprofiler_profiling_group" "test" {
 }
 `, rName, key1, value1, key2, value2)
 }
+
+func testAccProfilingGroupConfig_notificationChannelBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_sns_topic_policy" "test" {
+  count = 2
+
+  arn = aws_sns_topic.test[count.index].arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "codeguru-profiler.${data.aws_partition.current.dns_suffix}"
+      }
+      Action   = "sns:Publish"
+      Resource = aws_sns_topic.test[count.index].arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccProfilingGroupConfig_notificationChannel0(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_notificationChannelBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_notificationChannel1(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_notificationChannelBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  notification_channel {
+    event_publishers = ["AnomalyDetection"]
+    uri              = aws_sns_topic.test[0].arn
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_notificationChannel2(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_notificationChannelBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  notification_channel {
+    event_publishers = ["AnomalyDetection"]
+    uri              = aws_sns_topic.test[0].arn
+  }
+
+  notification_channel {
+    event_publishers = ["AnomalyDetection"]
+    uri              = aws_sns_topic.test[1].arn
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_agentPermissionsBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccProfilingGroupConfig_agentPermissions0(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_agentPermissionsBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+}
+`, rName))
+}
+
+func testAccProfilingGroupConfig_agentPermissions1(rName string) string {
+	return acctest.ConfigCompose(testAccProfilingGroupConfig_agentPermissionsBase(rName), fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  agent_orchestration_config {
+    profiling_enabled = true
+  }
+
+  agent_permissions {
+    principals = [aws_iam_role.test.arn]
+  }
+}
+`, rName))
+}
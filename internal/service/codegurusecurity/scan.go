@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codegurusecurity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codegurusecurity"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codegurusecurity/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codegurusecurity_scan", name="Scan")
+// @Tags(identifierAttribute="arn")
+func resourceScan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScanCreate,
+		ReadWithoutTimeout:   resourceScanRead,
+		UpdateWithoutTimeout: resourceScanUpdate,
+		DeleteWithoutTimeout: resourceScanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.AnalysisTypeSecurity,
+				ValidateDiagFunc: enum.Validate[awstypes.AnalysisType](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"code_artifact_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 140),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_$:.-]+$`), "must contain only alphanumeric characters, underscores, dollar signs, colons, periods or hyphens"),
+				),
+			},
+			"scan_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ScanTypeStandard,
+				ValidateDiagFunc: enum.Validate[awstypes.ScanType](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceScanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityClient(ctx)
+
+	name := d.Get("scan_name").(string)
+	input := &codegurusecurity.CreateScanInput{
+		AnalysisType: awstypes.AnalysisType(d.Get("analysis_type").(string)),
+		ClientToken:  aws.String(id.UniqueId()),
+		ResourceId: &awstypes.ResourceIdMemberCodeArtifactId{
+			Value: d.Get("code_artifact_id").(string),
+		},
+		ScanName: aws.String(name),
+		ScanType: awstypes.ScanType(d.Get("scan_type").(string)),
+		Tags:     getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateScan(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeGuru Security Scan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ScanName))
+
+	if _, err := waitScanCompleted(ctx, conn, d.Id(), aws.ToString(output.RunId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeGuru Security Scan (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceScanRead(ctx, d, meta)...)
+}
+
+func resourceScanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityClient(ctx)
+
+	output, err := findScanByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeGuru Security Scan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeGuru Security Scan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("analysis_type", output.AnalysisType)
+	d.Set("arn", output.ScanNameArn)
+	d.Set("run_id", output.RunId)
+	d.Set("scan_name", output.ScanName)
+	d.Set("scan_state", output.ScanState)
+
+	return diags
+}
+
+func resourceScanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruSecurityClient(ctx)
+
+	// Scans are immutable. Changing the scan configuration starts a new run of the named scan.
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &codegurusecurity.CreateScanInput{
+			AnalysisType: awstypes.AnalysisType(d.Get("analysis_type").(string)),
+			ClientToken:  aws.String(id.UniqueId()),
+			ResourceId: &awstypes.ResourceIdMemberCodeArtifactId{
+				Value: d.Get("code_artifact_id").(string),
+			},
+			ScanName: aws.String(d.Id()),
+			ScanType: awstypes.ScanType(d.Get("scan_type").(string)),
+		}
+
+		output, err := conn.CreateScan(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeGuru Security Scan (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitScanCompleted(ctx, conn, d.Id(), aws.ToString(output.RunId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CodeGuru Security Scan (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceScanRead(ctx, d, meta)...)
+}
+
+func resourceScanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] CodeGuru Security Scan (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func findScanByName(ctx context.Context, conn *codegurusecurity.Client, name string) (*codegurusecurity.GetScanOutput, error) {
+	input := &codegurusecurity.GetScanInput{
+		ScanName: aws.String(name),
+	}
+
+	return findScan(ctx, conn, input)
+}
+
+func findScanByNameAndRunID(ctx context.Context, conn *codegurusecurity.Client, name, runID string) (*codegurusecurity.GetScanOutput, error) {
+	input := &codegurusecurity.GetScanInput{
+		RunId:    aws.String(runID),
+		ScanName: aws.String(name),
+	}
+
+	return findScan(ctx, conn, input)
+}
+
+func findScan(ctx context.Context, conn *codegurusecurity.Client, input *codegurusecurity.GetScanInput) (*codegurusecurity.GetScanOutput, error) {
+	output, err := conn.GetScan(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusScan(ctx context.Context, conn *codegurusecurity.Client, name, runID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findScanByNameAndRunID(ctx, conn, name, runID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ScanState), nil
+	}
+}
+
+func waitScanCompleted(ctx context.Context, conn *codegurusecurity.Client, name, runID string, timeout time.Duration) (*codegurusecurity.GetScanOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ScanStateInProgress),
+		Target:  enum.Slice(awstypes.ScanStateSuccessful),
+		Refresh: statusScan(ctx, conn, name, runID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codegurusecurity.GetScanOutput); ok {
+		if output.ScanState == awstypes.ScanStateFailed {
+			tfresource.SetLastError(err, fmt.Errorf("scan run %s failed", runID))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
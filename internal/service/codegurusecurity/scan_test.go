@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codegurusecurity_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codegurusecurity"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodegurusecurity "github.com/hashicorp/terraform-provider-aws/internal/service/codegurusecurity"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The code artifact must be uploaded out of band using the URL returned by CreateUploadUrl.
+const envVarCodeArtifactID = "CODEGURUSECURITY_CODE_ARTIFACT_ID"
+
+func TestAccCodeGuruSecurityScan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	codeArtifactID := acctest.SkipIfEnvVarNotSet(t, envVarCodeArtifactID)
+	var v codegurusecurity.GetScanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codegurusecurity_scan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruSecurityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Scans cannot be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScanConfig_basic(rName, codeArtifactID, "Express"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "analysis_type", "Security"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "code_artifact_id", codeArtifactID),
+					resource.TestCheckResourceAttrSet(resourceName, "run_id"),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scan_state", "Successful"),
+					resource.TestCheckResourceAttr(resourceName, "scan_type", "Express"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"code_artifact_id", "scan_type"},
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruSecurityScan_analysisType(t *testing.T) {
+	ctx := acctest.Context(t)
+	codeArtifactID := acctest.SkipIfEnvVarNotSet(t, envVarCodeArtifactID)
+	var v1, v2 codegurusecurity.GetScanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codegurusecurity_scan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruSecurityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScanConfig_analysisType(rName, codeArtifactID, "Security"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_type", "Security"),
+				),
+			},
+			{
+				Config: testAccScanConfig_analysisType(rName, codeArtifactID, "All"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v2),
+					testAccCheckScanNewRun(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "analysis_type", "All"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruSecurityScan_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	codeArtifactID := acctest.SkipIfEnvVarNotSet(t, envVarCodeArtifactID)
+	var v codegurusecurity.GetScanOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codegurusecurity_scan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeGuruSecurityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScanConfig_tags1(rName, codeArtifactID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"code_artifact_id", "scan_type"},
+			},
+			{
+				Config: testAccScanConfig_tags2(rName, codeArtifactID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScanConfig_tags1(rName, codeArtifactID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScanExists(ctx context.Context, n string, v *codegurusecurity.GetScanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruSecurityClient(ctx)
+
+		output, err := tfcodegurusecurity.FindScanByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScanNewRun(before, after *codegurusecurity.GetScanOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.RunId), aws.ToString(after.RunId); before == after {
+			return fmt.Errorf("CodeGuru Security Scan run not started (%s)", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruSecurityClient(ctx)
+
+	input := &codegurusecurity.ListScansInput{}
+	_, err := conn.ListScans(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccScanConfig_basic(rName, codeArtifactID, scanType string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name        = %[1]q
+  code_artifact_id = %[2]q
+  scan_type        = %[3]q
+}
+`, rName, codeArtifactID, scanType)
+}
+
+func testAccScanConfig_analysisType(rName, codeArtifactID, analysisType string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name        = %[1]q
+  code_artifact_id = %[2]q
+  scan_type        = "Express"
+  analysis_type    = %[3]q
+}
+`, rName, codeArtifactID, analysisType)
+}
+
+func testAccScanConfig_tags1(rName, codeArtifactID, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name        = %[1]q
+  code_artifact_id = %[2]q
+  scan_type        = "Express"
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, codeArtifactID, tagKey1, tagValue1)
+}
+
+func testAccScanConfig_tags2(rName, codeArtifactID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codegurusecurity_scan" "test" {
+  scan_name        = %[1]q
+  code_artifact_id = %[2]q
+  scan_type        = "Express"
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, codeArtifactID, tagKey1, tagValue1, tagKey2, tagValue2)
+}
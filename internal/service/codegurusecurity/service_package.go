@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codegurusecurity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codegurusecurity"
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*codegurusecurity.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+
+	return codegurusecurity.NewFromConfig(cfg, func(o *codegurusecurity.Options) {
+		// The client predates BaseEndpoint, so custom endpoints are set via the legacy resolver.
+		if endpoint := config["endpoint"].(string); endpoint != "" {
+			o.EndpointResolver = codegurusecurity.EndpointResolverFromURL(endpoint)
+		}
+	}), nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -450,7 +451,10 @@ func resourcePipeline() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			pipelineCustomizeDiffPipelineType,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -601,6 +605,28 @@ func findPipelineByName(ctx context.Context, conn *codepipeline.Client, name str
 	return output, nil
 }
 
+// pipelineCustomizeDiffPipelineType rejects arguments that are only supported by V2 pipelines.
+func pipelineCustomizeDiffPipelineType(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("pipeline_type") || types.PipelineType(d.Get("pipeline_type").(string)) != types.PipelineTypeV1 {
+		return nil
+	}
+
+	if d.NewValueKnown("execution_mode") {
+		if v := types.ExecutionMode(d.Get("execution_mode").(string)); v != types.ExecutionModeSuperseded {
+			return fmt.Errorf("execution_mode %q requires pipeline_type %q", v, types.PipelineTypeV2)
+		}
+	}
+
+	config := d.GetRawConfig()
+	for _, k := range []string{"trigger", "variable"} {
+		if v := config.GetAttr(k); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("%s requires pipeline_type %q", k, types.PipelineTypeV2)
+		}
+	}
+
+	return nil
+}
+
 func pipelineValidateActionProvider(i interface{}, path cty.Path) (diags diag.Diagnostics) {
 	v, ok := i.(string)
 	if !ok {
@@ -974,6 +974,35 @@ func TestAccCodePipeline_pipelinetype(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_pipelinetypeV1UnsupportedArguments(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodePipelineConfig_pipelinetypeV1(rName, `execution_mode = "QUEUED"`),
+				ExpectError: regexache.MustCompile(`execution_mode "QUEUED" requires pipeline_type "V2"`),
+			},
+			{
+				Config: testAccCodePipelineConfig_pipelinetypeV1(rName, `
+variable {
+  name = "test"
+}
+`),
+				ExpectError: regexache.MustCompile(`variable requires pipeline_type "V2"`),
+			},
+		},
+	})
+}
+
 func testAccCheckPipelineExists(ctx context.Context, n string, v *types.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1356,6 +1385,62 @@ resource "aws_codestarconnections_connection" "test" {
 `, rName))
 }
 
+func testAccCodePipelineConfig_pipelinetypeV1(rName, extra string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "S3"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        S3Bucket    = aws_s3_bucket.test.bucket
+        S3ObjectKey = "test.zip"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+
+  pipeline_type = "V1"
+
+  %[2]s
+}
+`, rName, extra))
+}
+
 func testAccCodePipelineConfig_pipelinetypeUpdated1(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
 	return acctest.ConfigCompose(
 		testAccS3DefaultBucket(rName),
@@ -415,6 +415,25 @@ func (r *userPoolClientResource) Read(ctx context.Context, request resource.Read
 		return
 	}
 
+	// Keep the prior representation of token validities that are equivalent to the current ones, e.g. 60 minutes and 1 hour.
+	if tvu := poolClient.TokenValidityUnits; tvu != nil {
+		priorUnits := resolveTokenValidityUnits(ctx, state.TokenValidityUnits, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if priorUnits == nil {
+			priorUnits = &tokenValidityUnits{
+				AccessToken:  types.StringValue(cognitoidentityprovider.TimeUnitsTypeHours),
+				IdToken:      types.StringValue(cognitoidentityprovider.TimeUnitsTypeHours),
+				RefreshToken: types.StringValue(cognitoidentityprovider.TimeUnitsTypeDays),
+			}
+		}
+
+		poolClient.AccessTokenValidity, tvu.AccessToken = equivalentTokenValidity(state.AccessTokenValidity, priorUnits.AccessToken, poolClient.AccessTokenValidity, tvu.AccessToken)
+		poolClient.IdTokenValidity, tvu.IdToken = equivalentTokenValidity(state.IdTokenValidity, priorUnits.IdToken, poolClient.IdTokenValidity, tvu.IdToken)
+		poolClient.RefreshTokenValidity, tvu.RefreshToken = equivalentTokenValidity(state.RefreshTokenValidity, priorUnits.RefreshToken, poolClient.RefreshTokenValidity, tvu.RefreshToken)
+	}
+
 	state.AccessTokenValidity = flex.Int64ToFrameworkLegacy(ctx, poolClient.AccessTokenValidity)
 	state.AllowedOauthFlows = flex.FlattenFrameworkStringSetLegacy(ctx, poolClient.AllowedOAuthFlows)
 	state.AllowedOauthFlowsUserPoolClient = flex.BoolToFramework(ctx, poolClient.AllowedOAuthFlowsUserPoolClient)
@@ -811,6 +830,35 @@ func flattenTokenValidityUnits(ctx context.Context, tvu *cognitoidentityprovider
 	return types.ListValueMust(elemType, []attr.Value{val})
 }
 
+func tokenValidityDuration(validity int64, unit string) time.Duration {
+	switch unit {
+	case cognitoidentityprovider.TimeUnitsTypeSeconds:
+		return time.Duration(validity * int64(time.Second))
+	case cognitoidentityprovider.TimeUnitsTypeMinutes:
+		return time.Duration(validity * int64(time.Minute))
+	case cognitoidentityprovider.TimeUnitsTypeHours:
+		return time.Duration(validity * int64(time.Hour))
+	case cognitoidentityprovider.TimeUnitsTypeDays:
+		return time.Duration(validity * 24 * int64(time.Hour))
+	}
+
+	return 0
+}
+
+// equivalentTokenValidity returns the prior token validity and unit if they represent the same duration
+// as the specified validity and unit, otherwise it returns the specified validity and unit unchanged.
+func equivalentTokenValidity(priorValidity types.Int64, priorUnit types.String, validity *int64, unit *string) (*int64, *string) {
+	if priorValidity.IsNull() || priorValidity.IsUnknown() || priorUnit.IsNull() || priorUnit.IsUnknown() || validity == nil || unit == nil {
+		return validity, unit
+	}
+
+	if d := tokenValidityDuration(priorValidity.ValueInt64(), priorUnit.ValueString()); d != 0 && d == tokenValidityDuration(aws.Int64Value(validity), aws.StringValue(unit)) {
+		return aws.Int64(priorValidity.ValueInt64()), aws.String(priorUnit.ValueString())
+	}
+
+	return validity, unit
+}
+
 var _ resource.ConfigValidator = &resourceUserPoolClientAccessTokenValidityValidator{}
 
 type resourceUserPoolClientAccessTokenValidityValidator struct {
@@ -901,16 +949,7 @@ func (v resourceUserPoolClientValidityValidator) validate(ctx context.Context, r
 	if units == nil {
 		duration = time.Duration(val * int64(v.defaultUnit))
 	} else {
-		switch aws.StringValue(flex.StringFromFramework(ctx, unitF(units))) {
-		case cognitoidentityprovider.TimeUnitsTypeSeconds:
-			duration = time.Duration(val * int64(time.Second))
-		case cognitoidentityprovider.TimeUnitsTypeMinutes:
-			duration = time.Duration(val * int64(time.Minute))
-		case cognitoidentityprovider.TimeUnitsTypeHours:
-			duration = time.Duration(val * int64(time.Hour))
-		case cognitoidentityprovider.TimeUnitsTypeDays:
-			duration = time.Duration(val * 24 * int64(time.Hour))
-		}
+		duration = tokenValidityDuration(val, aws.StringValue(flex.StringFromFramework(ctx, unitF(units))))
 	}
 
 	if duration < v.min || duration > v.max {
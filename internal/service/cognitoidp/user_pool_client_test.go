@@ -10,8 +10,10 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -22,6 +24,77 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestUserPoolClientEquivalentTokenValidity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priorValidity types.Int64
+		priorUnit     types.String
+		validity      int64
+		unit          string
+		wantValidity  int64
+		wantUnit      string
+	}{
+		"no prior state": {
+			priorValidity: types.Int64Null(),
+			priorUnit:     types.StringNull(),
+			validity:      60,
+			unit:          cognitoidentityprovider.TimeUnitsTypeMinutes,
+			wantValidity:  60,
+			wantUnit:      cognitoidentityprovider.TimeUnitsTypeMinutes,
+		},
+		"same representation": {
+			priorValidity: types.Int64Value(1),
+			priorUnit:     types.StringValue(cognitoidentityprovider.TimeUnitsTypeHours),
+			validity:      1,
+			unit:          cognitoidentityprovider.TimeUnitsTypeHours,
+			wantValidity:  1,
+			wantUnit:      cognitoidentityprovider.TimeUnitsTypeHours,
+		},
+		"hours to minutes": {
+			priorValidity: types.Int64Value(1),
+			priorUnit:     types.StringValue(cognitoidentityprovider.TimeUnitsTypeHours),
+			validity:      60,
+			unit:          cognitoidentityprovider.TimeUnitsTypeMinutes,
+			wantValidity:  1,
+			wantUnit:      cognitoidentityprovider.TimeUnitsTypeHours,
+		},
+		"days to hours": {
+			priorValidity: types.Int64Value(30),
+			priorUnit:     types.StringValue(cognitoidentityprovider.TimeUnitsTypeDays),
+			validity:      720,
+			unit:          cognitoidentityprovider.TimeUnitsTypeHours,
+			wantValidity:  30,
+			wantUnit:      cognitoidentityprovider.TimeUnitsTypeDays,
+		},
+		"different duration": {
+			priorValidity: types.Int64Value(1),
+			priorUnit:     types.StringValue(cognitoidentityprovider.TimeUnitsTypeHours),
+			validity:      30,
+			unit:          cognitoidentityprovider.TimeUnitsTypeMinutes,
+			wantValidity:  30,
+			wantUnit:      cognitoidentityprovider.TimeUnitsTypeMinutes,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotValidity, gotUnit := tfcognitoidp.EquivalentTokenValidity(testCase.priorValidity, testCase.priorUnit, aws.Int64(testCase.validity), aws.String(testCase.unit))
+
+			if got, want := aws.Int64Value(gotValidity), testCase.wantValidity; got != want {
+				t.Errorf("validity = %d, want %d", got, want)
+			}
+			if got, want := aws.StringValue(gotUnit), testCase.wantUnit; got != want {
+				t.Errorf("unit = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccCognitoIDPUserPoolClient_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var client cognitoidentityprovider.UserPoolClientType
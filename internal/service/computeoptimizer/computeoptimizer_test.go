@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccComputeOptimizer_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			"basic": testAccEnrollmentStatus_basic,
+		},
+		"RecommendationPreferences": {
+			"basic":      testAccRecommendationPreferences_basic,
+			"disappears": testAccRecommendationPreferences_disappears,
+			"update":     testAccRecommendationPreferences_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
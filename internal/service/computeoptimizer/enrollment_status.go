@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Enrollment Status")
+func newEnrollmentStatusResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &enrollmentStatusResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type enrollmentStatusResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*enrollmentStatusResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_enrollment_status"
+}
+
+func (r *enrollmentStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"number_of_member_accounts_opted_in": schema.Int64Attribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusInactive)...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *enrollmentStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	if err := updateEnrollmentStatus(ctx, conn, awstypes.Status(data.Status.ValueString()), data.IncludeMemberAccounts.ValueBool(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError("creating Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+
+	data.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+
+	data.IncludeMemberAccounts = types.BoolValue(output.MemberAccountsEnrolled)
+	data.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+	data.Status = types.StringValue(string(output.Status))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	if !new.IncludeMemberAccounts.Equal(old.IncludeMemberAccounts) || !new.Status.Equal(old.Status) {
+		if err := updateEnrollmentStatus(ctx, conn, awstypes.Status(new.Status.ValueString()), new.IncludeMemberAccounts.ValueBool(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError("updating Compute Optimizer Enrollment Status", err.Error())
+
+			return
+		}
+	}
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+
+	new.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *enrollmentStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	// Opt out of Compute Optimizer.
+	err := updateEnrollmentStatus(ctx, conn, awstypes.StatusInactive, data.IncludeMemberAccounts.ValueBool(), r.DeleteTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError("deleting Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+}
+
+func updateEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client, status awstypes.Status, includeMemberAccounts bool, timeout time.Duration) error {
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: includeMemberAccounts,
+		Status:                status,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitEnrollmentStatusUpdated(ctx, conn, status, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+
+	output, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnrollmentStatus(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, status awstypes.Status, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusPending),
+		Target:  enum.Slice(status),
+		Refresh: statusEnrollmentStatus(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type enrollmentStatusResourceModel struct {
+	ID                            types.String   `tfsdk:"id"`
+	IncludeMemberAccounts         types.Bool     `tfsdk:"include_member_accounts"`
+	NumberOfMemberAccountsOptedIn types.Int64    `tfsdk:"number_of_member_accounts_opted_in"`
+	Status                        types.String   `tfsdk:"status"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recommendation Preferences")
+func newRecommendationPreferencesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &recommendationPreferencesResource{}, nil
+}
+
+type recommendationPreferencesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*recommendationPreferencesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_preferences"
+}
+
+func (r *recommendationPreferencesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enhanced_infrastructure_metrics": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnhancedInfrastructureMetrics](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"inferred_workload_types": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InferredWorkloadTypesPreference](),
+				Optional:   true,
+			},
+			"resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"savings_estimation_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SavingsEstimationMode](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"scope": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scopeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ScopeName](),
+							Required:   true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recommendationPreferencesResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("enhanced_infrastructure_metrics"),
+			path.MatchRoot("inferred_workload_types"),
+			path.MatchRoot("savings_estimation_mode"),
+		),
+	}
+}
+
+func (r *recommendationPreferencesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := data.expandScope(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putRecommendationPreferences(ctx, conn, &data, scope); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer Recommendation Preferences (%s)", data.ResourceType.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID(scope)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(ctx); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := data.expandScope(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findRecommendationPreferencesByTwoPartKey(ctx, conn, data.ResourceType.ValueEnum(), scope)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.EnhancedInfrastructureMetrics = flattenRecommendationPreference(output.EnhancedInfrastructureMetrics)
+	data.InferredWorkloadTypes = flattenRecommendationPreference(output.InferredWorkloadTypes)
+	data.SavingsEstimationMode = flattenRecommendationPreference(output.SavingsEstimationMode)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recommendationPreferencesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := new.expandScope(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putRecommendationPreferences(ctx, conn, &new, scope); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Remove any preferences that are no longer configured.
+	var preferenceNames []awstypes.RecommendationPreferenceName
+	if !old.EnhancedInfrastructureMetrics.IsNull() && new.EnhancedInfrastructureMetrics.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameEnhancedInfrastructureMetrics)
+	}
+	if !old.InferredWorkloadTypes.IsNull() && new.InferredWorkloadTypes.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameInferredWorkloadTypes)
+	}
+
+	if err := deleteRecommendationPreferences(ctx, conn, new.ResourceType.ValueEnum(), scope, preferenceNames); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Recommendation Preferences (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recommendationPreferencesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recommendationPreferencesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	scope, diags := data.expandScope(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The savings estimation mode can't be deleted, only changed.
+	var preferenceNames []awstypes.RecommendationPreferenceName
+	if !data.EnhancedInfrastructureMetrics.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameEnhancedInfrastructureMetrics)
+	}
+	if !data.InferredWorkloadTypes.IsNull() {
+		preferenceNames = append(preferenceNames, awstypes.RecommendationPreferenceNameInferredWorkloadTypes)
+	}
+
+	err := deleteRecommendationPreferences(ctx, conn, data.ResourceType.ValueEnum(), scope, preferenceNames)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Recommendation Preferences (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putRecommendationPreferences(ctx context.Context, conn *computeoptimizer.Client, data *recommendationPreferencesResourceModel, scope *awstypes.Scope) error {
+	input := &computeoptimizer.PutRecommendationPreferencesInput{
+		EnhancedInfrastructureMetrics: data.EnhancedInfrastructureMetrics.ValueEnum(),
+		InferredWorkloadTypes:         data.InferredWorkloadTypes.ValueEnum(),
+		ResourceType:                  data.ResourceType.ValueEnum(),
+		SavingsEstimationMode:         data.SavingsEstimationMode.ValueEnum(),
+		Scope:                         scope,
+	}
+
+	_, err := conn.PutRecommendationPreferences(ctx, input)
+
+	return err
+}
+
+func deleteRecommendationPreferences(ctx context.Context, conn *computeoptimizer.Client, resourceType awstypes.ResourceType, scope *awstypes.Scope, preferenceNames []awstypes.RecommendationPreferenceName) error {
+	if len(preferenceNames) == 0 {
+		return nil
+	}
+
+	input := &computeoptimizer.DeleteRecommendationPreferencesInput{
+		RecommendationPreferenceNames: preferenceNames,
+		ResourceType:                  resourceType,
+		Scope:                         scope,
+	}
+
+	_, err := conn.DeleteRecommendationPreferences(ctx, input)
+
+	return err
+}
+
+func findRecommendationPreferencesByTwoPartKey(ctx context.Context, conn *computeoptimizer.Client, resourceType awstypes.ResourceType, scope *awstypes.Scope) (*awstypes.RecommendationPreferencesDetail, error) {
+	input := &computeoptimizer.GetRecommendationPreferencesInput{
+		ResourceType: resourceType,
+		Scope:        scope,
+	}
+
+	output, err := findRecommendationPreferences(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findRecommendationPreferences(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetRecommendationPreferencesInput) ([]*awstypes.RecommendationPreferencesDetail, error) {
+	var output []*awstypes.RecommendationPreferencesDetail
+
+	pages := computeoptimizer.NewGetRecommendationPreferencesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RecommendationPreferencesDetails {
+			v := v
+			output = append(output, &v)
+		}
+	}
+
+	return output, nil
+}
+
+// flattenRecommendationPreference returns a null value for preferences that aren't set.
+func flattenRecommendationPreference[T enum.Valueser[T]](v T) fwtypes.StringEnum[T] {
+	if v == "" {
+		return fwtypes.StringEnumNull[T]()
+	}
+
+	return fwtypes.StringEnumValue(v)
+}
+
+const (
+	recommendationPreferencesResourceIDPartCount = 3
+)
+
+type recommendationPreferencesResourceModel struct {
+	EnhancedInfrastructureMetrics fwtypes.StringEnum[awstypes.EnhancedInfrastructureMetrics]   `tfsdk:"enhanced_infrastructure_metrics"`
+	ID                            types.String                                                 `tfsdk:"id"`
+	InferredWorkloadTypes         fwtypes.StringEnum[awstypes.InferredWorkloadTypesPreference] `tfsdk:"inferred_workload_types"`
+	ResourceType                  fwtypes.StringEnum[awstypes.ResourceType]                    `tfsdk:"resource_type"`
+	SavingsEstimationMode         fwtypes.StringEnum[awstypes.SavingsEstimationMode]           `tfsdk:"savings_estimation_mode"`
+	Scope                         fwtypes.ListNestedObjectValueOf[scopeModel]                  `tfsdk:"scope"`
+}
+
+type scopeModel struct {
+	Name  fwtypes.StringEnum[awstypes.ScopeName] `tfsdk:"name"`
+	Value types.String                           `tfsdk:"value"`
+}
+
+func (data *recommendationPreferencesResourceModel) InitFromID(ctx context.Context) error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), recommendationPreferencesResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ResourceType = fwtypes.StringEnumValue(awstypes.ResourceType(parts[0]))
+	data.Scope = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &scopeModel{
+		Name:  fwtypes.StringEnumValue(awstypes.ScopeName(parts[1])),
+		Value: types.StringValue(parts[2]),
+	})
+
+	return nil
+}
+
+func (data *recommendationPreferencesResourceModel) setID(scope *awstypes.Scope) {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{string(data.ResourceType.ValueEnum()), string(scope.Name), aws.ToString(scope.Value)}, recommendationPreferencesResourceIDPartCount, false)))
+}
+
+func (data *recommendationPreferencesResourceModel) expandScope(ctx context.Context) (*awstypes.Scope, diag.Diagnostics) {
+	v, diags := data.Scope.ToPtr(ctx)
+
+	if diags.HasError() || v == nil {
+		return nil, diags
+	}
+
+	return &awstypes.Scope{
+		Name:  v.Name.ValueEnum(),
+		Value: v.Value.ValueStringPointer(),
+	}, diags
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", string(awstypes.EnhancedInfrastructureMetricsActive)),
+					resource.TestCheckNoResourceAttr(resourceName, "inferred_workload_types"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", string(awstypes.ResourceTypeEc2Instance)),
+					resource.TestCheckNoResourceAttr(resourceName, "savings_estimation_mode"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", string(awstypes.ScopeNameAccountId)),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RecommendationPreferencesDetail
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", string(awstypes.EnhancedInfrastructureMetricsActive)),
+					resource.TestCheckNoResourceAttr(resourceName, "inferred_workload_types"),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_updated(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "enhanced_infrastructure_metrics"),
+					resource.TestCheckResourceAttr(resourceName, "inferred_workload_types", string(awstypes.InferredWorkloadTypesPreferenceInactive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			_, err := tfcomputeoptimizer.FindRecommendationPreferencesByTwoPartKey(ctx, conn, awstypes.ResourceType(rs.Primary.Attributes["resource_type"]), &awstypes.Scope{
+				Name:  awstypes.ScopeName(rs.Primary.Attributes["scope.0.name"]),
+				Value: aws.String(rs.Primary.Attributes["scope.0.value"]),
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string, v *awstypes.RecommendationPreferencesDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindRecommendationPreferencesByTwoPartKey(ctx, conn, awstypes.ResourceType(rs.Primary.Attributes["resource_type"]), &awstypes.Scope{
+			Name:  awstypes.ScopeName(rs.Primary.Attributes["scope.0.name"]),
+			Value: aws.String(rs.Primary.Attributes["scope.0.value"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccRecommendationPreferencesConfig_base = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = "Active"
+}
+`
+
+func testAccRecommendationPreferencesConfig_basic() string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base, `
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  enhanced_infrastructure_metrics = "Active"
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`)
+}
+
+func testAccRecommendationPreferencesConfig_updated() string {
+	return acctest.ConfigCompose(testAccRecommendationPreferencesConfig_base, `
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  inferred_workload_types = "Inactive"
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test]
+}
+`)
+}
@@ -30,8 +30,10 @@ func TestAccConnect_serial(t *testing.T) {
 			"basic":           testAccContactFlowModule_basic,
 			"disappears":      testAccContactFlowModule_disappears,
 			"filename":        testAccContactFlowModule_filename,
+			"invalidContent":  testAccContactFlowModule_invalidContent,
 			"dataSource_id":   testAccContactFlowModuleDataSource_contactFlowModuleID,
 			"dataSource_name": testAccContactFlowModuleDataSource_name,
+			"dataSource_list": testAccContactFlowModulesDataSource_basic,
 		},
 		"HoursOfOperation": {
 			"basic":           testAccHoursOfOperation_basic,
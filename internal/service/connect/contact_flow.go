@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +39,10 @@ func ResourceContactFlow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			contactFlowContentCustomizeDiff(resourceContactFlowLoadFileContent),
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -293,3 +297,39 @@ func resourceContactFlowLoadFileContent(filename string) (string, error) {
 	}
 	return string(fileContent), nil
 }
+
+// contactFlowContentCustomizeDiff validates new flow or flow module content, from either the
+// content argument or the file referenced by the filename argument, at plan time.
+func contactFlowContentCustomizeDiff(loadFileContent func(string) (string, error)) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChanges("content", "content_hash", "filename") {
+			return nil
+		}
+
+		var content string
+
+		if v, ok := d.GetOk("filename"); ok {
+			filename := v.(string)
+			file, err := loadFileContent(filename)
+
+			// The file may not exist until apply time.
+			if err != nil {
+				return nil
+			}
+
+			content = file
+		} else if d.NewValueKnown("content") && d.HasChange("content") {
+			content = d.Get("content").(string)
+		}
+
+		if content == "" {
+			return nil
+		}
+
+		if err := validContactFlowContent(content); err != nil {
+			return fmt.Errorf("invalid content: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -211,6 +212,25 @@ func testAccCheckContactFlowModuleDestroy(ctx context.Context) resource.TestChec
 	}
 }
 
+func testAccContactFlowModule_invalidContent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactFlowModuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContactFlowModuleConfig_invalidContent(rName, rName2),
+				ExpectError: regexache.MustCompile(`NextAction "does-not-exist" does not reference an Action`),
+			},
+		},
+	})
+}
+
 func testAccContactFlowModuleConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_connect_instance" "test" {
@@ -300,3 +320,31 @@ resource "aws_connect_contact_flow_module" "test" {
 }
 `, rName2, label, filepath))
 }
+
+func testAccContactFlowModuleConfig_invalidContent(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowModuleConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow_module" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  content = jsonencode({
+    Version     = "2019-10-30"
+    StartAction = "12345678-1234-1234-1234-123456789012"
+    Actions = [
+      {
+        Identifier = "12345678-1234-1234-1234-123456789012"
+        Type       = "MessageParticipant"
+        Parameters = {
+          Text = "Hello contact flow module"
+        }
+        Transitions = {
+          NextAction = "does-not-exist"
+        }
+      },
+    ]
+  })
+}
+`, rName2))
+}
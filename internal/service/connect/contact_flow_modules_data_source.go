@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_connect_contact_flow_modules", name="Contact Flow Modules")
+func DataSourceContactFlowModules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContactFlowModulesRead,
+		Schema: map[string]*schema.Schema{
+			"contact_flow_module_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowModuleState_Values(), false),
+			},
+			"contact_flow_modules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"contact_flow_module_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceContactFlowModulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListContactFlowModulesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListContactFlowModulesMaxResults),
+	}
+
+	if v, ok := d.GetOk("contact_flow_module_state"); ok {
+		input.ContactFlowModuleState = aws.String(v.(string))
+	}
+
+	var summaries []*connect.ContactFlowModuleSummary
+
+	err := conn.ListContactFlowModulesPagesWithContext(ctx, input, func(page *connect.ListContactFlowModulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContactFlowModulesSummaryList {
+			if v != nil {
+				summaries = append(summaries, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Connect Contact Flow Modules (%s): %s", instanceID, err)
+	}
+
+	contactFlowModules := make([]interface{}, 0, len(summaries))
+
+	for _, summary := range summaries {
+		output, err := conn.DescribeContactFlowModuleWithContext(ctx, &connect.DescribeContactFlowModuleInput{
+			ContactFlowModuleId: summary.Id,
+			InstanceId:          aws.String(instanceID),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Contact Flow Module (%s): %s", aws.StringValue(summary.Id), err)
+		}
+
+		if output == nil || output.ContactFlowModule == nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Contact Flow Module (%s): empty response", aws.StringValue(summary.Id))
+		}
+
+		contactFlowModules = append(contactFlowModules, flattenContactFlowModule(output.ContactFlowModule))
+	}
+
+	d.SetId(instanceID)
+
+	if err := d.Set("contact_flow_modules", contactFlowModules); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting contact_flow_modules: %s", err)
+	}
+
+	return diags
+}
+
+func flattenContactFlowModule(apiObject *connect.ContactFlowModule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                    aws.StringValue(apiObject.Arn),
+		"contact_flow_module_id": aws.StringValue(apiObject.Id),
+		"content":                aws.StringValue(apiObject.Content),
+		"description":            aws.StringValue(apiObject.Description),
+		"name":                   aws.StringValue(apiObject.Name),
+		"state":                  aws.StringValue(apiObject.State),
+		"status":                 aws.StringValue(apiObject.Status),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccContactFlowModulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_contact_flow_module.test"
+	datasourceName := "data.aws_connect_contact_flow_modules.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactFlowModulesDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "instance_id"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "contact_flow_modules.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "contact_flow_modules.*.contact_flow_module_id", resourceName, "contact_flow_module_id"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "contact_flow_modules.*.content", resourceName, "content"),
+					resource.TestCheckTypeSetElemAttrPair(datasourceName, "contact_flow_modules.*.name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactFlowModulesDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowModuleBaseDataSourceConfig(rName, rName2),
+		`
+data "aws_connect_contact_flow_modules" "test" {
+  instance_id               = aws_connect_instance.test.id
+  contact_flow_module_state = "ACTIVE"
+
+  depends_on = [aws_connect_contact_flow_module.test]
+}
+`)
+}
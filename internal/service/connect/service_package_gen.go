@@ -37,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContactFlowModule,
 			TypeName: "aws_connect_contact_flow_module",
 		},
+		{
+			Factory:  DataSourceContactFlowModules,
+			TypeName: "aws_connect_contact_flow_modules",
+			Name:     "Contact Flow Modules",
+		},
 		{
 			Factory:  DataSourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
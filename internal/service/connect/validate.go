@@ -4,6 +4,8 @@
 package connect
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
@@ -24,3 +26,90 @@ func validPhoneNumberPrefix(v interface{}, k string) (ws []string, errors []erro
 	}
 	return
 }
+
+// contactFlowContent is the subset of the Amazon Connect Flow language that is validated at plan time.
+// See https://docs.aws.amazon.com/connect/latest/APIReference/flow-language.html.
+type contactFlowContent struct {
+	Actions     []contactFlowContentAction `json:"Actions"`
+	StartAction string                     `json:"StartAction"`
+	Version     string                     `json:"Version"`
+}
+
+type contactFlowContentAction struct {
+	Identifier  string `json:"Identifier"`
+	Transitions struct {
+		Conditions []struct {
+			NextAction string `json:"NextAction"`
+		} `json:"Conditions"`
+		Errors []struct {
+			NextAction string `json:"NextAction"`
+		} `json:"Errors"`
+		NextAction string `json:"NextAction"`
+	} `json:"Transitions"`
+	Type string `json:"Type"`
+}
+
+// validContactFlowContent checks that the specified flow or flow module content is well-formed
+// so that errors are reported at plan time rather than by the Amazon Connect API at apply time.
+func validContactFlowContent(v string) error {
+	var content contactFlowContent
+
+	if err := json.Unmarshal([]byte(v), &content); err != nil {
+		return fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	var errs []error
+
+	if content.Version == "" {
+		errs = append(errs, errors.New("Version is required"))
+	}
+
+	if len(content.Actions) == 0 {
+		errs = append(errs, errors.New("at least one Action is required"))
+	}
+
+	identifiers := make(map[string]struct{}, len(content.Actions))
+	for i, action := range content.Actions {
+		if action.Identifier == "" {
+			errs = append(errs, fmt.Errorf("Actions[%d]: Identifier is required", i))
+			continue
+		}
+
+		if _, ok := identifiers[action.Identifier]; ok {
+			errs = append(errs, fmt.Errorf("Actions[%d]: duplicate Identifier %q", i, action.Identifier))
+		}
+		identifiers[action.Identifier] = struct{}{}
+
+		if action.Type == "" {
+			errs = append(errs, fmt.Errorf("Actions[%d] (%s): Type is required", i, action.Identifier))
+		}
+	}
+
+	if content.StartAction == "" {
+		errs = append(errs, errors.New("StartAction is required"))
+	} else if _, ok := identifiers[content.StartAction]; !ok && len(content.Actions) > 0 {
+		errs = append(errs, fmt.Errorf("StartAction %q does not reference an Action", content.StartAction))
+	}
+
+	for _, action := range content.Actions {
+		nextActions := []string{action.Transitions.NextAction}
+		for _, v := range action.Transitions.Conditions {
+			nextActions = append(nextActions, v.NextAction)
+		}
+		for _, v := range action.Transitions.Errors {
+			nextActions = append(nextActions, v.NextAction)
+		}
+
+		for _, nextAction := range nextActions {
+			if nextAction == "" {
+				continue
+			}
+
+			if _, ok := identifiers[nextAction]; !ok {
+				errs = append(errs, fmt.Errorf("Action %q: NextAction %q does not reference an Action", action.Identifier, nextAction))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -62,3 +62,33 @@ func TestValidPhoneNumberPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContactFlowContent(t *testing.T) {
+	t.Parallel()
+
+	validContents := []string{
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"NextAction":"b","Errors":[],"Conditions":[]}},{"Identifier":"b","Type":"DisconnectParticipant","Transitions":{}}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant","Transitions":{}}],"Settings":{"InputParameters":[],"OutputParameters":[],"Transitions":[]}}`,
+	}
+	for _, v := range validContents {
+		if err := validContactFlowContent(v); err != nil {
+			t.Fatalf("%q should be valid contact flow content: %s", v, err)
+		}
+	}
+
+	invalidContents := []string{
+		`not JSON`,
+		`{}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[]}`,
+		`{"Version":"2019-10-30","StartAction":"x","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"},{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a"}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"NextAction":"b"}}]}`,
+		`{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"Errors":[{"NextAction":"b","ErrorType":"NoMatchingError"}]}}]}`,
+	}
+	for _, v := range invalidContents {
+		if err := validContactFlowContent(v); err == nil {
+			t.Fatalf("%q should be invalid contact flow content", v)
+		}
+	}
+}
@@ -268,24 +268,28 @@ func ResourceTask() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"deleted_override": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										DiffSuppressFunc: suppressReportOverrideMatchingReportLevel,
 									},
 									"skipped_override": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										DiffSuppressFunc: suppressReportOverrideMatchingReportLevel,
 									},
 									"transferred_override": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										DiffSuppressFunc: suppressReportOverrideMatchingReportLevel,
 									},
 									"verified_override": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringInSlice(datasync.ReportLevel_Values(), false),
+										DiffSuppressFunc: suppressReportOverrideMatchingReportLevel,
 									},
 								},
 							},
@@ -556,10 +560,13 @@ func flattenTaskReportConfig(options *datasync.TaskReportConfig) []interface{} {
 		"s3_object_versioning": aws.StringValue(options.ObjectVersionIds),
 		"output_type":          aws.StringValue(options.OutputType),
 		"report_level":         aws.StringValue(options.ReportLevel),
-		"s3_destination":       flattenTaskReportConfigS3Destination(options.Destination.S3),
 		"report_overrides":     flattenTaskReportConfigReportOverrides(options.Overrides),
 	}
 
+	if options.Destination != nil {
+		m["s3_destination"] = flattenTaskReportConfigS3Destination(options.Destination.S3)
+	}
+
 	return []interface{}{m}
 }
 
@@ -663,6 +670,12 @@ func flattenTaskSchedule(schedule *datasync.TaskSchedule) []interface{} {
 	return []interface{}{m}
 }
 
+// suppressReportOverrideMatchingReportLevel suppresses the difference for a report override
+// that matches the report level, as DataSync doesn't return such overrides.
+func suppressReportOverrideMatchingReportLevel(k, old, new string, d *schema.ResourceData) bool {
+	return old == "" && new == d.Get("task_report_config.0.report_level").(string)
+}
+
 func expandTaskReportConfig(l []interface{}) *datasync.TaskReportConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
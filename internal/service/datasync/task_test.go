@@ -819,7 +819,7 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 		CheckDestroy:             testAccCheckTaskDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTaskConfig_taskReportConfig(rName),
+				Config: testAccTaskConfig_taskReportConfig(rName, "ERRORS_ONLY"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTaskExists(ctx, resourceName, &task1),
 					resource.TestCheckResourceAttr(resourceName, "task_report_config.#", "1"),
@@ -842,6 +842,14 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTaskConfig_taskReportConfig(rName, "SUCCESSES_AND_ERRORS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_level", "SUCCESSES_AND_ERRORS"),
+				),
+			},
 		},
 	})
 }
@@ -1512,7 +1520,7 @@ resource "aws_datasync_task" "test" {
 `, rName, key1, value1, key2, value2))
 }
 
-func testAccTaskConfig_taskReportConfig(rName string) string {
+func testAccTaskConfig_taskReportConfig(rName, override string) string {
 	return acctest.ConfigCompose(
 		testAccTaskConfig_baseLocationS3(rName),
 		testAccTaskConfig_baseLocationNFS(rName),
@@ -1571,15 +1579,15 @@ resource "aws_datasync_task" "test" {
       subdirectory           = "test/"
     }
     report_overrides {
-      deleted_override     = "ERRORS_ONLY"
-      skipped_override     = "ERRORS_ONLY"
-      transferred_override = "ERRORS_ONLY"
-      verified_override    = "ERRORS_ONLY"
+      deleted_override     = %[2]q
+      skipped_override     = %[2]q
+      transferred_override = %[2]q
+      verified_override    = %[2]q
     }
     s3_object_versioning = "INCLUDE"
     output_type          = "STANDARD"
     report_level         = "SUCCESSES_AND_ERRORS"
   }
 }
-`, rName))
+`, rName, override))
 }
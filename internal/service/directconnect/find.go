@@ -301,3 +301,49 @@ func FindLocations(ctx context.Context, conn *directconnect.DirectConnect, input
 
 	return output.Locations, nil
 }
+
+func FindGatewayAttachments(ctx context.Context, conn *directconnect.DirectConnect, input *directconnect.DescribeDirectConnectGatewayAttachmentsInput) ([]*directconnect.GatewayAttachment, error) {
+	var output []*directconnect.GatewayAttachment
+
+	err := describeGatewayAttachmentsPages(ctx, conn, input, func(page *directconnect.DescribeDirectConnectGatewayAttachmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DirectConnectGatewayAttachments {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindVirtualInterfaces(ctx context.Context, conn *directconnect.DirectConnect, input *directconnect.DescribeVirtualInterfacesInput) ([]*directconnect.VirtualInterface, error) {
+	output, err := conn.DescribeVirtualInterfacesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var virtualInterfaces []*directconnect.VirtualInterface
+
+	for _, v := range output.VirtualInterfaces {
+		if v != nil {
+			virtualInterfaces = append(virtualInterfaces, v)
+		}
+	}
+
+	return virtualInterfaces, nil
+}
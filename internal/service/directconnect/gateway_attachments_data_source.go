@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_dx_gateway_attachments")
+func DataSourceGatewayAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGatewayAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attachment_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attachment_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dx_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_interface_owner_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_interface_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dx_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"dx_gateway_id", "virtual_interface_id"},
+			},
+			"virtual_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"dx_gateway_id", "virtual_interface_id"},
+			},
+			"virtual_interface_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+		},
+	}
+}
+
+func dataSourceGatewayAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	input := &directconnect.DescribeDirectConnectGatewayAttachmentsInput{}
+
+	if v, ok := d.GetOk("dx_gateway_id"); ok {
+		input.DirectConnectGatewayId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("virtual_interface_id"); ok {
+		input.VirtualInterfaceId = aws.String(v.(string))
+	}
+
+	output, err := FindGatewayAttachments(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Gateway Attachments: %s", err)
+	}
+
+	ownerAccountID := d.Get("virtual_interface_owner_account_id").(string)
+
+	var tfList []interface{}
+
+	for _, v := range output {
+		if ownerAccountID != "" && aws.StringValue(v.VirtualInterfaceOwnerAccount) != ownerAccountID {
+			continue
+		}
+
+		tfList = append(tfList, flattenGatewayAttachment(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("attachments", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments: %s", err)
+	}
+
+	return diags
+}
+
+func flattenGatewayAttachment(apiObject *directconnect.GatewayAttachment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attachment_state":                   aws.StringValue(apiObject.AttachmentState),
+		"attachment_type":                    aws.StringValue(apiObject.AttachmentType),
+		"dx_gateway_id":                      aws.StringValue(apiObject.DirectConnectGatewayId),
+		"virtual_interface_id":               aws.StringValue(apiObject.VirtualInterfaceId),
+		"virtual_interface_owner_account_id": aws.StringValue(apiObject.VirtualInterfaceOwnerAccount),
+		"virtual_interface_region":           aws.StringValue(apiObject.VirtualInterfaceRegion),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDirectConnectGatewayAttachmentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dx_gateway_attachments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAttachmentsDataSourceConfig_basic(rName, sdkacctest.RandIntRange(64512, 65534)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDirectConnectGatewayAttachmentsDataSource_virtualInterface(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_dx_gateway_attachments.test"
+	gatewayResourceName := "aws_dx_gateway.test"
+	vifResourceName := "aws_dx_private_virtual_interface.test"
+	rName := fmt.Sprintf("tf-testacc-private-vif-%s", sdkacctest.RandString(9))
+	amzAsn := sdkacctest.RandIntRange(64512, 65534)
+	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	vlan := sdkacctest.RandIntRange(2049, 4094)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAttachmentsDataSourceConfig_virtualInterface(connectionId, rName, amzAsn, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.dx_gateway_id", gatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.virtual_interface_id", vifResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.virtual_interface_owner_account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "attachments.0.attachment_state"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "attachments.0.virtual_interface_region"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewayAttachmentsDataSourceConfig_basic(rName string, amzAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  amazon_side_asn = %[2]d
+  name            = %[1]q
+}
+
+data "aws_dx_gateway_attachments" "test" {
+  dx_gateway_id = aws_dx_gateway.test.id
+}
+`, rName, amzAsn)
+}
+
+func testAccGatewayAttachmentsDataSourceConfig_virtualInterface(cid, rName string, amzAsn, bgpAsn, vlan int) string {
+	return acctest.ConfigCompose(testAccPrivateVirtualInterfaceConfig_gateway(cid, rName, amzAsn, bgpAsn, vlan), `
+data "aws_caller_identity" "current" {}
+
+data "aws_dx_gateway_attachments" "test" {
+  dx_gateway_id                      = aws_dx_private_virtual_interface.test.dx_gateway_id
+  virtual_interface_owner_account_id = data.aws_caller_identity.current.account_id
+}
+`)
+}
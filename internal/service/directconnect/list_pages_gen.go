@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectConnectGateways,DescribeDirectConnectGatewayAssociations,DescribeDirectConnectGatewayAssociationProposals"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectConnectGateways,DescribeDirectConnectGatewayAssociations,DescribeDirectConnectGatewayAssociationProposals,DescribeDirectConnectGatewayAttachments"; DO NOT EDIT.
 
 package directconnect
 
@@ -42,6 +42,22 @@ func describeGatewayAssociationsPages(ctx context.Context, conn directconnectifa
 	}
 	return nil
 }
+func describeGatewayAttachmentsPages(ctx context.Context, conn directconnectiface.DirectConnectAPI, input *directconnect.DescribeDirectConnectGatewayAttachmentsInput, fn func(*directconnect.DescribeDirectConnectGatewayAttachmentsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeDirectConnectGatewayAttachmentsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeGatewaysPages(ctx context.Context, conn directconnectiface.DirectConnectAPI, input *directconnect.DescribeDirectConnectGatewaysInput, fn func(*directconnect.DescribeDirectConnectGatewaysOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeDirectConnectGatewaysWithContext(ctx, input)
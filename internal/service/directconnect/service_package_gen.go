@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceGateway,
 			TypeName: "aws_dx_gateway",
 		},
+		{
+			Factory:  DataSourceGatewayAttachments,
+			TypeName: "aws_dx_gateway_attachments",
+		},
 		{
 			Factory:  DataSourceLocation,
 			TypeName: "aws_dx_location",
@@ -45,6 +49,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceRouterConfiguration,
 			TypeName: "aws_dx_router_configuration",
 		},
+		{
+			Factory:  DataSourceVirtualInterfaces,
+			TypeName: "aws_dx_virtual_interfaces",
+		},
 	}
 }
 
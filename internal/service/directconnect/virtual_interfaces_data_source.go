@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_dx_virtual_interfaces")
+func DataSourceVirtualInterfaces() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVirtualInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"virtual_interface_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"private", "public", "transit"}, false),
+			},
+			"virtual_interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_side_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bgp_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"connection_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dx_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpn_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	input := &directconnect.DescribeVirtualInterfacesInput{}
+
+	if v, ok := d.GetOk("connection_id"); ok {
+		input.ConnectionId = aws.String(v.(string))
+	}
+
+	output, err := FindVirtualInterfaces(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Virtual Interfaces: %s", err)
+	}
+
+	ownerAccountID := d.Get("owner_account_id").(string)
+	virtualInterfaceType := d.Get("virtual_interface_type").(string)
+
+	var virtualInterfaceIDs []string
+	var tfList []interface{}
+
+	for _, v := range output {
+		if ownerAccountID != "" && aws.StringValue(v.OwnerAccount) != ownerAccountID {
+			continue
+		}
+
+		if virtualInterfaceType != "" && aws.StringValue(v.VirtualInterfaceType) != virtualInterfaceType {
+			continue
+		}
+
+		virtualInterfaceIDs = append(virtualInterfaceIDs, aws.StringValue(v.VirtualInterfaceId))
+		tfList = append(tfList, flattenVirtualInterface(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", virtualInterfaceIDs)
+	if err := d.Set("virtual_interfaces", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting virtual_interfaces: %s", err)
+	}
+
+	return diags
+}
+
+func flattenVirtualInterface(apiObject *directconnect.VirtualInterface) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"amazon_side_asn":  strconv.FormatInt(aws.Int64Value(apiObject.AmazonSideAsn), 10),
+		"bgp_asn":          aws.Int64Value(apiObject.Asn),
+		"connection_id":    aws.StringValue(apiObject.ConnectionId),
+		"dx_gateway_id":    aws.StringValue(apiObject.DirectConnectGatewayId),
+		"id":               aws.StringValue(apiObject.VirtualInterfaceId),
+		"name":             aws.StringValue(apiObject.VirtualInterfaceName),
+		"owner_account_id": aws.StringValue(apiObject.OwnerAccount),
+		"region":           aws.StringValue(apiObject.Region),
+		"state":            aws.StringValue(apiObject.VirtualInterfaceState),
+		"type":             aws.StringValue(apiObject.VirtualInterfaceType),
+		"vlan":             aws.Int64Value(apiObject.Vlan),
+		"vpn_gateway_id":   aws.StringValue(apiObject.VirtualGatewayId),
+	}
+
+	return tfMap
+}
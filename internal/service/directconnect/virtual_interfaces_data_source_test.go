@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDirectConnectVirtualInterfacesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	dataSourceName := "data.aws_dx_virtual_interfaces.test"
+	resourceName := "aws_dx_private_virtual_interface.test"
+	rName := fmt.Sprintf("tf-testacc-private-vif-%s", sdkacctest.RandString(9))
+	amzAsn := sdkacctest.RandIntRange(64512, 65534)
+	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	vlan := sdkacctest.RandIntRange(2049, 4094)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualInterfacesDataSourceConfig_basic(connectionId, rName, amzAsn, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "virtual_interfaces.*", map[string]string{
+						"bgp_asn":       fmt.Sprint(bgpAsn),
+						"connection_id": connectionId,
+						"name":          rName,
+						"type":          "private",
+						"vlan":          fmt.Sprint(vlan),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualInterfacesDataSourceConfig_basic(cid, rName string, amzAsn, bgpAsn, vlan int) string {
+	return acctest.ConfigCompose(testAccPrivateVirtualInterfaceConfig_gateway(cid, rName, amzAsn, bgpAsn, vlan), `
+data "aws_caller_identity" "current" {}
+
+data "aws_dx_virtual_interfaces" "test" {
+  connection_id          = aws_dx_private_virtual_interface.test.connection_id
+  owner_account_id       = data.aws_caller_identity.current.account_id
+  virtual_interface_type = "private"
+}
+`)
+}
@@ -30,6 +30,18 @@ const (
 	replicationTaskStatusStopping  = "stopping"
 	replicationTaskStatusRunning   = "running"
 	replicationTaskStatusStarting  = "starting"
+
+	replicationTaskAssessmentRunStatusCancelled         = "cancelled"
+	replicationTaskAssessmentRunStatusCancelling        = "cancelling"
+	replicationTaskAssessmentRunStatusDeleting          = "deleting"
+	replicationTaskAssessmentRunStatusErrorExecuting    = "error-executing"
+	replicationTaskAssessmentRunStatusErrorProvisioning = "error-provisioning"
+	replicationTaskAssessmentRunStatusFailed            = "failed"
+	replicationTaskAssessmentRunStatusInvalidState      = "invalid state"
+	replicationTaskAssessmentRunStatusPassed            = "passed"
+	replicationTaskAssessmentRunStatusProvisioning      = "provisioning"
+	replicationTaskAssessmentRunStatusRunning           = "running"
+	replicationTaskAssessmentRunStatusStarting          = "starting"
 )
 
 const (
@@ -96,6 +108,22 @@ func engineName_Values() []string {
 	}
 }
 
+// Engines supported by data providers.
+func dataProviderEngine_Values() []string {
+	return []string{
+		engineNameAurora,
+		engineNameAuroraPostgresql,
+		engineNameDocDB,
+		engineNameMariadb,
+		engineNameMongodb,
+		engineNameMySQL,
+		engineNameOracle,
+		engineNamePostgres,
+		engineNameRedshift,
+		engineNameSQLServer,
+	}
+}
+
 const (
 	kafkaDefaultTopic = "kafka-default-topic"
 )
@@ -143,6 +171,19 @@ func encryptionMode_Values() []string {
 	}
 }
 
+// Replication task assessment runs use lowercase encryption mode values.
+const (
+	assessmentRunResultEncryptionModeSseKMS = "sse-kms"
+	assessmentRunResultEncryptionModeSseS3  = "sse-s3"
+)
+
+func assessmentRunResultEncryptionMode_Values() []string {
+	return []string{
+		assessmentRunResultEncryptionModeSseKMS,
+		assessmentRunResultEncryptionModeSseS3,
+	}
+}
+
 const (
 	replicationStatusCreated              = "created"
 	replicationStatusReady                = "ready"
@@ -0,0 +1,557 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_data_provider", name="Data Provider")
+// @Tags(identifierAttribute="id")
+func ResourceDataProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataProviderCreate,
+		ReadWithoutTimeout:   resourceDataProviderRead,
+		UpdateWithoutTimeout: resourceDataProviderUpdate,
+		DeleteWithoutTimeout: resourceDataProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_provider_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dataProviderEngine_Values(), false),
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"docdb_settings":                dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName),
+						"mariadb_settings":              dataProviderSettingsSchema(),
+						"microsoft_sql_server_settings": dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName),
+						"mongodb_settings":              dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName, dataProviderSettingsWithMongoDBAuth),
+						"mysql_settings":                dataProviderSettingsSchema(),
+						"oracle_settings":               dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName, dataProviderSettingsWithOracleSecrets),
+						"postgres_settings":             dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName),
+						"redshift_settings":             dataProviderSettingsSchema(dataProviderSettingsWithDatabaseName, dataProviderSettingsWithoutSSL),
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+type dataProviderSettingsOption int
+
+const (
+	dataProviderSettingsWithDatabaseName dataProviderSettingsOption = iota
+	dataProviderSettingsWithMongoDBAuth
+	dataProviderSettingsWithOracleSecrets
+	dataProviderSettingsWithoutSSL
+)
+
+// dataProviderSettingsSchema returns the schema for one engine's data provider settings.
+// All engines share the server_name, port and SSL attributes; the options add engine-specific attributes.
+func dataProviderSettingsSchema(options ...dataProviderSettingsOption) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"server_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	withSSL := true
+
+	for _, option := range options {
+		switch option {
+		case dataProviderSettingsWithDatabaseName:
+			s["database_name"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+		case dataProviderSettingsWithMongoDBAuth:
+			s["auth_mechanism"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mongoDBAuthMechanismValue_Values(), false),
+			}
+			s["auth_source"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			}
+			s["auth_type"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.AuthTypeValue_Values(), false),
+			}
+		case dataProviderSettingsWithOracleSecrets:
+			s["asm_server"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+			s["secrets_manager_oracle_asm_access_role_arn"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			}
+			s["secrets_manager_oracle_asm_secret_id"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+			s["secrets_manager_security_db_encryption_access_role_arn"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			}
+			s["secrets_manager_security_db_encryption_secret_id"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+		case dataProviderSettingsWithoutSSL:
+			withSSL = false
+		}
+	}
+
+	if withSSL {
+		s["certificate_arn"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		}
+		s["ssl_mode"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(dms.DmsSslModeValue_Values(), false),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func resourceDataProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	input := &dms.CreateDataProviderInput{
+		Engine: aws.String(d.Get("engine").(string)),
+		Tags:   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_provider_name"); ok {
+		input.DataProviderName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Settings = expandDataProviderSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDataProviderWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Data Provider: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DataProvider.DataProviderArn))
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	dataProvider, err := FindDataProviderByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Data Provider (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataProvider.DataProviderArn)
+	d.Set("data_provider_name", dataProvider.DataProviderName)
+	d.Set("description", dataProvider.Description)
+	d.Set("engine", dataProvider.Engine)
+	if err := d.Set("settings", flattenDataProviderSettings(dataProvider.Settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &dms.ModifyDataProviderInput{
+			DataProviderIdentifier: aws.String(d.Id()),
+			Description:            aws.String(d.Get("description").(string)),
+			Engine:                 aws.String(d.Get("engine").(string)),
+			// Replace rather than merge the settings so that removed attributes are cleared.
+			ExactSettings: aws.Bool(true),
+		}
+
+		if d.HasChange("data_provider_name") {
+			input.DataProviderName = aws.String(d.Get("data_provider_name").(string))
+		}
+
+		if v, ok := d.GetOk("settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Settings = expandDataProviderSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.ModifyDataProviderWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DMS Data Provider (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Data Provider: %s", d.Id())
+	_, err := conn.DeleteDataProviderWithContext(ctx, &dms.DeleteDataProviderInput{
+		DataProviderIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDataProviderByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.DataProvider, error) {
+	input := &dms.DescribeDataProvidersInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("data-provider-identifier"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findDataProvider(ctx, conn, input)
+}
+
+func findDataProvider(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeDataProvidersInput) (*dms.DataProvider, error) {
+	output, err := findDataProviders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findDataProviders(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeDataProvidersInput) ([]*dms.DataProvider, error) {
+	var output []*dms.DataProvider
+
+	err := conn.DescribeDataProvidersPagesWithContext(ctx, input, func(page *dms.DescribeDataProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataProviders {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandDataProviderSettings(tfMap map[string]interface{}) *dms.DataProviderSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.DataProviderSettings{}
+
+	if v, ok := tfMap["docdb_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DocDbSettings = &dms.DocDbDataProviderSettings{
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			DatabaseName:   expandOptionalString(tfMap, "database_name"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["mariadb_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MariaDbSettings = &dms.MariaDbDataProviderSettings{
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["microsoft_sql_server_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MicrosoftSqlServerSettings = &dms.MicrosoftSqlServerDataProviderSettings{
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			DatabaseName:   expandOptionalString(tfMap, "database_name"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["mongodb_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MongoDbSettings = &dms.MongoDbDataProviderSettings{
+			AuthMechanism:  expandOptionalString(tfMap, "auth_mechanism"),
+			AuthSource:     expandOptionalString(tfMap, "auth_source"),
+			AuthType:       expandOptionalString(tfMap, "auth_type"),
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			DatabaseName:   expandOptionalString(tfMap, "database_name"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["mysql_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MySqlSettings = &dms.MySqlDataProviderSettings{
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["oracle_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.OracleSettings = &dms.OracleDataProviderSettings{
+			AsmServer:                            expandOptionalString(tfMap, "asm_server"),
+			CertificateArn:                       expandOptionalString(tfMap, "certificate_arn"),
+			DatabaseName:                         expandOptionalString(tfMap, "database_name"),
+			Port:                                 expandOptionalInt64(tfMap, "port"),
+			SecretsManagerOracleAsmAccessRoleArn: expandOptionalString(tfMap, "secrets_manager_oracle_asm_access_role_arn"),
+			SecretsManagerOracleAsmSecretId:      expandOptionalString(tfMap, "secrets_manager_oracle_asm_secret_id"),
+			SecretsManagerSecurityDbEncryptionAccessRoleArn: expandOptionalString(tfMap, "secrets_manager_security_db_encryption_access_role_arn"),
+			SecretsManagerSecurityDbEncryptionSecretId:      expandOptionalString(tfMap, "secrets_manager_security_db_encryption_secret_id"),
+			ServerName: expandOptionalString(tfMap, "server_name"),
+			SslMode:    expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["postgres_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.PostgreSqlSettings = &dms.PostgreSqlDataProviderSettings{
+			CertificateArn: expandOptionalString(tfMap, "certificate_arn"),
+			DatabaseName:   expandOptionalString(tfMap, "database_name"),
+			Port:           expandOptionalInt64(tfMap, "port"),
+			ServerName:     expandOptionalString(tfMap, "server_name"),
+			SslMode:        expandOptionalString(tfMap, "ssl_mode"),
+		}
+	}
+
+	if v, ok := tfMap["redshift_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RedshiftSettings = &dms.RedshiftDataProviderSettings{
+			DatabaseName: expandOptionalString(tfMap, "database_name"),
+			Port:         expandOptionalInt64(tfMap, "port"),
+			ServerName:   expandOptionalString(tfMap, "server_name"),
+		}
+	}
+
+	return apiObject
+}
+
+func expandOptionalString(tfMap map[string]interface{}, key string) *string {
+	if v, ok := tfMap[key].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func expandOptionalInt64(tfMap map[string]interface{}, key string) *int64 {
+	if v, ok := tfMap[key].(int); ok && v != 0 {
+		return aws.Int64(int64(v))
+	}
+
+	return nil
+}
+
+func flattenDataProviderSettings(apiObject *dms.DataProviderSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DocDbSettings; v != nil {
+		tfMap["docdb_settings"] = []interface{}{map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"database_name":   aws.StringValue(v.DatabaseName),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.MariaDbSettings; v != nil {
+		tfMap["mariadb_settings"] = []interface{}{map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.MicrosoftSqlServerSettings; v != nil {
+		tfMap["microsoft_sql_server_settings"] = []interface{}{map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"database_name":   aws.StringValue(v.DatabaseName),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.MongoDbSettings; v != nil {
+		tfMap["mongodb_settings"] = []interface{}{map[string]interface{}{
+			"auth_mechanism":  aws.StringValue(v.AuthMechanism),
+			"auth_source":     aws.StringValue(v.AuthSource),
+			"auth_type":       aws.StringValue(v.AuthType),
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"database_name":   aws.StringValue(v.DatabaseName),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.MySqlSettings; v != nil {
+		tfMap["mysql_settings"] = []interface{}{map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.OracleSettings; v != nil {
+		tfMap["oracle_settings"] = []interface{}{map[string]interface{}{
+			"asm_server":      aws.StringValue(v.AsmServer),
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"database_name":   aws.StringValue(v.DatabaseName),
+			"port":            aws.Int64Value(v.Port),
+			"secrets_manager_oracle_asm_access_role_arn":             aws.StringValue(v.SecretsManagerOracleAsmAccessRoleArn),
+			"secrets_manager_oracle_asm_secret_id":                   aws.StringValue(v.SecretsManagerOracleAsmSecretId),
+			"secrets_manager_security_db_encryption_access_role_arn": aws.StringValue(v.SecretsManagerSecurityDbEncryptionAccessRoleArn),
+			"secrets_manager_security_db_encryption_secret_id":       aws.StringValue(v.SecretsManagerSecurityDbEncryptionSecretId),
+			"server_name": aws.StringValue(v.ServerName),
+			"ssl_mode":    aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.PostgreSqlSettings; v != nil {
+		tfMap["postgres_settings"] = []interface{}{map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.CertificateArn),
+			"database_name":   aws.StringValue(v.DatabaseName),
+			"port":            aws.Int64Value(v.Port),
+			"server_name":     aws.StringValue(v.ServerName),
+			"ssl_mode":        aws.StringValue(v.SslMode),
+		}}
+	}
+
+	if v := apiObject.RedshiftSettings; v != nil {
+		tfMap["redshift_settings"] = []interface{}{map[string]interface{}{
+			"database_name": aws.StringValue(v.DatabaseName),
+			"port":          aws.Int64Value(v.Port),
+			"server_name":   aws.StringValue(v.ServerName),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
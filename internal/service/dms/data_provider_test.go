@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSDataProvider_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_data_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName, 5432),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dms", regexache.MustCompile(`data-provider:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_provider_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "engine", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.0.database_name", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.0.port", "5432"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.0.server_name", "tftest.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.0.ssl_mode", "none"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_data_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName, 5432),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceDataProvider(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_data_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName, 5432),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "engine", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.0.port", "5432"),
+				),
+			},
+			{
+				Config: testAccDataProviderConfig_mysql(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.mysql_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.mysql_settings.0.port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.postgres_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_data_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataProviderConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDataProviderConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataProviderExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+		_, err := tfdms.FindDataProviderByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckDataProviderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_data_provider" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+			_, err := tfdms.FindDataProviderByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Data Provider %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDataProviderConfig_basic(rName string, port int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  settings {
+    postgres_settings {
+      database_name = "tftest"
+      port          = %[2]d
+      server_name   = "tftest.example.com"
+      ssl_mode      = "none"
+    }
+  }
+}
+`, rName, port)
+}
+
+func testAccDataProviderConfig_mysql(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  description        = "testing"
+  engine             = "mysql"
+
+  settings {
+    mysql_settings {
+      port        = 3306
+      server_name = "tftest.example.com"
+      ssl_mode    = "none"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDataProviderConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  settings {
+    postgres_settings {
+      database_name = "tftest"
+      port          = 5432
+      server_name   = "tftest.example.com"
+      ssl_mode      = "none"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDataProviderConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  settings {
+    postgres_settings {
+      database_name = "tftest"
+      port          = 5432
+      server_name   = "tftest.example.com"
+      ssl_mode      = "none"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -90,7 +92,10 @@ func ResourceEventSubscription() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceEventSubscriptionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -212,6 +217,70 @@ func resourceEventSubscriptionDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func resourceEventSubscriptionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("event_categories", "source_type") {
+		return nil
+	}
+
+	if !d.NewValueKnown("event_categories") || !d.NewValueKnown("source_type") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	sourceType := d.Get("source_type").(string)
+	categories, err := findEventCategoriesBySourceType(ctx, conn, sourceType)
+
+	if err != nil {
+		return fmt.Errorf("reading DMS Event Categories (%s): %w", sourceType, err)
+	}
+
+	var invalid []string
+	for _, v := range d.Get("event_categories").(*schema.Set).List() {
+		if v := v.(string); !slices.Contains(categories, v) {
+			invalid = append(invalid, v)
+		}
+	}
+
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		return fmt.Errorf("event_categories %q are not supported for source_type %q, valid values are: %q", invalid, sourceType, categories)
+	}
+
+	return nil
+}
+
+func findEventCategoriesBySourceType(ctx context.Context, conn *dms.DatabaseMigrationService, sourceType string) ([]string, error) {
+	input := &dms.DescribeEventCategoriesInput{
+		SourceType: aws.String(sourceType),
+	}
+
+	output, err := conn.DescribeEventCategoriesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var categories []string
+	for _, v := range output.EventCategoryGroupList {
+		if v == nil || aws.StringValue(v.SourceType) != sourceType {
+			continue
+		}
+
+		categories = append(categories, aws.StringValueSlice(v.EventCategories)...)
+	}
+
+	if len(categories) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return categories, nil
+}
+
 func FindEventSubscriptionByName(ctx context.Context, conn *dms.DatabaseMigrationService, name string) (*dms.EventSubscription, error) {
 	input := &dms.DescribeEventSubscriptionsInput{
 		SubscriptionName: aws.String(name),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -162,6 +163,24 @@ func TestAccDMSEventSubscription_eventCategories(t *testing.T) {
 	})
 }
 
+func TestAccDMSEventSubscription_invalidEventCategories(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventSubscriptionConfig_eventCategories(rName, "creation", "not-a-category"),
+				ExpectError: regexache.MustCompile(`are not supported for source_type "replication-instance"`),
+			},
+		},
+	})
+}
+
 func TestAccDMSEventSubscription_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var eventSubscription dms.EventSubscription
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_instance_profile", name="Instance Profile")
+// @Tags(identifierAttribute="id")
+func ResourceInstanceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceProfileCreate,
+		ReadWithoutTimeout:   resourceInstanceProfileRead,
+		UpdateWithoutTimeout: resourceInstanceProfileUpdate,
+		DeleteWithoutTimeout: resourceInstanceProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instance_profile_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"network_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(networkType_Values(), false),
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_group_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	input := &dms.CreateInstanceProfileInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_profile_name"); ok {
+		input.InstanceProfileName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("subnet_group_identifier"); ok {
+		input.SubnetGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcSecurityGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateInstanceProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Instance Profile: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.InstanceProfile.InstanceProfileArn))
+
+	return append(diags, resourceInstanceProfileRead(ctx, d, meta)...)
+}
+
+func resourceInstanceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	instanceProfile, err := FindInstanceProfileByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Instance Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Instance Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", instanceProfile.InstanceProfileArn)
+	d.Set("availability_zone", instanceProfile.AvailabilityZone)
+	d.Set("description", instanceProfile.Description)
+	d.Set("instance_profile_name", instanceProfile.InstanceProfileName)
+	d.Set("kms_key_arn", instanceProfile.KmsKeyArn)
+	d.Set("network_type", instanceProfile.NetworkType)
+	d.Set("publicly_accessible", instanceProfile.PubliclyAccessible)
+	d.Set("subnet_group_identifier", instanceProfile.SubnetGroupIdentifier)
+	d.Set("vpc_security_groups", aws.StringValueSlice(instanceProfile.VpcSecurityGroups))
+
+	return diags
+}
+
+func resourceInstanceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &dms.ModifyInstanceProfileInput{
+			InstanceProfileIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("availability_zone") {
+			input.AvailabilityZone = aws.String(d.Get("availability_zone").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("instance_profile_name") {
+			input.InstanceProfileName = aws.String(d.Get("instance_profile_name").(string))
+		}
+
+		if d.HasChange("kms_key_arn") {
+			input.KmsKeyArn = aws.String(d.Get("kms_key_arn").(string))
+		}
+
+		if d.HasChange("network_type") {
+			input.NetworkType = aws.String(d.Get("network_type").(string))
+		}
+
+		if d.HasChange("publicly_accessible") {
+			input.PubliclyAccessible = aws.Bool(d.Get("publicly_accessible").(bool))
+		}
+
+		if d.HasChange("subnet_group_identifier") {
+			input.SubnetGroupIdentifier = aws.String(d.Get("subnet_group_identifier").(string))
+		}
+
+		if d.HasChange("vpc_security_groups") {
+			input.VpcSecurityGroups = flex.ExpandStringSet(d.Get("vpc_security_groups").(*schema.Set))
+		}
+
+		_, err := conn.ModifyInstanceProfileWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DMS Instance Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceProfileRead(ctx, d, meta)...)
+}
+
+func resourceInstanceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Instance Profile: %s", d.Id())
+	_, err := conn.DeleteInstanceProfileWithContext(ctx, &dms.DeleteInstanceProfileInput{
+		InstanceProfileIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Instance Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindInstanceProfileByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.InstanceProfile, error) {
+	input := &dms.DescribeInstanceProfilesInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("instance-profile-identifier"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findInstanceProfile(ctx, conn, input)
+}
+
+func findInstanceProfile(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeInstanceProfilesInput) (*dms.InstanceProfile, error) {
+	output, err := findInstanceProfiles(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findInstanceProfiles(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeInstanceProfilesInput) ([]*dms.InstanceProfile, error) {
+	var output []*dms.InstanceProfile
+
+	err := conn.DescribeInstanceProfilesPagesWithContext(ctx, input, func(page *dms.DescribeInstanceProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceProfiles {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
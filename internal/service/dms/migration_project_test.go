@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSMigrationProject_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dms", regexache.MustCompile(`migration-project:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_profile_arn", "aws_dms_instance_profile.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "migration_project_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schema_conversion_application_attributes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_data_provider_descriptors.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_data_provider_descriptors.0.data_provider_arn", "aws_dms_data_provider.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_data_provider_descriptors.0.secrets_manager_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_data_provider_descriptors.0.secrets_manager_secret_id", "aws_secretsmanager_secret.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_data_provider_descriptors.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_data_provider_descriptors.0.data_provider_arn", "aws_dms_data_provider.target", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "transformation_rules", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSMigrationProject_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceMigrationProject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSMigrationProject_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccMigrationProjectConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "transformation_rules"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSMigrationProject_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dms_migration_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMigrationProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationProjectConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMigrationProjectConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMigrationProjectConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMigrationProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMigrationProjectExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+		_, err := tfdms.FindMigrationProjectByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMigrationProjectDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_migration_project" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn(ctx)
+
+			_, err := tfdms.FindMigrationProjectByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Migration Project %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMigrationProjectConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceProfileConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "dms.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "secretsmanager:GetSecretValue"
+      Effect   = "Allow"
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  secret_string = jsonencode({
+    username = "tftest"
+    password = "tftest"
+  })
+}
+
+resource "aws_dms_data_provider" "source" {
+  data_provider_name = "%[1]s-source"
+  engine             = "postgres"
+
+  settings {
+    postgres_settings {
+      database_name = "tftest"
+      port          = 5432
+      server_name   = "source.example.com"
+      ssl_mode      = "none"
+    }
+  }
+}
+
+resource "aws_dms_data_provider" "target" {
+  data_provider_name = "%[1]s-target"
+  engine             = "aurora-postgresql"
+
+  settings {
+    postgres_settings {
+      database_name = "tftest"
+      port          = 5432
+      server_name   = "target.example.com"
+      ssl_mode      = "none"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccMigrationProjectConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMigrationProjectConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_migration_project" "test" {
+  instance_profile_arn   = aws_dms_instance_profile.test.arn
+  migration_project_name = %[1]q
+
+  source_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.source.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  target_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.target.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
+
+func testAccMigrationProjectConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccMigrationProjectConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_migration_project" "test" {
+  description            = "testing"
+  instance_profile_arn   = aws_dms_instance_profile.test.arn
+  migration_project_name = %[1]q
+
+  source_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.source.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  target_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.target.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  transformation_rules = jsonencode({
+    rules = [{
+      rule-type   = "transformation"
+      rule-id     = "1"
+      rule-name   = "1"
+      rule-target = "schema"
+      object-locator = {
+        schema-name = "public"
+      }
+      rule-action = "add-prefix"
+      value       = "tf_"
+    }]
+  })
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
+
+func testAccMigrationProjectConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMigrationProjectConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_migration_project" "test" {
+  instance_profile_arn   = aws_dms_instance_profile.test.arn
+  migration_project_name = %[1]q
+
+  source_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.source.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  target_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.target.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMigrationProjectConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMigrationProjectConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_migration_project" "test" {
+  instance_profile_arn   = aws_dms_instance_profile.test.arn
+  migration_project_name = %[1]q
+
+  source_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.source.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  target_data_provider_descriptors {
+    data_provider_arn               = aws_dms_data_provider.target.arn
+    secrets_manager_access_role_arn = aws_iam_role.test.arn
+    secrets_manager_secret_id       = aws_secretsmanager_secret.test.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_secretsmanager_secret_version.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
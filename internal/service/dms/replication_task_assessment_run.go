@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_dms_replication_task_assessment_run", name="Replication Task Assessment Run")
+func ResourceReplicationTaskAssessmentRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationTaskAssessmentRunCreate,
+		ReadWithoutTimeout:   resourceReplicationTaskAssessmentRunRead,
+		DeleteWithoutTimeout: resourceReplicationTaskAssessmentRunDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_run_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exclude": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"include_only"},
+			},
+			"include_only": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"exclude"},
+			},
+			"last_failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_task_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(assessmentRunResultEncryptionMode_Values(), false),
+			},
+			"result_kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"result_location_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"result_location_folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplicationTaskAssessmentRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	name := d.Get("assessment_run_name").(string)
+	input := &dms.StartReplicationTaskAssessmentRunInput{
+		AssessmentRunName:    aws.String(name),
+		ReplicationTaskArn:   aws.String(d.Get("replication_task_arn").(string)),
+		ResultLocationBucket: aws.String(d.Get("result_location_bucket").(string)),
+		ServiceAccessRoleArn: aws.String(d.Get("service_access_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("exclude"); ok && v.(*schema.Set).Len() > 0 {
+		input.Exclude = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("include_only"); ok && v.(*schema.Set).Len() > 0 {
+		input.IncludeOnly = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("result_encryption_mode"); ok {
+		input.ResultEncryptionMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_kms_key_arn"); ok {
+		input.ResultKmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("result_location_folder"); ok {
+		input.ResultLocationFolder = aws.String(v.(string))
+	}
+
+	output, err := conn.StartReplicationTaskAssessmentRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting DMS Replication Task Assessment Run (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReplicationTaskAssessmentRun.ReplicationTaskAssessmentRunArn))
+
+	return append(diags, resourceReplicationTaskAssessmentRunRead(ctx, d, meta)...)
+}
+
+func resourceReplicationTaskAssessmentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	run, err := FindReplicationTaskAssessmentRunByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Replication Task Assessment Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", run.ReplicationTaskAssessmentRunArn)
+	d.Set("assessment_run_name", run.AssessmentRunName)
+	d.Set("last_failure_message", run.LastFailureMessage)
+	d.Set("replication_task_arn", run.ReplicationTaskArn)
+	d.Set("result_encryption_mode", run.ResultEncryptionMode)
+	d.Set("result_kms_key_arn", run.ResultKmsKeyArn)
+	d.Set("result_location_bucket", run.ResultLocationBucket)
+	d.Set("result_location_folder", run.ResultLocationFolder)
+	d.Set("service_access_role_arn", run.ServiceAccessRoleArn)
+	d.Set("status", run.Status)
+
+	return diags
+}
+
+func resourceReplicationTaskAssessmentRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSConn(ctx)
+
+	// Runs that are still in progress must be cancelled before they can be deleted.
+	if _, err := conn.CancelReplicationTaskAssessmentRunWithContext(ctx, &dms.CancelReplicationTaskAssessmentRunInput{
+		ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+	}); err != nil && !tfawserr.ErrCodeEquals(err, dms.ErrCodeInvalidResourceStateFault) {
+		if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "cancelling DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationTaskAssessmentRunStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) stop: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting DMS Replication Task Assessment Run: %s", d.Id())
+	_, err := conn.DeleteReplicationTaskAssessmentRunWithContext(ctx, &dms.DeleteReplicationTaskAssessmentRunInput{
+		ReplicationTaskAssessmentRunArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Replication Task Assessment Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitReplicationTaskAssessmentRunDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Replication Task Assessment Run (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindReplicationTaskAssessmentRunByARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationTaskAssessmentRun, error) {
+	input := &dms.DescribeReplicationTaskAssessmentRunsInput{
+		Filters: []*dms.Filter{{
+			Name:   aws.String("replication-task-assessment-run-arn"),
+			Values: aws.StringSlice([]string{arn}),
+		}},
+	}
+
+	return findReplicationTaskAssessmentRun(ctx, conn, input)
+}
+
+func findReplicationTaskAssessmentRun(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeReplicationTaskAssessmentRunsInput) (*dms.ReplicationTaskAssessmentRun, error) {
+	output, err := findReplicationTaskAssessmentRuns(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findReplicationTaskAssessmentRuns(ctx context.Context, conn *dms.DatabaseMigrationService, input *dms.DescribeReplicationTaskAssessmentRunsInput) ([]*dms.ReplicationTaskAssessmentRun, error) {
+	var output []*dms.ReplicationTaskAssessmentRun
+
+	err := conn.DescribeReplicationTaskAssessmentRunsPagesWithContext(ctx, input, func(page *dms.DescribeReplicationTaskAssessmentRunsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationTaskAssessmentRuns {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusReplicationTaskAssessmentRun(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationTaskAssessmentRunByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitReplicationTaskAssessmentRunStopped(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			replicationTaskAssessmentRunStatusCancelling,
+			replicationTaskAssessmentRunStatusProvisioning,
+			replicationTaskAssessmentRunStatusRunning,
+			replicationTaskAssessmentRunStatusStarting,
+		},
+		Target: []string{
+			replicationTaskAssessmentRunStatusCancelled,
+			replicationTaskAssessmentRunStatusErrorExecuting,
+			replicationTaskAssessmentRunStatusErrorProvisioning,
+			replicationTaskAssessmentRunStatusFailed,
+			replicationTaskAssessmentRunStatusInvalidState,
+			replicationTaskAssessmentRunStatusPassed,
+		},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.LastFailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationTaskAssessmentRunDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.ReplicationTaskAssessmentRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{replicationTaskAssessmentRunStatusDeleting},
+		Target:     []string{},
+		Refresh:    statusReplicationTaskAssessmentRun(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.ReplicationTaskAssessmentRun); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -58,6 +58,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "certificate_arn",
 			},
 		},
+		{
+			Factory:  ResourceDataProvider,
+			TypeName: "aws_dms_data_provider",
+			Name:     "Data Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceEndpoint,
 			TypeName: "aws_dms_endpoint",
@@ -74,6 +82,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceInstanceProfile,
+			TypeName: "aws_dms_instance_profile",
+			Name:     "Instance Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceMigrationProject,
+			TypeName: "aws_dms_migration_project",
+			Name:     "Migration Project",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceReplicationConfig,
 			TypeName: "aws_dms_replication_config",
@@ -106,6 +130,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "replication_task_arn",
 			},
 		},
+		{
+			Factory:  ResourceReplicationTaskAssessmentRun,
+			TypeName: "aws_dms_replication_task_assessment_run",
+			Name:     "Replication Task Assessment Run",
+		},
 		{
 			Factory:  ResourceS3Endpoint,
 			TypeName: "aws_dms_s3_endpoint",
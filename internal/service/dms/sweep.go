@@ -15,6 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_dms_data_provider", &resource.Sweeper{
+		Name: "aws_dms_data_provider",
+		F:    sweepDataProviders,
+		Dependencies: []string{
+			"aws_dms_migration_project",
+		},
+	})
+
 	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
@@ -23,6 +31,19 @@ func RegisterSweepers() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_dms_instance_profile", &resource.Sweeper{
+		Name: "aws_dms_instance_profile",
+		F:    sweepInstanceProfiles,
+		Dependencies: []string{
+			"aws_dms_migration_project",
+		},
+	})
+
+	resource.AddTestSweepers("aws_dms_migration_project", &resource.Sweeper{
+		Name: "aws_dms_migration_project",
+		F:    sweepMigrationProjects,
+	})
+
 	resource.AddTestSweepers("aws_dms_replication_config", &resource.Sweeper{
 		Name: "aws_dms_replication_config",
 		F:    sweepReplicationConfigs,
@@ -50,6 +71,50 @@ func RegisterSweepers() {
 	})
 }
 
+func sweepDataProviders(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DMSConn(ctx)
+	input := &dms.DescribeDataProvidersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeDataProvidersPagesWithContext(ctx, input, func(page *dms.DescribeDataProvidersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataProviders {
+			r := ResourceDataProvider()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DataProviderArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DMS Data Provider sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DMS Data Providers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DMS Data Providers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepEndpoints(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -95,6 +160,94 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
+func sweepInstanceProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DMSConn(ctx)
+	input := &dms.DescribeInstanceProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeInstanceProfilesPagesWithContext(ctx, input, func(page *dms.DescribeInstanceProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InstanceProfiles {
+			r := ResourceInstanceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.InstanceProfileArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DMS Instance Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DMS Instance Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DMS Instance Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMigrationProjects(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DMSConn(ctx)
+	input := &dms.DescribeMigrationProjectsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeMigrationProjectsPagesWithContext(ctx, input, func(page *dms.DescribeMigrationProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MigrationProjects {
+			r := ResourceMigrationProject()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MigrationProjectArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DMS Migration Project sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DMS Migration Projects (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DMS Migration Projects (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepReplicationConfigs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="EBS Snapshot Lock")
+func newEBSSnapshotLockResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &ebsSnapshotLockResource{}, nil
+}
+
+type ebsSnapshotLockResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ebsSnapshotLockResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ebs_snapshot_lock"
+}
+
+func (r *ebsSnapshotLockResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cool_off_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 72),
+				},
+			},
+			"cool_off_period_expires_on": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"expiration_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"lock_created_on": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lock_duration": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 36500),
+				},
+			},
+			"lock_expires_on": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lock_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LockMode](),
+				Required:   true,
+			},
+			"lock_state": schema.StringAttribute{
+				Computed: true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ebsSnapshotLockResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := lockSnapshot(ctx, conn, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EBS Snapshot Lock (%s)", data.SnapshotID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.SnapshotID
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ebsSnapshotLockResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findLockedSnapshotBySnapshotID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EBS Snapshot Lock (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The lock mode isn't returned by the API, but is implied by the lock state.
+	switch output.LockState {
+	case awstypes.LockStateCompliance, awstypes.LockStateComplianceCooloff:
+		data.LockMode = fwtypes.StringEnumValue(awstypes.LockModeCompliance)
+	case awstypes.LockStateGovernance:
+		data.LockMode = fwtypes.StringEnumValue(awstypes.LockModeGovernance)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ebsSnapshotLockResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Locking an already locked snapshot modifies the existing lock.
+	output, err := lockSnapshot(ctx, conn, &new)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating EBS Snapshot Lock (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ebsSnapshotLockResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ebsSnapshotLockResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.UnlockSnapshot(ctx, &ec2.UnlockSnapshotInput{
+		SnapshotId: aws.String(data.ID.ValueString()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EBS Snapshot Lock (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ebsSnapshotLockResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("expiration_date"),
+			path.MatchRoot("lock_duration"),
+		),
+	}
+}
+
+func lockSnapshot(ctx context.Context, conn *ec2.Client, data *ebsSnapshotLockResourceModel) (*ec2.LockSnapshotOutput, error) {
+	input := &ec2.LockSnapshotInput{
+		LockMode:   data.LockMode.ValueEnum(),
+		SnapshotId: fwflex.StringFromFramework(ctx, data.SnapshotID),
+	}
+
+	if !data.CoolOffPeriod.IsNull() && !data.CoolOffPeriod.IsUnknown() {
+		input.CoolOffPeriod = fwflex.Int32FromFramework(ctx, data.CoolOffPeriod)
+	}
+
+	if !data.ExpirationDate.IsNull() && !data.ExpirationDate.IsUnknown() {
+		v, diags := data.ExpirationDate.ValueRFC3339Time()
+		if diags.HasError() {
+			return nil, fwdiag.DiagnosticsError(diags)
+		}
+		input.ExpirationDate = aws.Time(v)
+	}
+
+	if !data.LockDuration.IsNull() && !data.LockDuration.IsUnknown() {
+		input.LockDuration = fwflex.Int32FromFramework(ctx, data.LockDuration)
+	}
+
+	return conn.LockSnapshot(ctx, input)
+}
+
+type ebsSnapshotLockResourceModel struct {
+	CoolOffPeriod          types.Int64                           `tfsdk:"cool_off_period"`
+	CoolOffPeriodExpiresOn timetypes.RFC3339                     `tfsdk:"cool_off_period_expires_on"`
+	ExpirationDate         timetypes.RFC3339                     `tfsdk:"expiration_date"`
+	ID                     types.String                          `tfsdk:"id"`
+	LockCreatedOn          timetypes.RFC3339                     `tfsdk:"lock_created_on"`
+	LockDuration           types.Int64                           `tfsdk:"lock_duration"`
+	LockExpiresOn          timetypes.RFC3339                     `tfsdk:"lock_expires_on"`
+	LockMode               fwtypes.StringEnum[awstypes.LockMode] `tfsdk:"lock_mode"`
+	LockState              types.String                          `tfsdk:"lock_state"`
+	SnapshotID             types.String                          `tfsdk:"snapshot_id"`
+}
+
+func findLockedSnapshotBySnapshotID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.LockedSnapshotsInfo, error) {
+	input := &ec2.DescribeLockedSnapshotsInput{
+		SnapshotIds: []string{id},
+	}
+
+	output, err := conn.DescribeLockedSnapshots(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	lock, err := tfresource.AssertSingleValueResult(output.Snapshots)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if lock.LockState == awstypes.LockStateExpired {
+		return nil, &retry.NotFoundError{
+			Message:     string(lock.LockState),
+			LastRequest: input,
+		}
+	}
+
+	return lock, nil
+}
@@ -8,6 +8,7 @@ var (
 	ResourceDefaultNetworkACL               = resourceDefaultNetworkACL
 	ResourceDefaultRouteTable               = resourceDefaultRouteTable
 	ResourceEBSFastSnapshotRestore          = newResourceEBSFastSnapshotRestore
+	ResourceEBSSnapshotLock                 = newEBSSnapshotLockResource
 	ResourceInstanceConnectEndpoint         = newResourceInstanceConnectEndpoint
 	ResourceNetworkACL                      = resourceNetworkACL
 	ResourceNetworkACLRule                  = resourceNetworkACLRule
@@ -18,13 +19,15 @@ var (
 	ResourceTag                             = resourceTag
 	ResourceTransitGatewayPeeringAttachment = resourceTransitGatewayPeeringAttachment
 
-	CustomFiltersSchema            = customFiltersSchema
-	FindEBSFastSnapshotRestoreByID = findEBSFastSnapshotRestoreByID
-	FindNetworkACLByIDV2           = findNetworkACLByIDV2
-	NewAttributeFilterList         = newAttributeFilterList
-	NewCustomFilterList            = newCustomFilterList
-	NewTagFilterList               = newTagFilterList
-	StopInstance                   = stopInstance
-	UpdateTags                     = updateTags
-	UpdateTagsV2                   = updateTagsV2
+	CustomFiltersSchema                      = customFiltersSchema
+	FindEBSFastSnapshotRestoreByID           = findEBSFastSnapshotRestoreByID
+	FindLockedSnapshotBySnapshotID           = findLockedSnapshotBySnapshotID
+	FindNetworkACLByIDV2                     = findNetworkACLByIDV2
+	NewAttributeFilterList                   = newAttributeFilterList
+	NewCustomFilterList                      = newCustomFilterList
+	NewTagFilterList                         = newTagFilterList
+	SecurityGroupRulesWithDescriptionChanges = securityGroupRulesWithDescriptionChanges
+	StopInstance                             = stopInstance
+	UpdateTags                               = updateTags
+	UpdateTagsV2                             = updateTagsV2
 )
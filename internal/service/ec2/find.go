@@ -6638,6 +6638,36 @@ func FindVPCEndpointConnectionByServiceIDAndVPCEndpointID(ctx context.Context, c
 	return output, nil
 }
 
+func FindVPCEndpointConnectionsByServiceID(ctx context.Context, conn *ec2.EC2, serviceID string) ([]*ec2.VpcEndpointConnection, error) {
+	input := &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"service-id": serviceID,
+		}),
+	}
+
+	var output []*ec2.VpcEndpointConnection
+
+	err := conn.DescribeVpcEndpointConnectionsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcEndpointConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VpcEndpointConnections {
+			if v != nil && aws.StringValue(v.VpcEndpointState) != vpcEndpointStateDeleted {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindImportSnapshotTasks(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeImportSnapshotTasksInput) ([]*ec2.ImportSnapshotTask, error) {
 	var output []*ec2.ImportSnapshotTask
 
@@ -34,6 +34,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceEBSFastSnapshotRestore,
 			Name:    "EBS Fast Snapshot Restore",
 		},
+		{
+			Factory: newEBSSnapshotLockResource,
+			Name:    "EBS Snapshot Lock",
+		},
 		{
 			Factory: newResourceInstanceConnectEndpoint,
 			Name:    "Instance Connect Endpoint",
@@ -767,6 +771,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTransitGatewayRouteTablePropagation,
 			TypeName: "aws_ec2_transit_gateway_route_table_propagation",
 		},
+		{
+			Factory:  ResourceTransitGatewayRouteTablePropagations,
+			TypeName: "aws_ec2_transit_gateway_route_table_propagations",
+		},
 		{
 			Factory:  ResourceTransitGatewayVPCAttachment,
 			TypeName: "aws_ec2_transit_gateway_vpc_attachment",
@@ -1068,6 +1076,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCEndpointServiceAllowedPrincipal,
 			TypeName: "aws_vpc_endpoint_service_allowed_principal",
 		},
+		{
+			Factory:  ResourceVPCEndpointServicePrivateDNSVerification,
+			TypeName: "aws_vpc_endpoint_service_private_dns_verification",
+		},
 		{
 			Factory:  ResourceVPCEndpointSubnetAssociation,
 			TypeName: "aws_vpc_endpoint_subnet_association",
@@ -1254,6 +1254,26 @@ func StatusVPCEndpointServiceStateAvailable(ctx context.Context, conn *ec2.EC2,
 	}
 }
 
+func StatusVPCEndpointServicePrivateDNSNameConfiguration(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return nil, "", nil
+		}
+
+		return output.PrivateDnsNameConfiguration, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
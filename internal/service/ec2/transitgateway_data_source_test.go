@@ -70,7 +70,8 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"basic":  testAccTransitGatewayRouteTablePropagationsDataSource_basic,
 		},
 		"RouteTableRoutes": {
-			"basic": testAccTransitGatewayRouteTableRoutesDataSource_basic,
+			"basic":     testAccTransitGatewayRouteTableRoutesDataSource_basic,
+			"blackhole": testAccTransitGatewayRouteTableRoutesDataSource_blackhole,
 		},
 		"VpcAttachment": {
 			"Filter": testAccTransitGatewayVPCAttachmentDataSource_Filter,
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_route_table_propagations")
+func ResourceTransitGatewayRouteTablePropagations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRouteTablePropagationsCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRouteTablePropagationsRead,
+		UpdateWithoutTimeout: resourceTransitGatewayRouteTablePropagationsUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayRouteTablePropagationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"transit_gateway_attachment_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTablePropagationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	transitGatewayAttachmentIDs := flex.ExpandStringValueSet(d.Get("transit_gateway_attachment_ids").(*schema.Set))
+
+	d.SetId(transitGatewayRouteTableID)
+
+	if err := enableTransitGatewayRouteTablePropagations(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentIDs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Route Table Propagations (%s): %s", transitGatewayRouteTableID, err)
+	}
+
+	return append(diags, resourceTransitGatewayRouteTablePropagationsRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTablePropagationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	propagations, err := FindTransitGatewayRouteTablePropagations(ctx, conn, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table Propagations %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Propagations (%s): %s", d.Id(), err)
+	}
+
+	// Only propagations managed by this resource are reported, unless the resource is being imported.
+	configured := d.Get("transit_gateway_attachment_ids").(*schema.Set)
+	var transitGatewayAttachmentIDs []string
+
+	for _, v := range propagations {
+		if state := aws.StringValue(v.State); state == ec2.TransitGatewayPropagationStateDisabled || state == ec2.TransitGatewayPropagationStateDisabling {
+			continue
+		}
+
+		transitGatewayAttachmentID := aws.StringValue(v.TransitGatewayAttachmentId)
+
+		if configured.Len() > 0 && !configured.Contains(transitGatewayAttachmentID) {
+			continue
+		}
+
+		transitGatewayAttachmentIDs = append(transitGatewayAttachmentIDs, transitGatewayAttachmentID)
+	}
+
+	d.Set("transit_gateway_attachment_ids", transitGatewayAttachmentIDs)
+	d.Set("transit_gateway_route_table_id", d.Id())
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTablePropagationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("transit_gateway_attachment_ids") {
+		o, n := d.GetChange("transit_gateway_attachment_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := disableTransitGatewayRouteTablePropagations(ctx, conn, d.Id(), flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table Propagations (%s): %s", d.Id(), err)
+		}
+
+		if err := enableTransitGatewayRouteTablePropagations(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table Propagations (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransitGatewayRouteTablePropagationsRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTablePropagationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Propagations: %s", d.Id())
+	if err := disableTransitGatewayRouteTablePropagations(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("transit_gateway_attachment_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Route Table Propagations (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// enableTransitGatewayRouteTablePropagations enables propagation for all the specified attachments before waiting,
+// so that the propagations settle concurrently.
+func enableTransitGatewayRouteTablePropagations(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentIDs []string) error {
+	var errs []error
+
+	for _, transitGatewayAttachmentID := range transitGatewayAttachmentIDs {
+		input := &ec2.EnableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		if _, err := conn.EnableTransitGatewayRouteTablePropagationWithContext(ctx, input); err != nil {
+			errs = append(errs, fmt.Errorf("enabling propagation for EC2 Transit Gateway Attachment (%s): %w", transitGatewayAttachmentID, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for _, transitGatewayAttachmentID := range transitGatewayAttachmentIDs {
+		if _, err := WaitTransitGatewayRouteTablePropagationCreated(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+			errs = append(errs, fmt.Errorf("waiting for propagation for EC2 Transit Gateway Attachment (%s) enable: %w", transitGatewayAttachmentID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// disableTransitGatewayRouteTablePropagations disables propagation for all the specified attachments before waiting,
+// so that the propagations settle concurrently.
+func disableTransitGatewayRouteTablePropagations(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentIDs []string) error {
+	var errs []error
+	var disabled []string
+
+	for _, transitGatewayAttachmentID := range transitGatewayAttachmentIDs {
+		input := &ec2.DisableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		_, err := conn.DisableTransitGatewayRouteTablePropagationWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+			return nil
+		}
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("disabling propagation for EC2 Transit Gateway Attachment (%s): %w", transitGatewayAttachmentID, err))
+
+			continue
+		}
+
+		disabled = append(disabled, transitGatewayAttachmentID)
+	}
+
+	for _, transitGatewayAttachmentID := range disabled {
+		if _, err := WaitTransitGatewayRouteTablePropagationDeleted(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID); err != nil {
+			errs = append(errs, fmt.Errorf("waiting for propagation for EC2 Transit Gateway Attachment (%s) disable: %w", transitGatewayAttachmentID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayRouteTablePropagations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTablePropagations_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_transit_gateway_route_table_propagations.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "3"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTablePropagationsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTablePropagationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "transit_gateway_attachment_ids.*", "aws_ec2_transit_gateway_vpc_attachment.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteTablePropagationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Route Table Propagations ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if k == "transit_gateway_attachment_ids.#" || !strings.HasPrefix(k, "transit_gateway_attachment_ids.") {
+				continue
+			}
+
+			if _, err := tfec2.FindTransitGatewayRouteTablePropagationByTwoPartKey(ctx, conn, rs.Primary.ID, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTablePropagationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_route_table_propagations" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if k == "transit_gateway_attachment_ids.#" || !strings.HasPrefix(k, "transit_gateway_attachment_ids.") {
+					continue
+				}
+
+				_, err := tfec2.FindTransitGatewayRouteTablePropagationByTwoPartKey(ctx, conn, rs.Primary.ID, v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EC2 Transit Gateway Route Table Propagation %s still exists", tfec2.TransitGatewayRouteTablePropagationCreateResourceID(rs.Primary.ID, v))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRouteTablePropagationsConfig_basic(rName string, n int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.${count.index}.0.0/24"
+  vpc_id            = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  count = 3
+
+  subnet_ids         = [aws_subnet.test[count.index].id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table_propagations" "test" {
+  transit_gateway_attachment_ids = slice(aws_ec2_transit_gateway_vpc_attachment.test[*].id, 0, %[2]d)
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, rName, n))
+}
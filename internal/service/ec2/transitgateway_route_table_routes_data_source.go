@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // @SDKDataSource("aws_ec2_transit_gateway_route_table_routes")
@@ -39,6 +41,26 @@ func DataSourceTransitGatewayRouteTableRoutes() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"transit_gateway_attachments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_attachment_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 						"transit_gateway_route_table_announcement_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -71,6 +93,11 @@ func dataSourceTransitGatewayRouteTableRoutesRead(ctx context.Context, d *schema
 
 	output, err := FindTransitGatewayRoutes(ctx, conn, input)
 
+	// No matching routes (e.g. when searching for blackhole routes) is not an error.
+	if errors.Is(err, tfresource.ErrEmptyResult) {
+		err = nil
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s) Routes: %s", tgwRouteTableID, err)
 	}
@@ -80,9 +107,10 @@ func dataSourceTransitGatewayRouteTableRoutesRead(ctx context.Context, d *schema
 	routes := []interface{}{}
 	for _, route := range output {
 		routes = append(routes, map[string]interface{}{
-			"destination_cidr_block": aws.StringValue(route.DestinationCidrBlock),
-			"prefix_list_id":         aws.StringValue(route.PrefixListId),
-			"state":                  aws.StringValue(route.State),
+			"destination_cidr_block":      aws.StringValue(route.DestinationCidrBlock),
+			"prefix_list_id":              aws.StringValue(route.PrefixListId),
+			"state":                       aws.StringValue(route.State),
+			"transit_gateway_attachments": flattenTransitGatewayRouteAttachments(route.TransitGatewayAttachments),
 			"transit_gateway_route_table_announcement_id": aws.StringValue(route.TransitGatewayRouteTableAnnouncementId),
 			"type": aws.StringValue(route.Type),
 		})
@@ -94,3 +122,21 @@ func dataSourceTransitGatewayRouteTableRoutesRead(ctx context.Context, d *schema
 
 	return diags
 }
+
+func flattenTransitGatewayRouteAttachments(apiObjects []*ec2.TransitGatewayRouteAttachment) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"resource_id":                   aws.StringValue(apiObject.ResourceId),
+			"resource_type":                 aws.StringValue(apiObject.ResourceType),
+			"transit_gateway_attachment_id": aws.StringValue(apiObject.TransitGatewayAttachmentId),
+		})
+	}
+
+	return tfList
+}
@@ -27,6 +27,34 @@ func testAccTransitGatewayRouteTableRoutesDataSource_basic(t *testing.T) {
 				Config: testAccTransitGatewayRouteTableRoutesDataSourceConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "routes.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_id", "aws_vpc.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.0.resource_type", "vpc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableRoutesDataSource_blackhole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_routes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableRoutesDataSourceConfig_blackhole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.state", "blackhole"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.transit_gateway_attachments.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_ec2_transit_gateway_route_table_routes.empty", "routes.#", "0"),
 				),
 			},
 		},
@@ -83,3 +111,49 @@ data "aws_ec2_transit_gateway_route_table_routes" "test" {
 }
 `, rName))
 }
+
+func testAccTransitGatewayRouteTableRoutesDataSourceConfig_blackhole(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route" "test" {
+  destination_cidr_block         = "10.1.0.0/16"
+  blackhole                      = true
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+
+data "aws_ec2_transit_gateway_route_table_routes" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  filter {
+    name   = "state"
+    values = ["blackhole"]
+  }
+
+  depends_on = [aws_ec2_transit_gateway_route.test]
+}
+
+data "aws_ec2_transit_gateway_route_table_routes" "empty" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+
+  filter {
+    name   = "type"
+    values = ["propagated"]
+  }
+
+  depends_on = [aws_ec2_transit_gateway_route.test]
+}
+`, rName)
+}
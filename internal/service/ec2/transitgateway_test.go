@@ -129,6 +129,10 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"basic":      testAccTransitGatewayRouteTablePropagation_basic,
 			"disappears": testAccTransitGatewayRouteTablePropagation_disappears,
 		},
+		"RouteTablePropagations": {
+			"basic":  testAccTransitGatewayRouteTablePropagations_basic,
+			"update": testAccTransitGatewayRouteTablePropagations_update,
+		},
 		"VpcAttachment": {
 			"basic":                testAccTransitGatewayVPCAttachment_basic,
 			"disappears":           testAccTransitGatewayVPCAttachment_disappears,
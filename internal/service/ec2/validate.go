@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 func validSecurityGroupRuleDescription(v interface{}, k string) (ws []string, errors []error) {
@@ -46,3 +47,11 @@ func validNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
 	}
 	return nil
 }
+
+// customerGatewayCertificateARNCheck checks that a customer gateway certificate is an ACM certificate.
+func customerGatewayCertificateARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	if arn.Service != "acm" || !strings.HasPrefix(arn.Resource, "certificate/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid ACM certificate ARN", k, v))
+	}
+	return
+}
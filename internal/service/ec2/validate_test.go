@@ -5,6 +5,8 @@ package ec2
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func TestValidSecurityGroupRuleDescription(t *testing.T) {
@@ -35,3 +37,32 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestCustomerGatewayCertificateARNCheck(t *testing.T) {
+	t.Parallel()
+
+	f := verify.ValidARNCheck(customerGatewayCertificateARNCheck)
+
+	validARNs := []string{
+		"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:acm:us-gov-west-1:123456789012:certificate/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := f(v, "certificate_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid customer gateway certificate ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:acm-pca:us-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:server-certificate/example",                                              //lintignore:AWSAT005
+		"not-an-arn",
+	}
+	for _, v := range invalidARNs {
+		_, errors := f(v, "certificate_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid customer gateway certificate ARN", v)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -24,6 +26,7 @@ func ResourceVPCEndpointConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCEndpointConnectionAccepterCreate,
 		ReadWithoutTimeout:   resourceVPCEndpointConnectionAccepterRead,
+		UpdateWithoutTimeout: resourceVPCEndpointConnectionAccepterUpdate,
 		DeleteWithoutTimeout: resourceVPCEndpointConnectionAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -32,9 +35,18 @@ func ResourceVPCEndpointConnectionAccepter() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"vpc_endpoint_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"vpc_endpoint_id", "vpc_endpoint_ids"},
+			},
+			"vpc_endpoint_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"vpc_endpoint_id", "vpc_endpoint_ids"},
 			},
 			"vpc_endpoint_service_id": {
 				Type:     schema.TypeString,
@@ -45,6 +57,11 @@ func ResourceVPCEndpointConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"vpc_endpoint_states": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -54,6 +71,17 @@ func resourceVPCEndpointConnectionAccepterCreate(ctx context.Context, d *schema.
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
 	serviceID := d.Get("vpc_endpoint_service_id").(string)
+
+	if v, ok := d.GetOk("vpc_endpoint_ids"); ok {
+		if err := acceptVPCEndpointConnections(ctx, conn, serviceID, flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.SetId(serviceID)
+
+		return append(diags, resourceVPCEndpointConnectionAccepterRead(ctx, d, meta)...)
+	}
+
 	vpcEndpointID := d.Get("vpc_endpoint_id").(string)
 	id := VPCEndpointConnectionAccepterCreateResourceID(serviceID, vpcEndpointID)
 	input := &ec2.AcceptVpcEndpointConnectionsInput{
@@ -83,6 +111,10 @@ func resourceVPCEndpointConnectionAccepterRead(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	if !strings.Contains(d.Id(), vpcEndpointConnectionAccepterResourceIDSeparator) {
+		return append(diags, resourceVPCEndpointConnectionAccepterBulkRead(ctx, d, meta)...)
+	}
+
 	serviceID, vpcEndpointID, err := VPCEndpointConnectionAccepterParseResourceID(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -102,15 +134,109 @@ func resourceVPCEndpointConnectionAccepterRead(ctx context.Context, d *schema.Re
 
 	d.Set("vpc_endpoint_id", vpcEndpointConnection.VpcEndpointId)
 	d.Set("vpc_endpoint_service_id", vpcEndpointConnection.ServiceId)
+	d.Set("vpc_endpoint_ids", []string{aws.StringValue(vpcEndpointConnection.VpcEndpointId)})
 	d.Set("vpc_endpoint_state", vpcEndpointConnection.VpcEndpointState)
+	d.Set("vpc_endpoint_states", map[string]string{
+		aws.StringValue(vpcEndpointConnection.VpcEndpointId): aws.StringValue(vpcEndpointConnection.VpcEndpointState),
+	})
+
+	return diags
+}
+
+func resourceVPCEndpointConnectionAccepterBulkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	serviceID := d.Id()
+	vpcEndpointConnections, err := FindVPCEndpointConnectionsByServiceID(ctx, conn, serviceID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeInvalidVPCEndpointServiceIdNotFound) {
+		log.Printf("[WARN] VPC Endpoint Service %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint Connections (%s): %s", d.Id(), err)
+	}
+
+	// On import all accepted connections are tracked.
+	tracked := d.Get("vpc_endpoint_ids").(*schema.Set)
+	all := tracked.Len() == 0
+
+	var vpcEndpointIDs []string
+	vpcEndpointStates := make(map[string]string)
+
+	for _, v := range vpcEndpointConnections {
+		vpcEndpointID, vpcEndpointState := aws.StringValue(v.VpcEndpointId), aws.StringValue(v.VpcEndpointState)
+
+		if !all && !tracked.Contains(vpcEndpointID) {
+			continue
+		}
+
+		vpcEndpointStates[vpcEndpointID] = vpcEndpointState
+
+		// Connections that are no longer accepted are dropped so that they are accepted again.
+		switch vpcEndpointState {
+		case vpcEndpointStateAvailable, vpcEndpointStatePending:
+			vpcEndpointIDs = append(vpcEndpointIDs, vpcEndpointID)
+		}
+	}
+
+	if !d.IsNewResource() && len(vpcEndpointStates) == 0 {
+		log.Printf("[WARN] VPC Endpoint Connections %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("vpc_endpoint_id", nil)
+	d.Set("vpc_endpoint_ids", vpcEndpointIDs)
+	d.Set("vpc_endpoint_service_id", serviceID)
+	d.Set("vpc_endpoint_state", nil)
+	d.Set("vpc_endpoint_states", vpcEndpointStates)
 
 	return diags
 }
 
+func resourceVPCEndpointConnectionAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("vpc_endpoint_ids") {
+		serviceID := d.Get("vpc_endpoint_service_id").(string)
+		o, n := d.GetChange("vpc_endpoint_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			if err := rejectVPCEndpointConnections(ctx, conn, serviceID, del); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			if err := acceptVPCEndpointConnections(ctx, conn, serviceID, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceVPCEndpointConnectionAccepterRead(ctx, d, meta)...)
+}
+
 func resourceVPCEndpointConnectionAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	if !strings.Contains(d.Id(), vpcEndpointConnectionAccepterResourceIDSeparator) {
+		if v := flex.ExpandStringValueSet(d.Get("vpc_endpoint_ids").(*schema.Set)); len(v) > 0 {
+			if err := rejectVPCEndpointConnections(ctx, conn, d.Id(), v); err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidVPCEndpointServiceIdNotFound) {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		return diags
+	}
+
 	serviceID, vpcEndpointID, err := VPCEndpointConnectionAccepterParseResourceID(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -133,6 +259,50 @@ func resourceVPCEndpointConnectionAccepterDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func acceptVPCEndpointConnections(ctx context.Context, conn *ec2.EC2, serviceID string, vpcEndpointIDs []string, timeout time.Duration) error {
+	input := &ec2.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      aws.String(serviceID),
+		VpcEndpointIds: aws.StringSlice(vpcEndpointIDs),
+	}
+
+	log.Printf("[DEBUG] Accepting VPC Endpoint Connections: %s", input)
+	output, err := conn.AcceptVpcEndpointConnectionsWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = UnsuccessfulItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return fmt.Errorf("accepting VPC Endpoint Connections (%s): %w", serviceID, err)
+	}
+
+	for _, vpcEndpointID := range vpcEndpointIDs {
+		if _, err := waitVPCEndpointConnectionAccepted(ctx, conn, serviceID, vpcEndpointID, timeout); err != nil {
+			return fmt.Errorf("waiting for VPC Endpoint Connection (%s) to be accepted: %w", VPCEndpointConnectionAccepterCreateResourceID(serviceID, vpcEndpointID), err)
+		}
+	}
+
+	return nil
+}
+
+func rejectVPCEndpointConnections(ctx context.Context, conn *ec2.EC2, serviceID string, vpcEndpointIDs []string) error {
+	log.Printf("[DEBUG] Rejecting VPC Endpoint Connections: %s %v", serviceID, vpcEndpointIDs)
+	output, err := conn.RejectVpcEndpointConnectionsWithContext(ctx, &ec2.RejectVpcEndpointConnectionsInput{
+		ServiceId:      aws.String(serviceID),
+		VpcEndpointIds: aws.StringSlice(vpcEndpointIDs),
+	})
+
+	if err == nil && output != nil {
+		err = UnsuccessfulItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return fmt.Errorf("rejecting VPC Endpoint Connections (%s): %w", serviceID, err)
+	}
+
+	return nil
+}
+
 const vpcEndpointConnectionAccepterResourceIDSeparator = "_"
 
 func VPCEndpointConnectionAccepterCreateResourceID(serviceID, vpcEndpointID string) string {
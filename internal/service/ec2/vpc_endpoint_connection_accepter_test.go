@@ -6,8 +6,11 @@ package ec2_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -48,6 +51,46 @@ func TestAccVPCEndpointConnectionAccepter_crossAccount(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpointConnectionAccepter_bulk(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_endpoint_connection_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckVPCEndpointConnectionAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointConnectionAccepterConfig_bulk(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "aws_vpc_endpoint_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_states.%", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_endpoint_ids.*", "aws_vpc_endpoint.test.0", "id"),
+				),
+			},
+			{
+				Config:            testAccVPCEndpointConnectionAccepterConfig_bulk(rName, 2),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCEndpointConnectionAccepterConfig_bulk(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_states.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCEndpointConnectionAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
@@ -57,6 +100,26 @@ func testAccCheckVPCEndpointConnectionAccepterDestroy(ctx context.Context) resou
 				continue
 			}
 
+			if !strings.Contains(rs.Primary.ID, "_") {
+				vpcEndpointConnections, err := tfec2.FindVPCEndpointConnectionsByServiceID(ctx, conn, rs.Primary.ID)
+
+				if tfawserr.ErrCodeEquals(err, "InvalidVpcEndpointServiceId.NotFound") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				for _, v := range vpcEndpointConnections {
+					if aws.StringValue(v.VpcEndpointState) == "available" {
+						return fmt.Errorf("VPC Endpoint Connection %s_%s still exists", rs.Primary.ID, aws.StringValue(v.VpcEndpointId))
+					}
+				}
+
+				continue
+			}
+
 			serviceID, vpcEndpointID, err := tfec2.VPCEndpointConnectionAccepterParseResourceID(rs.Primary.ID)
 
 			if err != nil {
@@ -80,7 +143,7 @@ func testAccCheckVPCEndpointConnectionAccepterDestroy(ctx context.Context) resou
 	}
 }
 
-func testAccVPCEndpointConnectionAccepterConfig_crossAccount(rName string) string {
+func testAccVPCEndpointConnectionAccepterConfig_base(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
@@ -246,7 +309,11 @@ resource "aws_security_group" "test" {
     Name = %[1]q
   }
 }
+`, rName))
+}
 
+func testAccVPCEndpointConnectionAccepterConfig_crossAccount(rName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointConnectionAccepterConfig_base(rName), fmt.Sprintf(`
 resource "aws_vpc_endpoint" "test" {
   provider = "awsalternate"
 
@@ -271,3 +338,32 @@ resource "aws_vpc_endpoint_connection_accepter" "test" {
 }
 `, rName))
 }
+
+func testAccVPCEndpointConnectionAccepterConfig_bulk(rName string, n int) string {
+	return acctest.ConfigCompose(testAccVPCEndpointConnectionAccepterConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_endpoint" "test" {
+  provider = "awsalternate"
+
+  count = 2
+
+  vpc_id              = aws_vpc.test_alternate.id
+  service_name        = aws_vpc_endpoint_service.test.service_name
+  subnet_ids          = data.aws_subnets.alternate_intersect.ids
+  vpc_endpoint_type   = "Interface"
+  private_dns_enabled = false
+
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint_connection_accepter" "test" {
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.test.id
+  vpc_endpoint_ids        = slice(aws_vpc_endpoint.test[*].id, 0, %[2]d)
+}
+`, rName, n))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_endpoint_service_private_dns_verification")
+func ResourceVPCEndpointServicePrivateDNSVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCEndpointServicePrivateDNSVerificationCreate,
+		ReadWithoutTimeout:   resourceVPCEndpointServicePrivateDNSVerificationRead,
+		DeleteWithoutTimeout: resourceVPCEndpointServicePrivateDNSVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_private_dns_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	serviceID := d.Get("service_id").(string)
+	input := &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerificationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting EC2 VPC Endpoint Service (%s) private DNS verification: %s", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+
+	if d.Get("wait_for_private_dns_verification").(bool) {
+		if _, err := WaitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, serviceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint Service (%s) private DNS verification: %s", serviceID, err)
+		}
+	}
+
+	return append(diags, resourceVPCEndpointServicePrivateDNSVerificationRead(ctx, d, meta)...)
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	_, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Endpoint Service (%s) not found, removing Private DNS Verification from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] EC2 VPC Endpoint Service Private DNS Verification (%s) is only removed from state", d.Id())
+
+	return nil
+}
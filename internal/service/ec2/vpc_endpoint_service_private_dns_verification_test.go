@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var svcCfg ec2.ServiceConfiguration
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, rootDomain, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_private_dns_verification", "true"),
+				),
+			},
+			{
+				// Refresh the endpoint service to pick up the verified state.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(serviceResourceName, "private_dns_name_configuration.0.state", ec2.DnsNameStateVerified),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, rootDomain, domainName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_baseNetworkLoadBalancer(rName, 1), fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = false
+  network_load_balancer_arns = aws_lb.test[*].arn
+  private_dns_name           = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "${aws_vpc_endpoint_service.test.private_dns_name_configuration[0].name}.${aws_vpc_endpoint_service.test.private_dns_name}"
+  type    = aws_vpc_endpoint_service.test.private_dns_name_configuration[0].type
+  ttl     = 60
+  records = [aws_vpc_endpoint_service.test.private_dns_name_configuration[0].value]
+}
+
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  service_id                        = aws_vpc_endpoint_service.test.id
+  wait_for_private_dns_verification = true
+
+  depends_on = [aws_route53_record.test]
+}
+`, rName, rootDomain, domainName))
+}
@@ -747,18 +747,52 @@ func updateSecurityGroupRules(ctx context.Context, conn *ec2.EC2, d *schema.Reso
 	os := SecurityGroupExpandRules(o.(*schema.Set))
 	ns := SecurityGroupExpandRules(n.(*schema.Set))
 
-	del, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, os.Difference(ns).List()))
+	removed, added := os.Difference(ns), ns.Difference(os)
+
+	// Rules whose only change is the description are updated in place
+	// rather than revoked and re-authorized, which would briefly drop traffic.
+	updated := securityGroupRulesWithDescriptionChanges(removed, added)
+
+	del, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, removed.List()))
 
 	if err != nil {
 		return fmt.Errorf("updating rules: %w", err)
 	}
 
-	add, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, ns.Difference(os).List()))
+	add, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, added.List()))
 
 	if err != nil {
 		return fmt.Errorf("updating rules: %w", err)
 	}
 
+	upd, err := ExpandIPPerms(group, SecurityGroupCollapseRules(ruleType, updated.List()))
+
+	if err != nil {
+		return fmt.Errorf("updating rules: %w", err)
+	}
+
+	if len(upd) > 0 {
+		if ruleType == securityGroupRuleTypeEgress {
+			input := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: upd,
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgressWithContext(ctx, input)
+		} else {
+			input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       group.GroupId,
+				IpPermissions: upd,
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngressWithContext(ctx, input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("updating Security Group (%s) rule descriptions: %w", ruleType, err)
+		}
+	}
+
 	// TODO: We need to handle partial state better in the in-between
 	// in this update.
 
@@ -815,6 +849,41 @@ func updateSecurityGroupRules(ctx context.Context, conn *ec2.EC2, d *schema.Reso
 	return nil
 }
 
+// securityGroupRulesWithDescriptionChanges finds the expanded rules that appear in
+// both the removed and added sets and differ only by description.
+// Matching rules are taken out of both input sets and returned with their new descriptions.
+func securityGroupRulesWithDescriptionChanges(removed, added *schema.Set) *schema.Set {
+	updated := schema.NewSet(SecurityGroupRuleHash, nil)
+
+	hashWithoutDescription := func(v interface{}) int {
+		m := make(map[string]interface{})
+		for k, v := range v.(map[string]interface{}) {
+			m[k] = v
+		}
+		m["description"] = ""
+
+		return SecurityGroupRuleHash(m)
+	}
+
+	addedByHash := make(map[int]interface{})
+	for _, v := range added.List() {
+		addedByHash[hashWithoutDescription(v)] = v
+	}
+
+	for _, v := range removed.List() {
+		hash := hashWithoutDescription(v)
+
+		if a, ok := addedByHash[hash]; ok {
+			updated.Add(a)
+			removed.Remove(v)
+			added.Remove(a)
+			delete(addedByHash, hash)
+		}
+	}
+
+	return updated
+}
+
 // Takes the result of flatmap.Expand for an array of ingress/egress security
 // group rules and returns EC2 API compatible objects. This function will error
 // if it finds invalid permissions input, namely a protocol of "-1" with either
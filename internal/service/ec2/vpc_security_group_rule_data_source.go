@@ -124,7 +124,7 @@ func (d *dataSourceSecurityGroupRule) Read(ctx context.Context, request datasour
 	data.IPProtocol = flex.StringToFramework(ctx, output.IpProtocol)
 	data.IsEgress = flex.BoolToFramework(ctx, output.IsEgress)
 	data.PrefixListID = flex.StringToFramework(ctx, output.PrefixListId)
-	data.ReferencedSecurityGroupID = d.flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo)
+	data.ReferencedSecurityGroupID = flattenReferencedSecurityGroupFramework(ctx, output.ReferencedGroupInfo, d.Meta().AccountID)
 	data.SecurityGroupID = flex.StringToFramework(ctx, output.GroupId)
 	data.SecurityGroupRuleID = flex.StringToFramework(ctx, output.SecurityGroupRuleId)
 	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
@@ -145,13 +145,13 @@ func (d *dataSourceSecurityGroupRule) arn(_ context.Context, id string) types.St
 	return types.StringValue(arn)
 }
 
-func (d *dataSourceSecurityGroupRule) flattenReferencedSecurityGroup(ctx context.Context, apiObject *ec2.ReferencedSecurityGroup) types.String {
+func flattenReferencedSecurityGroupFramework(ctx context.Context, apiObject *ec2.ReferencedSecurityGroup, accountID string) types.String {
 	// TODO Consider reusing resourceSecurityGroupRule.flattenReferencedSecurityGroup().
 	if apiObject == nil {
 		return types.StringNull()
 	}
 
-	if apiObject.UserId == nil || aws.StringValue(apiObject.UserId) == d.Meta().AccountID {
+	if apiObject.UserId == nil || aws.StringValue(apiObject.UserId) == accountID {
 		return flex.StringToFramework(ctx, apiObject.GroupId)
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -37,6 +38,15 @@ func (d *dataSourceSecurityGroupRules) Schema(ctx context.Context, req datasourc
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"security_group_rules": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[securityGroupRuleData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[securityGroupRuleData](ctx),
+				Computed:    true,
+			},
 			"tags": tftags.TagsAttribute(),
 		},
 		Blocks: map[string]schema.Block{
@@ -60,6 +70,10 @@ func (d *dataSourceSecurityGroupRules) Read(ctx context.Context, request datasou
 		Filters: append(newCustomFilterListFramework(ctx, data.Filters), newTagFilterList(Tags(tftags.New(ctx, data.Tags)))...),
 	}
 
+	if securityGroupIDs := flex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroupIDs); len(securityGroupIDs) > 0 {
+		input.Filters = append(input.Filters, newFilter("group-id", securityGroupIDs))
+	}
+
 	if len(input.Filters) == 0 {
 		// Don't send an empty filters list; the EC2 API won't accept it.
 		input.Filters = nil
@@ -74,19 +88,50 @@ func (d *dataSourceSecurityGroupRules) Read(ctx context.Context, request datasou
 	}
 
 	var securityGroupRuleIDs []string
+	var securityGroupRules []securityGroupRuleData
 	for _, v := range output {
 		securityGroupRuleIDs = append(securityGroupRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
+		securityGroupRules = append(securityGroupRules, securityGroupRuleData{
+			CIDRIPv4:                  flex.StringToFramework(ctx, v.CidrIpv4),
+			CIDRIPv6:                  flex.StringToFramework(ctx, v.CidrIpv6),
+			Description:               flex.StringToFramework(ctx, v.Description),
+			FromPort:                  flex.Int64ToFramework(ctx, v.FromPort),
+			IPProtocol:                flex.StringToFramework(ctx, v.IpProtocol),
+			IsEgress:                  flex.BoolToFramework(ctx, v.IsEgress),
+			PrefixListID:              flex.StringToFramework(ctx, v.PrefixListId),
+			ReferencedSecurityGroupID: flattenReferencedSecurityGroupFramework(ctx, v.ReferencedGroupInfo, d.Meta().AccountID),
+			SecurityGroupID:           flex.StringToFramework(ctx, v.GroupId),
+			SecurityGroupRuleID:       flex.StringToFramework(ctx, v.SecurityGroupRuleId),
+			ToPort:                    flex.Int64ToFramework(ctx, v.ToPort),
+		})
 	}
 
 	data.ID = types.StringValue(d.Meta().Region)
 	data.IDs = flex.FlattenFrameworkStringValueList(ctx, securityGroupRuleIDs)
+	data.SecurityGroupRules = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, securityGroupRules)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 type dataSourceSecurityGroupRulesData struct {
-	Filters types.Set    `tfsdk:"filter"`
-	ID      types.String `tfsdk:"id"`
-	IDs     types.List   `tfsdk:"ids"`
-	Tags    types.Map    `tfsdk:"tags"`
+	Filters            types.Set                                              `tfsdk:"filter"`
+	ID                 types.String                                           `tfsdk:"id"`
+	IDs                types.List                                             `tfsdk:"ids"`
+	SecurityGroupIDs   types.Set                                              `tfsdk:"security_group_ids"`
+	SecurityGroupRules fwtypes.ListNestedObjectValueOf[securityGroupRuleData] `tfsdk:"security_group_rules"`
+	Tags               types.Map                                              `tfsdk:"tags"`
+}
+
+type securityGroupRuleData struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                types.String `tfsdk:"ip_protocol"`
+	IsEgress                  types.Bool   `tfsdk:"is_egress"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
 }
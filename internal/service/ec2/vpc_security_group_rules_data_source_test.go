@@ -51,6 +51,42 @@ func TestAccVPCSecurityGroupRulesDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupRulesDataSource_securityGroupIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesDataSourceConfig_securityGroupIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					// The default egress rule plus the ingress rule.
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "security_group_rules.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "80",
+						"ip_protocol": "tcp",
+						"is_egress":   "false",
+						"to_port":     "8080",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "security_group_rules.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"ip_protocol": "-1",
+						"is_egress":   "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "security_group_rules.*.security_group_rule_id", "aws_vpc_security_group_ingress_rule.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "security_group_rules.*.security_group_id", "aws_security_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCSecurityGroupRulesDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
 resource "aws_vpc_security_group_ingress_rule" "test" {
@@ -108,3 +144,22 @@ data "aws_vpc_security_group_rules" "test" {
 }
 `, rName))
 }
+
+func testAccVPCSecurityGroupRulesDataSourceConfig_securityGroupIDs(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+
+data "aws_vpc_security_group_rules" "test" {
+  security_group_ids = [aws_security_group.test.id]
+
+  depends_on = [aws_vpc_security_group_ingress_rule.test]
+}
+`)
+}
@@ -376,6 +376,45 @@ func TestSecurityGroupExpandCollapseRules(t *testing.T) {
 	}
 }
 
+func TestSecurityGroupRulesWithDescriptionChanges(t *testing.T) {
+	t.Parallel()
+
+	rule := func(cidrBlock, description string) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":    "tcp",
+			"from_port":   int(443),
+			"to_port":     int(443),
+			"description": description,
+			"self":        false,
+			"cidr_blocks": []interface{}{cidrBlock},
+		}
+	}
+
+	removed := schema.NewSet(tfec2.SecurityGroupRuleHash, []interface{}{
+		rule("10.0.0.1/32", "old description"),
+		rule("10.0.0.2/32", ""),
+	})
+	added := schema.NewSet(tfec2.SecurityGroupRuleHash, []interface{}{
+		rule("10.0.0.1/32", "new description"),
+		rule("10.0.0.3/32", ""),
+	})
+
+	updated := tfec2.SecurityGroupRulesWithDescriptionChanges(removed, added)
+
+	if got, want := updated.Len(), 1; got != want {
+		t.Fatalf("updated rules: got %d, want %d", got, want)
+	}
+	if !updated.Contains(rule("10.0.0.1/32", "new description")) {
+		t.Errorf("updated rules do not contain the rule with the new description: %#v", updated.List())
+	}
+	if got, want := removed.Len(), 1; got != want || !removed.Contains(rule("10.0.0.2/32", "")) {
+		t.Errorf("removed rules: got %#v", removed.List())
+	}
+	if got, want := added.Len(), 1; got != want || !added.Contains(rule("10.0.0.3/32", "")) {
+		t.Errorf("added rules: got %#v", added.List())
+	}
+}
+
 func TestSecurityGroupIPPermGather(t *testing.T) {
 	t.Parallel()
 
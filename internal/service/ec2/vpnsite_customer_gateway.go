@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,7 +53,8 @@ func ResourceCustomerGateway() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(customerGatewayCertificateARNCheck),
+				AtLeastOneOf: []string{"certificate_arn", "ip_address"},
 			},
 			"device_name": {
 				Type:         schema.TypeString,
@@ -65,6 +67,7 @@ func ResourceCustomerGateway() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IsIPv4Address,
+				AtLeastOneOf: []string{"certificate_arn", "ip_address"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -76,7 +79,10 @@ func ResourceCustomerGateway() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffCustomerGatewayBGPASN,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -192,3 +198,35 @@ func resourceCustomerGatewayDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// customerGatewayBGPASNRanges are the BGP ASNs accepted for each customer gateway type.
+// ASNs above 2147483647 must be passed as BgpAsnExtended, which the AWS SDK for Go v1 does not yet support.
+var customerGatewayBGPASNRanges = map[string][2]int64{
+	ec2.GatewayTypeIpsec1: {1, 2147483647},
+}
+
+func customizeDiffCustomerGatewayBGPASN(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("bgp_asn") || !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	gatewayType := diff.Get("type").(string)
+	bounds, ok := customerGatewayBGPASNRanges[gatewayType]
+
+	if !ok {
+		return nil
+	}
+
+	asn, err := strconv.ParseInt(diff.Get("bgp_asn").(string), 10, 64)
+
+	if err != nil {
+		// Reported by the attribute's ValidateFunc.
+		return nil
+	}
+
+	if asn < bounds[0] || asn > bounds[1] {
+		return fmt.Errorf("bgp_asn (%d) must be in the range %d to %d for %s customer gateways", asn, bounds[0], bounds[1], gatewayType)
+	}
+
+	return nil
+}
@@ -181,6 +181,41 @@ func TestAccSiteVPNCustomerGateway_4ByteASN(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNCustomerGateway_4ByteASNOutOfRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSiteVPNCustomerGatewayConfig_siteVPN4ByteASN(rName, "4200000000"),
+				ExpectError: regexache.MustCompile(`must be in the range 1 to 2147483647 for ipsec.1 customer gateways`),
+			},
+		},
+	})
+}
+
+func TestAccSiteVPNCustomerGateway_noIPAddressOrCertificate(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSiteVPNCustomerGatewayConfig_noIPAddressOrCertificate(),
+				ExpectError: regexache.MustCompile(`one of .certificate_arn,ip_address. must be specified`),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNCustomerGateway_certificate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var gateway ec2.CustomerGateway
@@ -357,6 +392,15 @@ resource "aws_customer_gateway" "test" {
 `, rName, rBgpAsn)
 }
 
+func testAccSiteVPNCustomerGatewayConfig_noIPAddressOrCertificate() string {
+	return `
+resource "aws_customer_gateway" "test" {
+  bgp_asn = 65000
+  type    = "ipsec.1"
+}
+`
+}
+
 func testAccSiteVPNCustomerGatewayConfig_cas(rootDomain, subDomain string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "root" {
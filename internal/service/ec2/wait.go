@@ -2649,6 +2649,25 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 	return nil, err
 }
 
+func WaitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.PrivateDnsNameConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.DnsNameStatePendingVerification},
+		Target:     []string{ec2.DnsNameStateVerified},
+		Refresh:    StatusVPCEndpointServicePrivateDNSNameConfiguration(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.PrivateDnsNameConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_ecr_image_replication_status", name="Image Replication Status")
+func dataSourceImageReplicationStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImageReplicationStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"replication_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registry_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageReplicationStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	repositoryName := d.Get("repository_name").(string)
+	input := &ecr.DescribeImageReplicationStatusInput{
+		ImageId:        &ecr.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	registryID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("registry_id"); ok {
+		registryID = v.(string)
+		input.RegistryId = aws.String(registryID)
+	}
+
+	output, err := findImageReplicationStatus(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Image (%s) Replication Status: %s", repositoryName, err)
+	}
+
+	d.SetId(aws.StringValue(output.ImageId.ImageDigest))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("registry_id", registryID)
+	if err := d.Set("replication_status", flattenImageReplicationStatuses(output.ReplicationStatuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting replication_status: %s", err)
+	}
+	d.Set("repository_name", output.RepositoryName)
+
+	return diags
+}
+
+func findImageReplicationStatus(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeImageReplicationStatusInput) (*ecr.DescribeImageReplicationStatusOutput, error) {
+	output, err := conn.DescribeImageReplicationStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenImageReplicationStatuses(apiObjects []*ecr.ImageReplicationStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code": aws.StringValue(apiObject.FailureCode),
+			"region":       aws.StringValue(apiObject.Region),
+			"registry_id":  aws.StringValue(apiObject.RegistryId),
+			"status":       aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImageReplicationStatusDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo, tag := "137112412989", "amazonlinux", "latest"
+	dataSourceName := "data.aws_ecr_image_replication_status.test"
+	imageDataSourceName := "data.aws_ecr_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageReplicationStatusDataSourceConfig_basic(registry, repo, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_digest", imageDataSourceName, "image_digest"),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+					resource.TestCheckResourceAttrSet(dataSourceName, "replication_status.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_name", repo),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageReplicationStatusDataSourceConfig_basic(reg, repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  image_tag       = %[3]q
+}
+
+data "aws_ecr_image_replication_status" "test" {
+  registry_id     = data.aws_ecr_image.test.registry_id
+  repository_name = data.aws_ecr_image.test.repository_name
+  image_tag       = %[3]q
+}
+`, reg, repo, tag)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_ecr_images")
+func DataSourceImages() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"image_digests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"image_tag_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_pushed_at": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"pushed_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"pushed_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecr.TagStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRConn(ctx)
+
+	repositoryName := d.Get("repository_name").(string)
+	input := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag_status"); ok {
+		input.Filter = &ecr.DescribeImagesFilter{
+			TagStatus: aws.String(v.(string)),
+		}
+	}
+
+	imageDetails, err := FindImageDetails(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Images (%s): %s", repositoryName, err)
+	}
+
+	var tagRegex *regexp.Regexp
+	if v, ok := d.GetOk("image_tag_regex"); ok {
+		tagRegex = regexache.MustCompile(v.(string))
+	}
+
+	var pushedAfter, pushedBefore time.Time
+	if v, ok := d.GetOk("pushed_after"); ok {
+		pushedAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("pushed_before"); ok {
+		pushedBefore, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	imageDetails = slices.DeleteFunc(imageDetails, func(v *ecr.ImageDetail) bool {
+		pushedAt := aws.TimeValue(v.ImagePushedAt)
+
+		if !pushedAfter.IsZero() && pushedAt.Before(pushedAfter) {
+			return true
+		}
+
+		if !pushedBefore.IsZero() && !pushedAt.Before(pushedBefore) {
+			return true
+		}
+
+		if tagRegex != nil {
+			return !slices.ContainsFunc(aws.StringValueSlice(v.ImageTags), tagRegex.MatchString)
+		}
+
+		return false
+	})
+
+	// Most recently pushed images first.
+	slices.SortStableFunc(imageDetails, func(a, b *ecr.ImageDetail) int {
+		return aws.TimeValue(b.ImagePushedAt).Compare(aws.TimeValue(a.ImagePushedAt))
+	})
+
+	repository, err := FindRepository(ctx, conn, &ecr.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{repositoryName}),
+		RegistryId:      input.RegistryId,
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Repository (%s): %s", repositoryName, err)
+	}
+
+	repositoryURI := aws.StringValue(repository.RepositoryUri)
+	var digests []string
+	var images []interface{}
+
+	for _, v := range imageDetails {
+		digest := aws.StringValue(v.ImageDigest)
+		digests = append(digests, digest)
+		images = append(images, map[string]interface{}{
+			"image_digest":        digest,
+			"image_pushed_at":     aws.TimeValue(v.ImagePushedAt).Unix(),
+			"image_size_in_bytes": aws.Int64Value(v.ImageSizeInBytes),
+			"image_tags":          aws.StringValueSlice(v.ImageTags),
+			"image_uri":           fmt.Sprintf("%s@%s", repositoryURI, digest),
+		})
+	}
+
+	d.SetId(aws.StringValue(repository.RepositoryArn))
+	d.Set("image_digests", digests)
+	if err := d.Set("images", images); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting images: %s", err)
+	}
+	d.Set("registry_id", repository.RegistryId)
+
+	return diags
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo, tag := "137112412989", "amazonlinux", "latest"
+	dataSourceName := "data.aws_ecr_images.test"
+	imageDataSourceName := "data.aws_ecr_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_basic(registry, repo, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "image_digests.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_digests.0", imageDataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_digest", imageDataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_pushed_at", imageDataSourceName, "image_pushed_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_size_in_bytes", imageDataSourceName, "image_size_in_bytes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_uri", imageDataSourceName, "image_uri"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "images.0.image_tags.*", tag),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_pushedAt(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_pushedAt(registry, repo),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "image_digests.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_basic(reg, repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  image_tag       = %[3]q
+}
+
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_status      = "TAGGED"
+  image_tag_regex = "^%[3]s$"
+}
+`, reg, repo, tag)
+}
+
+func testAccImagesDataSourceConfig_pushedAt(reg, repo string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  pushed_after    = "2000-01-01T00:00:00Z"
+  pushed_before   = "2000-01-02T00:00:00Z"
+}
+`, reg, repo)
+}
@@ -5,8 +5,10 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +30,8 @@ func ResourceReplicationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceReplicationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -74,6 +78,12 @@ func ResourceReplicationConfiguration() *schema.Resource {
 												"filter": {
 													Type:     schema.TypeString,
 													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(2, 256),
+														validation.StringMatch(
+															regexache.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]*)*/)*[a-z0-9]*(?:[._-][a-z0-9]*)*)$`),
+															"must only include lowercase alphanumeric, underscore, period, hyphen, or slash characters"),
+													),
 												},
 												"filter_type": {
 													Type:         schema.TypeString,
@@ -148,6 +158,37 @@ func resourceReplicationConfigurationDelete(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+// resourceReplicationConfigurationCustomizeDiff validates replication rule destinations at plan time.
+// A registry can't replicate to itself and a rule can't contain the same destination more than once.
+func resourceReplicationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	region, accountID := meta.(*conns.AWSClient).Region, meta.(*conns.AWSClient).AccountID
+
+	for i, rule := range expandReplicationConfigurationReplicationConfigurationRules(d.Get("replication_configuration.0.rule").([]interface{})) {
+		destinations := make(map[string]struct{})
+
+		for _, destination := range rule.Destinations {
+			destinationRegion, destinationRegistryID := aws.StringValue(destination.Region), aws.StringValue(destination.RegistryId)
+
+			// Values may be unknown until apply.
+			if destinationRegion == "" || destinationRegistryID == "" {
+				continue
+			}
+
+			if destinationRegion == region && destinationRegistryID == accountID {
+				return fmt.Errorf("replication_configuration.0.rule.%d: destination (%s, %s) is the source registry", i, destinationRegion, destinationRegistryID)
+			}
+
+			key := destinationRegion + ":" + destinationRegistryID
+			if _, ok := destinations[key]; ok {
+				return fmt.Errorf("replication_configuration.0.rule.%d: duplicate destination (%s, %s)", i, destinationRegion, destinationRegistryID)
+			}
+			destinations[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func expandReplicationConfigurationReplicationConfiguration(data []interface{}) *ecr.ReplicationConfiguration {
 	if len(data) == 0 || data[0] == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -22,6 +23,7 @@ func TestAccECRReplicationConfiguration_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		"basic":            testAccReplicationConfiguration_basic,
 		"repositoryFilter": testAccReplicationConfiguration_repositoryFilter,
+		"validation":       testAccReplicationConfiguration_validation,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -146,6 +148,31 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 	})
 }
 
+func testAccReplicationConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReplicationConfigurationConfig_basic(acctest.Region()),
+				ExpectError: regexache.MustCompile(`is the source registry`),
+			},
+			{
+				Config:      testAccReplicationConfigurationConfig_multipleRegion(acctest.AlternateRegion(), acctest.AlternateRegion()),
+				ExpectError: regexache.MustCompile(`duplicate destination`),
+			},
+			{
+				Config:      testAccReplicationConfigurationConfig_repositoryFilterValue(acctest.AlternateRegion(), "Invalid_Prefix"),
+				ExpectError: regexache.MustCompile(`must only include lowercase alphanumeric`),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[name]
@@ -278,3 +305,25 @@ resource "aws_ecr_replication_configuration" "test" {
 }
 `, region)
 }
+
+func testAccReplicationConfigurationConfig_repositoryFilterValue(region, filter string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = %[2]q
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+  }
+}
+`, region, filter)
+}
@@ -40,6 +40,15 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceImage,
 			TypeName: "aws_ecr_image",
 		},
+		{
+			Factory:  dataSourceImageReplicationStatus,
+			TypeName: "aws_ecr_image_replication_status",
+			Name:     "Image Replication Status",
+		},
+		{
+			Factory:  DataSourceImages,
+			TypeName: "aws_ecr_images",
+		},
 		{
 			Factory:  dataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -17,8 +19,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	accountSettingValueDisabled = "disabled"
+	accountSettingValueEnabled  = "enabled"
+	accountSettingValueOff      = "off"
+	accountSettingValueOn       = "on"
+)
+
+// accountSettingDefaultValues holds the values that can be set for each account setting.
+// The first value is the one the setting is returned to when the resource is destroyed.
+var accountSettingDefaultValues = map[string][]string{
+	ecs.SettingNameAwsvpcTrunking:                  {accountSettingValueDisabled, accountSettingValueEnabled},
+	ecs.SettingNameContainerInsights:               {accountSettingValueDisabled, accountSettingValueEnabled},
+	ecs.SettingNameContainerInstanceLongArnFormat:  {accountSettingValueDisabled, accountSettingValueEnabled},
+	ecs.SettingNameFargateFipsmode:                 {accountSettingValueDisabled, accountSettingValueEnabled},
+	ecs.SettingNameFargateTaskRetirementWaitPeriod: {"7", "0", "14"},
+	ecs.SettingNameServiceLongArnFormat:            {accountSettingValueDisabled, accountSettingValueEnabled},
+	ecs.SettingNameTagResourceAuthorization:        {accountSettingValueOff, accountSettingValueOn},
+	ecs.SettingNameTaskLongArnFormat:               {accountSettingValueDisabled, accountSettingValueEnabled},
+}
+
 // @SDKResource("aws_ecs_account_setting_default", name="Account Setting Defauilt")
 func ResourceAccountSettingDefault() *schema.Resource {
 	return &schema.Resource{
@@ -30,12 +53,18 @@ func ResourceAccountSettingDefault() *schema.Resource {
 			StateContext: resourceAccountSettingDefaultImport,
 		},
 
+		CustomizeDiff: resourceAccountSettingDefaultCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:         schema.TypeString,
-				ForceNew:     true,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(ecs.SettingName_Values(), false),
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringInSlice(ecs.SettingName_Values(), false),
+					// guardDutyActivate is read-only.
+					validation.StringNotInSlice([]string{ecs.SettingNameGuardDutyActivate}, false),
+				),
 			},
 			"principal_arn": {
 				Type:     schema.TypeString,
@@ -91,31 +120,24 @@ func resourceAccountSettingDefaultRead(ctx context.Context, d *schema.ResourceDa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	input := &ecs.ListAccountSettingsInput{
-		Name:              aws.String(d.Get("name").(string)),
-		EffectiveSettings: aws.Bool(true),
-	}
-
-	log.Printf("[DEBUG] Reading Default Account Settings: %s", input)
-	resp, err := conn.ListAccountSettingsWithContext(ctx, input)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading ECS Account Setting Defauilt (%s): %s", d.Get("name").(string), err)
-	}
+	settingName := d.Get("name").(string)
+	setting, err := findEffectiveAccountSetting(ctx, conn, settingName, "")
 
-	if len(resp.Settings) == 0 {
-		log.Printf("[WARN] Account Setting Default not set. Removing from state")
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Account Setting Default (%s) not found, removing from state", settingName)
 		d.SetId("")
 		return diags
 	}
 
-	for _, r := range resp.Settings {
-		d.SetId(aws.StringValue(r.PrincipalArn))
-		d.Set("name", r.Name)
-		d.Set("principal_arn", r.PrincipalArn)
-		d.Set("value", r.Value)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Account Setting Default (%s): %s", settingName, err)
 	}
 
+	d.SetId(aws.StringValue(setting.PrincipalArn))
+	d.Set("name", setting.Name)
+	d.Set("principal_arn", setting.PrincipalArn)
+	d.Set("value", setting.Value)
+
 	return diags
 }
 
@@ -147,10 +169,15 @@ func resourceAccountSettingDefaultDelete(ctx context.Context, d *schema.Resource
 
 	settingName := d.Get("name").(string)
 
+	settingValue := accountSettingValueDisabled
+	if v, ok := accountSettingDefaultValues[settingName]; ok {
+		settingValue = v[0]
+	}
+
 	log.Printf("[WARN] Disabling ECS Account Setting Default %s", settingName)
 	input := ecs.PutAccountSettingDefaultInput{
 		Name:  aws.String(settingName),
-		Value: aws.String("disabled"),
+		Value: aws.String(settingValue),
 	}
 
 	_, err := conn.PutAccountSettingDefaultWithContext(ctx, &input)
@@ -167,3 +194,40 @@ func resourceAccountSettingDefaultDelete(ctx context.Context, d *schema.Resource
 	log.Printf("[DEBUG] ECS Account Setting Default (%q) disabled", settingName)
 	return diags
 }
+
+func resourceAccountSettingDefaultCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("name") || !d.NewValueKnown("value") {
+		return nil
+	}
+
+	settingName := d.Get("name").(string)
+	settingValue := d.Get("value").(string)
+
+	if values, ok := accountSettingDefaultValues[settingName]; ok && !slices.Contains(values, settingValue) {
+		return fmt.Errorf("invalid value (%s) for ECS Account Setting Default (%s), expected one of: %s", settingValue, settingName, strings.Join(values, ", "))
+	}
+
+	return nil
+}
+
+func findEffectiveAccountSetting(ctx context.Context, conn *ecs.ECS, name, principalARN string) (*ecs.Setting, error) {
+	input := &ecs.ListAccountSettingsInput{
+		Name:              aws.String(name),
+		EffectiveSettings: aws.Bool(true),
+	}
+	if principalARN != "" {
+		input.PrincipalArn = aws.String(principalARN)
+	}
+
+	output, err := conn.ListAccountSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSinglePtrResult(output.Settings)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccountSettingDefaultDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_account_setting_default.test"
+	resourceName := "aws_ecs_account_setting_default.test"
+	settingName := ecs.SettingNameFargateTaskRetirementWaitPeriod
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingDefaultDataSourceConfig_basic(settingName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", settingName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "principal_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "value"),
+				),
+			},
+			{
+				Config: testAccAccountSettingDefaultDataSourceConfig_resource(settingName, "14"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", ecs.SettingTypeUser),
+					resource.TestCheckResourceAttrPair(dataSourceName, "value", resourceName, "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingDefaultDataSourceConfig_basic(settingName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_account_setting_default" "test" {
+  name = %[1]q
+}
+`, settingName)
+}
+
+func testAccAccountSettingDefaultDataSourceConfig_resource(settingName, settingValue string) string {
+	return acctest.ConfigCompose(testAccAccountSettingDefaultConfig_value(settingName, settingValue), `
+data "aws_ecs_account_setting_default" "test" {
+  name = aws_ecs_account_setting_default.test.name
+}
+`)
+}
@@ -23,11 +23,15 @@ func TestAccECSAccountSettingDefault_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(*testing.T){
-		"containerInstanceLongARNFormat": testAccAccountSettingDefault_containerInstanceLongARNFormat,
-		"serviceLongARNFormat":           testAccAccountSettingDefault_serviceLongARNFormat,
-		"taskLongARNFormat":              testAccAccountSettingDefault_taskLongARNFormat,
-		"vpcTrunking":                    testAccAccountSettingDefault_vpcTrunking,
-		"containerInsights":              testAccAccountSettingDefault_containerInsights,
+		"containerInstanceLongARNFormat":  testAccAccountSettingDefault_containerInstanceLongARNFormat,
+		"serviceLongARNFormat":            testAccAccountSettingDefault_serviceLongARNFormat,
+		"taskLongARNFormat":               testAccAccountSettingDefault_taskLongARNFormat,
+		"vpcTrunking":                     testAccAccountSettingDefault_vpcTrunking,
+		"containerInsights":               testAccAccountSettingDefault_containerInsights,
+		"fargateTaskRetirementWaitPeriod": testAccAccountSettingDefault_fargateTaskRetirementWaitPeriod,
+		"tagResourceAuthorization":        testAccAccountSettingDefault_tagResourceAuthorization,
+		"invalidValue":                    testAccAccountSettingDefault_invalidValue,
+		"dataSource":                      testAccAccountSettingDefaultDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -178,6 +182,92 @@ func testAccAccountSettingDefault_containerInsights(t *testing.T) {
 	})
 }
 
+func testAccAccountSettingDefault_fargateTaskRetirementWaitPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ecs_account_setting_default.test"
+	settingName := ecs.SettingNameFargateTaskRetirementWaitPeriod
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingDefaultConfig_value(settingName, "14"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "14"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "principal_arn", "iam", regexache.MustCompile("root")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     settingName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingDefaultConfig_value(settingName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingDefault_tagResourceAuthorization(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ecs_account_setting_default.test"
+	settingName := ecs.SettingNameTagResourceAuthorization
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingDefaultConfig_value(settingName, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", settingName),
+					resource.TestCheckResourceAttr(resourceName, "value", "on"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "principal_arn", "iam", regexache.MustCompile("root")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     settingName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountSettingDefault_invalidValue(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountSettingDefaultConfig_value(ecs.SettingNameFargateTaskRetirementWaitPeriod, "enabled"),
+				ExpectError: regexache.MustCompile(`invalid value \(enabled\) for ECS Account Setting Default`),
+			},
+			{
+				Config:      testAccAccountSettingDefaultConfig_value(ecs.SettingNameTagResourceAuthorization, "enabled"),
+				ExpectError: regexache.MustCompile(`invalid value \(enabled\) for ECS Account Setting Default`),
+			},
+		},
+	})
+}
+
 func testAccCheckAccountSettingDefaultDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
@@ -205,6 +295,19 @@ func testAccCheckAccountSettingDefaultDestroy(ctx context.Context) resource.Test
 			}
 
 			for _, value := range resp.Settings {
+				switch name {
+				case ecs.SettingNameFargateTaskRetirementWaitPeriod:
+					if aws.StringValue(value.Value) != "7" {
+						return fmt.Errorf("[Destroy Error] Account Settings (%s), still set", aws.StringValue(value.Name))
+					}
+					continue
+				case ecs.SettingNameTagResourceAuthorization:
+					if aws.StringValue(value.Value) != "off" {
+						return fmt.Errorf("[Destroy Error] Account Settings (%s), still enabled", aws.StringValue(value.Name))
+					}
+					continue
+				}
+
 				if aws.StringValue(value.Value) != "disabled" {
 					switch name {
 					case ecs.SettingNameContainerInstanceLongArnFormat:
@@ -232,3 +335,12 @@ resource "aws_ecs_account_setting_default" "test" {
 }
 `, settingName)
 }
+
+func testAccAccountSettingDefaultConfig_value(settingName, settingValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_account_setting_default" "test" {
+  name  = %[1]q
+  value = %[2]q
+}
+`, settingName, settingValue)
+}
@@ -27,6 +27,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAccountSettingDefault,
+			TypeName: "aws_ecs_account_setting_default",
+			Name:     "Account Setting Default",
+		},
 		{
 			Factory:  DataSourceCluster,
 			TypeName: "aws_ecs_cluster",
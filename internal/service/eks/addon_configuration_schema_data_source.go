@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_eks_addon_configuration_schema", name="Add-On Configuration Schema")
+func dataSourceAddonConfigurationSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonConfigurationSchemaRead,
+
+		Schema: map[string]*schema.Schema{
+			"addon_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"addon_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"configuration_schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonConfigurationSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	addonName := d.Get("addon_name").(string)
+	addonVersion := d.Get("addon_version").(string)
+	output, err := findAddonConfigurationByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s, %s) configuration schema: %s", addonName, addonVersion, err)
+	}
+
+	d.SetId(strings.Join([]string{addonName, addonVersion}, addonResourceIDSeparator))
+	d.Set("addon_name", output.AddonName)
+	d.Set("addon_version", output.AddonVersion)
+	d.Set("configuration_schema", output.ConfigurationSchema)
+
+	return diags
+}
+
+func findAddonConfigurationByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (*eks.DescribeAddonConfigurationOutput, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigurationSchema == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
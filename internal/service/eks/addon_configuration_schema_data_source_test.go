@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddonConfigurationSchemaDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_addon_configuration_schema.test"
+	versionDataSourceName := "data.aws_eks_addon_version.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfigurationSchemaDataSourceConfig_basic(addonName, clusterVersionUpgradeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "addon_name", addonName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "addon_version", versionDataSourceName, "version"),
+					acctest.CheckResourceAttrJMES(dataSourceName, "configuration_schema", "type", "object"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonConfigurationSchemaDataSourceConfig_basic(addonName, kubernetesVersion string) string {
+	return fmt.Sprintf(`
+data "aws_eks_addon_version" "test" {
+  addon_name         = %[1]q
+  kubernetes_version = %[2]q
+  most_recent        = true
+}
+
+data "aws_eks_addon_configuration_schema" "test" {
+  addon_name    = data.aws_eks_addon_version.test.addon_name
+  addon_version = data.aws_eks_addon_version.test.version
+}
+`, addonName, kubernetesVersion)
+}
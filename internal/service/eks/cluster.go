@@ -49,6 +49,7 @@ func resourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			clusterEncryptionConfigCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -668,6 +669,26 @@ func findClusterByName(ctx context.Context, conn *eks.Client, name string) (*typ
 	return output.Cluster, nil
 }
 
+func clusterEncryptionConfigCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Encryption configuration can be associated with an existing cluster only once and cannot be modified afterwards.
+	if d.Id() == "" {
+		return nil
+	}
+
+	o, n := d.GetChange("encryption_config")
+
+	if len(o.([]interface{})) != 1 {
+		return nil
+	}
+
+	// An unknown value can't be shown to leave the existing configuration unchanged.
+	if !d.NewValueKnown("encryption_config") || (d.HasChange("encryption_config") && len(n.([]interface{})) == 1) {
+		return fmt.Errorf("encryption_config cannot be modified once associated with EKS Cluster (%s)", d.Id())
+	}
+
+	return nil
+}
+
 func updateVPCConfig(ctx context.Context, conn *eks.Client, name string, vpcConfig *types.VpcConfigRequest, timeout time.Duration) error {
 	input := &eks.UpdateClusterConfigInput{
 		Name:               aws.String(name),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -258,6 +259,38 @@ func TestAccEKSCluster_Encryption_update(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_Encryption_keyUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_encryptionKey(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "encryption_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_config.0.provider.0.key_arn", "aws_kms_key.test.0", "arn"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_encryptionKey(rName, 1),
+				ExpectError: regexp.MustCompile(`encryption_config cannot be modified`),
+			},
+			{
+				Config:      testAccClusterConfig_encryptionKeyUnknown(rName),
+				ExpectError: regexp.MustCompile(`encryption_config cannot be modified`),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/19968.
 func TestAccEKSCluster_Encryption_versionUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1079,6 +1112,71 @@ resource "aws_eks_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_encryptionKey(rName string, keyIndex int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+}
+
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  encryption_config {
+    resources = ["secrets"]
+
+    provider {
+      key_arn = aws_kms_key.test[%[2]d].arn
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, keyIndex))
+}
+
+func testAccClusterConfig_encryptionKeyUnknown(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "unknown" {
+  description             = "%[1]s-unknown"
+  deletion_window_in_days = 7
+}
+
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  encryption_config {
+    resources = ["secrets"]
+
+    provider {
+      key_arn = aws_kms_key.unknown.arn
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName))
+}
+
 func testAccClusterConfig_encryptionVersion(rName, version string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_eks_fargate_profile_selectors", name="Fargate Profile Selectors")
+func dataSourceFargateProfileSelectors() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFargateProfileSelectorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"namespaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selectors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fargate_profile_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFargateProfileSelectorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var fargateProfileNames []string
+	pages := eks.NewListFargateProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Fargate Profiles: %s", err)
+		}
+
+		fargateProfileNames = append(fargateProfileNames, page.FargateProfileNames...)
+	}
+
+	var namespaces []string
+	var selectors []interface{}
+	for _, fargateProfileName := range fargateProfileNames {
+		fargateProfile, err := findFargateProfileByTwoPartKey(ctx, conn, clusterName, fargateProfileName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Fargate Profile (%s): %s", FargateProfileCreateResourceID(clusterName, fargateProfileName), err)
+		}
+
+		for _, v := range flattenFargateProfileSelectors(fargateProfile.Selectors) {
+			v["fargate_profile_name"] = fargateProfileName
+			namespaces = append(namespaces, v["namespace"].(string))
+			selectors = append(selectors, v)
+		}
+	}
+
+	d.SetId(clusterName)
+	d.Set("cluster_name", clusterName)
+	d.Set("namespaces", namespaces)
+	if err := d.Set("selectors", selectors); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting selectors: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSFargateProfileSelectorsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_fargate_profile_selectors.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartition(t, names.StandardPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFargateProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateProfileSelectorsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "namespaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "namespaces.*", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "selectors.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "selectors.*", map[string]string{
+						"fargate_profile_name": rName + "-0",
+						"namespace":            "test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "selectors.*", map[string]string{
+						"fargate_profile_name": rName + "-1",
+						"namespace":            "test",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFargateProfileSelectorsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFargateProfileConfig_multiple(rName), `
+data "aws_eks_fargate_profile_selectors" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  depends_on = [aws_eks_fargate_profile.test]
+}
+`)
+}
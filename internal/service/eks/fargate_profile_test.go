@@ -170,6 +170,8 @@ func TestAccEKSFargateProfile_tags(t *testing.T) {
 					testAccCheckFargateProfileExists(ctx, resourceName, &fargateProfile1),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
 				),
 			},
 			{
@@ -198,6 +200,69 @@ func TestAccEKSFargateProfile_tags(t *testing.T) {
 	})
 }
 
+func TestAccEKSFargateProfile_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fargateProfile types.FargateProfile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_fargate_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartition(t, names.StandardPartitionID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFargateProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("CostCenter", "providervalue1"),
+					testAccFargateProfileConfig_name(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFargateProfileExists(ctx, resourceName, &fargateProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.CostCenter", "providervalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("CostCenter", "providervalue1", "Team", "providervalue2"),
+					testAccFargateProfileConfig_name(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFargateProfileExists(ctx, resourceName, &fargateProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.CostCenter", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Team", "providervalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("CostCenter", "providervalue1"),
+					testAccFargateProfileConfig_tags1(rName, "CostCenter", "resourcevalue1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFargateProfileExists(ctx, resourceName, &fargateProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostCenter", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.CostCenter", "resourcevalue1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFargateProfileExists(ctx context.Context, n string, v *types.FargateProfile) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAddon,
 			TypeName: "aws_eks_addon",
 		},
+		{
+			Factory:  dataSourceAddonConfigurationSchema,
+			TypeName: "aws_eks_addon_configuration_schema",
+			Name:     "Add-On Configuration Schema",
+		},
 		{
 			Factory:  dataSourceAddonVersion,
 			TypeName: "aws_eks_addon_version",
@@ -57,6 +62,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceClusters,
 			TypeName: "aws_eks_clusters",
 		},
+		{
+			Factory:  dataSourceFargateProfileSelectors,
+			TypeName: "aws_eks_fargate_profile_selectors",
+			Name:     "Fargate Profile Selectors",
+		},
 		{
 			Factory:  dataSourceNodeGroup,
 			TypeName: "aws_eks_node_group",
@@ -139,28 +139,36 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 				DiffSuppressFunc: descriptionDiffSuppress,
 				StateFunc:        descriptionStateFunc,
 			},
-			// global_replication_group_members cannot be correctly implemented because any secondary
-			// replication groups will be added after this resource completes.
-			// "global_replication_group_members": {
-			// 	Type:     schema.TypeSet,
-			// 	Computed: true,
-			// 	Elem: &schema.Resource{
-			// 		Schema: map[string]*schema.Schema{
-			// 			"replication_group_id": {
-			// 				Type:     schema.TypeString,
-			// 				Computed: true,
-			// 			},
-			// 			"replication_group_region": {
-			// 				Type:     schema.TypeString,
-			// 				Computed: true,
-			// 			},
-			// 			"role": {
-			// 				Type:     schema.TypeString,
-			// 				Computed: true,
-			// 			},
-			// 		},
-			// 	},
-			// },
+			// Secondary replication groups are added after this resource completes,
+			// so global_replication_group_members only reflects them after a subsequent refresh.
+			"global_replication_group_members": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic_failover_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"replication_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_group_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"num_node_groups": {
 				Type:         schema.TypeInt,
 				Computed:     true,
@@ -427,6 +435,9 @@ func resourceGlobalReplicationGroupRead(ctx context.Context, d *schema.ResourceD
 	}
 	d.Set("num_node_groups", len(globalReplicationGroup.GlobalNodeGroups))
 	d.Set("automatic_failover_enabled", flattenGlobalReplicationGroupAutomaticFailoverEnabled(globalReplicationGroup.Members))
+	if err := d.Set("global_replication_group_members", flattenGlobalReplicationGroupMembers(globalReplicationGroup.Members)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting global_replication_group_members: %s", err)
+	}
 
 	d.Set("primary_replication_group_id", flattenGlobalReplicationGroupPrimaryGroupID(globalReplicationGroup.Members))
 
@@ -445,6 +456,10 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupNodeTypeUpdater(d.Get("cache_node_type").(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s) node type: %s", d.Id(), err)
 		}
+
+		if err := waitGlobalReplicationGroupMembersAvailable(ctx, conn, d.Id(), meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s) node type: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("automatic_failover_enabled") {
@@ -472,6 +487,11 @@ func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.Resourc
 				return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s): %s", d.Id(), err)
 			}
 		}
+
+		// The upgrade is rolled out to each member in turn.
+		if err := waitGlobalReplicationGroupMembersAvailable(ctx, conn, d.Id(), meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache Global Replication Group (%s) engine version: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("global_replication_group_description") {
@@ -561,6 +581,29 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	return nil
 }
 
+// waitGlobalReplicationGroupMembersAvailable waits for the members of a Global Replication Group in the specified Region
+// to be available, as changes to a Global Replication Group are rolled out to each of its members in turn.
+// Members in other Regions are only covered by the status of the Global Replication Group itself.
+func waitGlobalReplicationGroupMembersAvailable(ctx context.Context, conn *elasticache.ElastiCache, globalReplicationGroupID, region string, timeout time.Duration) error {
+	globalReplicationGroup, err := waitGlobalReplicationGroupAvailable(ctx, conn, globalReplicationGroupID, timeout)
+	if err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if aws.StringValue(member.ReplicationGroupRegion) != region {
+			continue
+		}
+
+		id := aws.StringValue(member.ReplicationGroupId)
+		if _, err := WaitReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for member Replication Group (%s): %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -624,6 +667,30 @@ func flattenGlobalReplicationGroupAutomaticFailoverEnabled(members []*elasticach
 	return aws.StringValue(member.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled
 }
 
+func flattenGlobalReplicationGroupMembers(members []*elasticache.GlobalReplicationGroupMember) []any {
+	if len(members) == 0 {
+		return nil
+	}
+
+	var l []any
+
+	for _, member := range members {
+		if member == nil {
+			continue
+		}
+
+		l = append(l, map[string]any{
+			"automatic_failover_enabled": aws.StringValue(member.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled,
+			"replication_group_id":       aws.StringValue(member.ReplicationGroupId),
+			"replication_group_region":   aws.StringValue(member.ReplicationGroupRegion),
+			"role":                       aws.StringValue(member.Role),
+			"status":                     aws.StringValue(member.Status),
+		})
+	}
+
+	return l
+}
+
 func flattenGlobalNodeGroups(nodeGroups []*elasticache.GlobalNodeGroup) []any {
 	if len(nodeGroups) == 0 {
 		return nil
@@ -66,6 +66,12 @@ func TestAccElastiCacheGlobalReplicationGroup_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "global_replication_group_id", regexache.MustCompile(tfelasticache.GlobalReplicationGroupRegionPrefixFormat+rName)),
 					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", tfelasticache.EmptyDescription),
 					resource.TestCheckResourceAttr(resourceName, "global_node_groups.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_replication_group_members.*", map[string]string{
+						"replication_group_id":     primaryReplicationGroupId,
+						"replication_group_region": acctest.Region(),
+						"role":                     tfelasticache.GlobalReplicationGroupMemberRolePrimary,
+					}),
 					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "0"),
 					resource.TestCheckResourceAttr(resourceName, "primary_replication_group_id", primaryReplicationGroupId),
 					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "false"),
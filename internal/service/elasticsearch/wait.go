@@ -146,3 +146,23 @@ func waitForDomainDelete(ctx context.Context, conn *elasticsearch.ElasticsearchS
 
 	return err
 }
+
+// waitDomainAccessPoliciesActive waits for a domain's access policy change to propagate.
+// Data plane requests made before then may fail with 403 Forbidden.
+func waitDomainAccessPoliciesActive(ctx context.Context, conn *elasticsearch.ElasticsearchService, domainName string, timeout time.Duration) (*elasticsearch.AccessPoliciesStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{elasticsearch.OptionStateProcessing, elasticsearch.OptionStateRequiresIndexDocuments},
+		Target:     []string{elasticsearch.OptionStateActive},
+		Refresh:    statusDomainAccessPolicies(ctx, conn, domainName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticsearch.AccessPoliciesStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
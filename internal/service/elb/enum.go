@@ -11,3 +11,9 @@ const (
 	ReferenceSecurityPolicy  = "Reference-Security-Policy"
 	SSLNegotiationPolicyType = "SSLNegotiationPolicyType"
 )
+
+// Types of the load balancer that a Classic Load Balancer can be migrated to.
+const (
+	loadBalancerTypeApplication = "application"
+	loadBalancerTypeNetwork     = "network"
+)
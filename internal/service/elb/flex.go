@@ -193,3 +193,112 @@ func FlattenPolicyAttributes(list []*elb.PolicyAttributeDescription) []interface
 
 	return attributes
 }
+
+// migrationLoadBalancerType returns the type of load balancer that a Classic Load Balancer
+// with the specified listeners can be migrated to. Only an Application Load Balancer can
+// terminate HTTP and HTTPS, so a Network Load Balancer is used if any listener is TCP or SSL.
+func migrationLoadBalancerType(list []*elb.ListenerDescription) string {
+	for _, v := range list {
+		if v == nil || v.Listener == nil {
+			continue
+		}
+
+		switch strings.ToUpper(aws.StringValue(v.Listener.Protocol)) {
+		case "TCP", "SSL":
+			return loadBalancerTypeNetwork
+		}
+	}
+
+	return loadBalancerTypeApplication
+}
+
+// migrationProtocol returns the Application or Network Load Balancer equivalent of a
+// Classic Load Balancer protocol.
+func migrationProtocol(lbType, protocol string) string {
+	secure := false
+
+	switch strings.ToUpper(protocol) {
+	case "HTTPS", "SSL":
+		secure = true
+	}
+
+	switch {
+	case lbType == loadBalancerTypeApplication && secure:
+		return "HTTPS"
+	case lbType == loadBalancerTypeApplication:
+		return "HTTP"
+	case secure:
+		return "TLS"
+	default:
+		return "TCP"
+	}
+}
+
+func flattenMigrationListeners(lbType string, list []*elb.ListenerDescription) []interface{} {
+	tfList := make([]interface{}, 0, len(list))
+
+	for _, v := range list {
+		if v == nil || v.Listener == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"certificate_arn": aws.StringValue(v.Listener.SSLCertificateId),
+			"port":            aws.Int64Value(v.Listener.LoadBalancerPort),
+			"protocol":        migrationProtocol(lbType, aws.StringValue(v.Listener.Protocol)),
+			"target_port":     aws.Int64Value(v.Listener.InstancePort),
+			"target_protocol": migrationProtocol(lbType, aws.StringValue(v.Listener.InstanceProtocol)),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenMigrationHealthCheck converts a Classic Load Balancer health check target,
+// e.g. "HTTP:80/index.html", into the equivalent target group health check.
+func flattenMigrationHealthCheck(lbType string, apiObject *elb.HealthCheck) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.Target) == "" {
+		return []interface{}{}
+	}
+
+	protocol, port, path := aws.StringValue(apiObject.Target), "", ""
+	if i := strings.Index(protocol, ":"); i != -1 {
+		protocol, port = protocol[:i], protocol[i+1:]
+	}
+	if i := strings.Index(port, "/"); i != -1 {
+		port, path = port[:i], port[i:]
+	}
+
+	protocol = strings.ToUpper(protocol)
+	switch protocol {
+	case "HTTP", "HTTPS":
+	case "SSL":
+		// Network Load Balancer target groups don't support TLS health checks.
+		if lbType == loadBalancerTypeApplication {
+			protocol, path = "HTTPS", "/"
+		} else {
+			protocol = "TCP"
+		}
+	default:
+		// Application Load Balancer target groups don't support TCP health checks.
+		if lbType == loadBalancerTypeApplication {
+			protocol, path = "HTTP", "/"
+		} else {
+			protocol = "TCP"
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"healthy_threshold":   aws.Int64Value(apiObject.HealthyThreshold),
+		"interval":            aws.Int64Value(apiObject.Interval),
+		"path":                path,
+		"port":                port,
+		"protocol":            protocol,
+		"timeout":             aws.Int64Value(apiObject.Timeout),
+		"unhealthy_threshold": aws.Int64Value(apiObject.UnhealthyThreshold),
+	}
+
+	return []interface{}{tfMap}
+}
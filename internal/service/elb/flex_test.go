@@ -234,3 +234,146 @@ func TestFlattenPolicyAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenMigrationListeners(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Input  []*elb.ListenerDescription
+		Type   string
+		Output []interface{}
+	}{
+		{
+			Input: []*elb.ListenerDescription{
+				{
+					Listener: &elb.Listener{
+						InstancePort:     aws.Int64(8000),
+						InstanceProtocol: aws.String("http"),
+						LoadBalancerPort: aws.Int64(80),
+						Protocol:         aws.String("http"),
+					},
+				},
+				{
+					Listener: &elb.Listener{
+						InstancePort:     aws.Int64(8000),
+						InstanceProtocol: aws.String("http"),
+						LoadBalancerPort: aws.Int64(443),
+						Protocol:         aws.String("https"),
+						SSLCertificateId: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/abc"), //lintignore:AWSAT003,AWSAT005
+					},
+				},
+			},
+			Type: "application",
+			Output: []interface{}{
+				map[string]interface{}{
+					"certificate_arn": "",
+					"port":            int64(80),
+					"protocol":        "HTTP",
+					"target_port":     int64(8000),
+					"target_protocol": "HTTP",
+				},
+				map[string]interface{}{
+					"certificate_arn": "arn:aws:acm:us-west-2:123456789012:certificate/abc", //lintignore:AWSAT003,AWSAT005
+					"port":            int64(443),
+					"protocol":        "HTTPS",
+					"target_port":     int64(8000),
+					"target_protocol": "HTTP",
+				},
+			},
+		},
+		{
+			Input: []*elb.ListenerDescription{
+				{
+					Listener: &elb.Listener{
+						InstancePort:     aws.Int64(80),
+						InstanceProtocol: aws.String("http"),
+						LoadBalancerPort: aws.Int64(80),
+						Protocol:         aws.String("http"),
+					},
+				},
+				{
+					Listener: &elb.Listener{
+						InstancePort:     aws.Int64(5432),
+						InstanceProtocol: aws.String("tcp"),
+						LoadBalancerPort: aws.Int64(5432),
+						Protocol:         aws.String("ssl"),
+					},
+				},
+			},
+			Type: "network",
+			Output: []interface{}{
+				map[string]interface{}{
+					"certificate_arn": "",
+					"port":            int64(80),
+					"protocol":        "TCP",
+					"target_port":     int64(80),
+					"target_protocol": "TCP",
+				},
+				map[string]interface{}{
+					"certificate_arn": "",
+					"port":            int64(5432),
+					"protocol":        "TLS",
+					"target_port":     int64(5432),
+					"target_protocol": "TCP",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		lbType := migrationLoadBalancerType(tc.Input)
+		if lbType != tc.Type {
+			t.Fatalf("Got type %q, expected %q", lbType, tc.Type)
+		}
+
+		output := flattenMigrationListeners(lbType, tc.Input)
+		if !reflect.DeepEqual(output, tc.Output) {
+			t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v", output, tc.Output)
+		}
+	}
+}
+
+func TestFlattenMigrationHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Target   string
+		Type     string
+		Protocol string
+		Port     string
+		Path     string
+	}{
+		{Target: "HTTP:80/index.html", Type: "application", Protocol: "HTTP", Port: "80", Path: "/index.html"},
+		{Target: "HTTPS:443/health", Type: "network", Protocol: "HTTPS", Port: "443", Path: "/health"},
+		{Target: "TCP:8080", Type: "application", Protocol: "HTTP", Port: "8080", Path: "/"},
+		{Target: "TCP:8080", Type: "network", Protocol: "TCP", Port: "8080", Path: ""},
+		{Target: "SSL:8443", Type: "application", Protocol: "HTTPS", Port: "8443", Path: "/"},
+		{Target: "SSL:8443", Type: "network", Protocol: "TCP", Port: "8443", Path: ""},
+	}
+
+	for _, tc := range cases {
+		output := flattenMigrationHealthCheck(tc.Type, &elb.HealthCheck{
+			HealthyThreshold:   aws.Int64(2),
+			Interval:           aws.Int64(30),
+			Target:             aws.String(tc.Target),
+			Timeout:            aws.Int64(5),
+			UnhealthyThreshold: aws.Int64(3),
+		})
+
+		expected := []interface{}{
+			map[string]interface{}{
+				"healthy_threshold":   int64(2),
+				"interval":            int64(30),
+				"path":                tc.Path,
+				"port":                tc.Port,
+				"protocol":            tc.Protocol,
+				"timeout":             int64(5),
+				"unhealthy_threshold": int64(3),
+			},
+		}
+
+		if !reflect.DeepEqual(output, expected) {
+			t.Fatalf("%s (%s): Got:\n\n%#v\n\nExpected:\n\n%#v", tc.Target, tc.Type, output, expected)
+		}
+	}
+}
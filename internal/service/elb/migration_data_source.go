@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_elb_migration")
+func DataSourceMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"cross_zone_load_balancing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"deregistration_delay": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"health_check": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"healthy_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"unhealthy_threshold": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"idle_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"internal": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"listener": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"load_balancer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnets": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBConn(ctx)
+
+	name := d.Get("name").(string)
+	lb, err := FindLoadBalancerByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s): %s", name, err)
+	}
+
+	lbAttrs, err := findLoadBalancerAttributesByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELB Classic Load Balancer (%s) attributes: %s", name, err)
+	}
+
+	lbType := migrationLoadBalancerType(lb.ListenerDescriptions)
+
+	d.SetId(aws.StringValue(lb.LoadBalancerName))
+	if v := lbAttrs.CrossZoneLoadBalancing; v != nil {
+		d.Set("cross_zone_load_balancing", v.Enabled)
+	}
+	if v := lbAttrs.ConnectionDraining; v != nil && aws.BoolValue(v.Enabled) {
+		d.Set("deregistration_delay", v.Timeout)
+	} else {
+		d.Set("deregistration_delay", 0)
+	}
+	if err := d.Set("health_check", flattenMigrationHealthCheck(lbType, lb.HealthCheck)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting health_check: %s", err)
+	}
+	if v := lbAttrs.ConnectionSettings; v != nil && lbType == loadBalancerTypeApplication {
+		d.Set("idle_timeout", v.IdleTimeout)
+	}
+	d.Set("instance_ids", flattenInstances(lb.Instances))
+	d.Set("internal", aws.StringValue(lb.Scheme) == "internal")
+	if err := d.Set("listener", flattenMigrationListeners(lbType, lb.ListenerDescriptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting listener: %s", err)
+	}
+	d.Set("load_balancer_type", lbType)
+	d.Set("name", lb.LoadBalancerName)
+	d.Set("security_groups", flex.FlattenStringList(lb.SecurityGroups))
+	d.Set("subnets", flex.FlattenStringList(lb.Subnets))
+	d.Set("vpc_id", lb.VPCId)
+
+	return diags
+}
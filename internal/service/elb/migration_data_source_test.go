@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elb_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBMigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elb_migration.test"
+	resourceName := "aws_elb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMigrationDataSourceConfig_basic(rName, "http"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cross_zone_load_balancing", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "deregistration_delay", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.healthy_threshold", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.path", "/health"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.port", "8000"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.timeout", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.unhealthy_threshold", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "idle_timeout", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "internal", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.target_port", "8000"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.target_protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_type", "application"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_groups.#", resourceName, "security_groups.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnets.#", resourceName, "subnets.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				Config: testAccMigrationDataSourceConfig_basic(rName, "tcp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.path", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "health_check.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(dataSourceName, "idle_timeout", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(dataSourceName, "listener.0.target_protocol", "TCP"),
+					resource.TestCheckResourceAttr(dataSourceName, "load_balancer_type", "network"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMigrationDataSourceConfig_basic(rName, protocol string) string {
+	healthCheckTarget := "HTTP:8000/health"
+	if protocol == "tcp" {
+		healthCheckTarget = "TCP:8000"
+	}
+
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_elb" "test" {
+  name            = %[1]q
+  internal        = true
+  security_groups = [aws_security_group.test.id]
+  subnets         = aws_subnet.test[*].id
+
+  connection_draining = true
+  idle_timeout        = 30
+
+  listener {
+    instance_port     = 8000
+    instance_protocol = %[2]q
+    lb_port           = 80
+    lb_protocol       = %[2]q
+  }
+
+  health_check {
+    healthy_threshold   = 2
+    unhealthy_threshold = 2
+    timeout             = 3
+    target              = %[3]q
+    interval            = 30
+  }
+}
+
+data "aws_elb_migration" "test" {
+  name = aws_elb.test.name
+}
+`, rName, protocol, healthCheckTarget))
+}
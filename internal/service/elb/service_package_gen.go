@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceHostedZoneID,
 			TypeName: "aws_elb_hosted_zone_id",
 		},
+		{
+			Factory:  DataSourceMigration,
+			TypeName: "aws_elb_migration",
+		},
 		{
 			Factory:  DataSourceServiceAccount,
 			TypeName: "aws_elb_service_account",
@@ -121,6 +121,7 @@ const (
 	targetGroupAttributePreserveClientIPEnabled                                = "preserve_client_ip.enabled"
 	targetGroupAttributeProxyProtocolV2Enabled                                 = "proxy_protocol_v2.enabled"
 	targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled = "target_health_state.unhealthy.connection_termination.enabled"
+	targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds      = "target_health_state.unhealthy.draining_interval_seconds"
 
 	// The following attributes are supported only by Gateway Load Balancers:
 	targetGroupAttributeTargetFailoverOnDeregistration = "target_failover.on_deregistration"
@@ -323,6 +323,12 @@ func ResourceTargetGroup() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+						"unhealthy_draining_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 360000),
+						},
 					},
 				},
 			},
@@ -1012,6 +1018,23 @@ func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDi
 		}
 	}
 
+	if v := diff.GetRawConfig().GetAttr("target_health_state"); v.IsKnown() && !v.IsNull() && v.LengthInt() == 1 {
+		targetHealthState := v.Index(cty.NumberIntVal(0))
+		targetHealthStatePath := cty.GetAttrPath("target_health_state").IndexInt(0)
+
+		if v := targetHealthState.GetAttr("enable_unhealthy_connection_termination"); v.IsKnown() && !v.IsNull() && v.True() {
+			if v := targetHealthState.GetAttr("unhealthy_draining_interval"); v.IsKnown() && !v.IsNull() {
+				if i, _ := v.AsBigFloat().Int64(); i > 0 {
+					return sdkdiag.DiagnosticError(errs.NewAttributeConflictsWhenError(
+						targetHealthStatePath.GetAttr("unhealthy_draining_interval"),
+						targetHealthStatePath.GetAttr("enable_unhealthy_connection_termination"),
+						"true",
+					))
+				}
+			}
+		}
+	}
+
 	protocol := diff.Get("protocol").(string)
 
 	switch protocol {
@@ -1258,6 +1281,17 @@ func expandTargetGroupTargetHealthStateAttributes(tfMap map[string]interface{},
 				Key:   aws.String(targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled),
 				Value: flex.BoolValueToString(tfMap["enable_unhealthy_connection_termination"].(bool)),
 			})
+
+		// The draining interval can only be set when unhealthy connection termination is disabled.
+		if !tfMap["enable_unhealthy_connection_termination"].(bool) {
+			if v, ok := tfMap["unhealthy_draining_interval"].(int); ok {
+				apiObjects = append(apiObjects,
+					&elbv2.TargetGroupAttribute{
+						Key:   aws.String(targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds),
+						Value: flex.IntValueToString(v),
+					})
+			}
+		}
 	}
 
 	return apiObjects
@@ -1276,6 +1310,8 @@ func flattenTargetGroupTargetHealthStateAttributes(apiObjects []*elbv2.TargetGro
 			switch k, v := aws.StringValue(apiObject.Key), apiObject.Value; k {
 			case targetGroupAttributeTargetHealthStateUnhealthyConnectionTerminationEnabled:
 				tfMap["enable_unhealthy_connection_termination"] = flex.StringToBoolValue(v)
+			case targetGroupAttributeTargetHealthStateUnhealthyDrainingIntervalSeconds:
+				tfMap["unhealthy_draining_interval"] = flex.StringToIntValue(v)
 			}
 		}
 	}
@@ -2595,6 +2595,54 @@ func TestAccELBV2TargetGroup_targetHealthStateUnhealthyConnectionTermination(t *
 	})
 }
 
+func TestAccELBV2TargetGroup_targetHealthStateUnhealthyDrainingInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var targetGroup elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetGroupConfig_targetHealthStateDrainingInterval(rName, "TCP", true, 300),
+				ExpectError: regexache.MustCompile(`Attribute "target_health_state\[0\].unhealthy_draining_interval" cannot be specified when "target_health_state\[0\].enable_unhealthy_connection_termination" is "true"`),
+			},
+			{
+				Config: testAccTargetGroupConfig_targetHealthStateDrainingInterval(rName, "TCP", false, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "300"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_targetHealthStateDrainingInterval(rName, "TCP", false, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.enable_unhealthy_connection_termination", "false"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "0"),
+				),
+			},
+			{
+				Config: testAccTargetGroupConfig_targetHealthStateDrainingInterval(rName, "TLS", false, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetGroupExists(ctx, resourceName, &targetGroup),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "TLS"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_health_state.0.unhealthy_draining_interval", "600"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_Instance_HealthCheck_defaults(t *testing.T) {
 	t.Parallel()
 
@@ -5017,6 +5065,30 @@ resource "aws_vpc" "test" {
 `, rName, protocol, enabled)
 }
 
+func testAccTargetGroupConfig_targetHealthStateDrainingInterval(rName, protocol string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 25
+  protocol = %[2]q
+  vpc_id   = aws_vpc.test.id
+
+  target_health_state {
+    enable_unhealthy_connection_termination = %[3]t
+    unhealthy_draining_interval             = %[4]d
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, protocol, enabled, interval)
+}
+
 func testAccTargetGroupConfig_typeTCP(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lb_target_group" "test" {
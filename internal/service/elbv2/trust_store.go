@@ -5,9 +5,14 @@ package elbv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -45,6 +50,7 @@ func ResourceTrustStore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			resourceTrustStoreCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -67,6 +73,10 @@ func ResourceTrustStore() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"ca_certificates_bundle_s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ca_certificates_bundle_s3_object_version": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -159,6 +169,10 @@ func resourceTrustStoreCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if err := setCACertificatesBundleETag(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store (%s) CA certificates bundle: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
 }
 
@@ -205,6 +219,10 @@ func resourceTrustStoreUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "modifying ELBv2 Trust Store (%s): %s", d.Id(), err)
 		}
+
+		if err := setCACertificatesBundleETag(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store (%s) CA certificates bundle: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceTrustStoreRead(ctx, d, meta)...)
@@ -232,6 +250,85 @@ func resourceTrustStoreDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceTrustStoreCustomizeDiff detects changes to the contents of the CA certificates bundle
+// made in place in S3, which the ELBv2 API does not report, by comparing the object's current ETag
+// with the one recorded when the trust store was last created or modified.
+func resourceTrustStoreCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	keys := []string{"ca_certificates_bundle_s3_bucket", "ca_certificates_bundle_s3_key", "ca_certificates_bundle_s3_object_version"}
+
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("ca_certificates_bundle_s3_object_etag")
+		}
+	}
+
+	if d.HasChanges(keys...) {
+		return d.SetNewComputed("ca_certificates_bundle_s3_object_etag")
+	}
+
+	etag, err := findCACertificatesBundleETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), d.Get("ca_certificates_bundle_s3_bucket").(string), d.Get("ca_certificates_bundle_s3_key").(string), d.Get("ca_certificates_bundle_s3_object_version").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading ELBv2 Trust Store (%s) CA certificates bundle: %w", d.Id(), err)
+	}
+
+	if etag != d.Get("ca_certificates_bundle_s3_object_etag").(string) {
+		return d.SetNew("ca_certificates_bundle_s3_object_etag", etag)
+	}
+
+	return nil
+}
+
+func setCACertificatesBundleETag(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	etag, err := findCACertificatesBundleETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), d.Get("ca_certificates_bundle_s3_bucket").(string), d.Get("ca_certificates_bundle_s3_key").(string), d.Get("ca_certificates_bundle_s3_object_version").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("ca_certificates_bundle_s3_object_etag", etag)
+
+	return nil
+}
+
+func findCACertificatesBundleETag(ctx context.Context, conn *s3.Client, bucket, key, version string) (string, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+
+	if version != "" {
+		input.VersionId = aws_sdkv2.String(version)
+	}
+
+	output, err := conn.HeadObject(ctx, input)
+
+	if errs.IsA[*s3types.NotFound](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return strings.Trim(aws_sdkv2.ToString(output.ETag), `"`), nil
+}
+
 func FindTrustStoreByARN(ctx context.Context, conn *elbv2.ELBV2, arn string) (*elbv2.TrustStore, error) {
 	input := &elbv2.DescribeTrustStoresInput{
 		TrustStoreArns: aws.StringSlice([]string{arn}),
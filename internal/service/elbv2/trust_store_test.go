@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -75,6 +76,50 @@ func TestAccELBV2TrustStore_disappears(t *testing.T) {
 	})
 }
 
+func TestAccELBV2TrustStore_caCertificatesBundleUpdatedInPlace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf elbv2.TrustStore
+	resourceName := "aws_lb_trust_store.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_caCertificatesBundleTrailer(rName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "ca_certificates_bundle_s3_object_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+			{
+				// The bundle is replaced in place after the plan is made, so the trust store change
+				// is only detected by the next plan.
+				Config: testAccTrustStoreConfig_caCertificatesBundleTrailer(rName, "# Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &conf),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTrustStoreConfig_caCertificatesBundleTrailer(rName, "# Updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "ca_certificates_bundle_s3_object_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TrustStore_nameGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf elbv2.TrustStore
@@ -227,6 +272,10 @@ func testAccCheckTrustStoreDestroy(ctx context.Context) resource.TestCheckFunc {
 }
 
 func testAccTrustStoreConfig_baseS3BucketCA(rName string) string {
+	return testAccTrustStoreConfig_baseS3BucketCAWithTrailer(rName, "")
+}
+
+func testAccTrustStoreConfig_baseS3BucketCAWithTrailer(rName, trailer string) string {
 	return acctest.ConfigCompose(fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket        = %[1]q
@@ -292,9 +341,10 @@ yVz4RzGvqBwlyJO7Do1QSvDkYd1yHKXYHN5kILthFtjC+bAFY/bDFrGTViU7lT9y
 hAqfbOov9uFU7QAFHx5yllOGtycJ1kE8zaI8S6XXj0909b7EiKP+IqFe35FrpiZY
 LDgwwPky7T6W4ohoGv+p497rbPtHsLq9
 -----END CERTIFICATE-----
+%[2]s
 EOT
 }
-`, rName))
+`, rName, trailer))
 }
 
 func testAccTrustStoreConfig_basic(rName string) string {
@@ -307,6 +357,16 @@ resource "aws_lb_trust_store" "test" {
 `, rName))
 }
 
+func testAccTrustStoreConfig_caCertificatesBundleTrailer(rName, trailer string) string {
+	return acctest.ConfigCompose(testAccTrustStoreConfig_baseS3BucketCAWithTrailer(rName, trailer), fmt.Sprintf(`
+resource "aws_lb_trust_store" "test" {
+  name                             = %[1]q
+  ca_certificates_bundle_s3_bucket = aws_s3_bucket.test.bucket
+  ca_certificates_bundle_s3_key    = aws_s3_object.test.key
+}
+`, rName))
+}
+
 func testAccTrustStoreConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccTrustStoreConfig_baseS3BucketCA(rName), `
 resource "aws_lb_trust_store" "test" {
@@ -179,6 +179,96 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"monitoring_configuration": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				MaxItems:         1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"log_group_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"log_stream_name_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"log_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"managed_persistence_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"log_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -262,6 +352,10 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -323,6 +417,10 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting maximum_capacity: %s", err)
 	}
 
+	if err := d.Set("monitoring_configuration", flattenMonitoringConfiguration(application.MonitoringConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting monitoring_configuration: %s", err)
+	}
+
 	if err := d.Set("network_configuration", []interface{}{flattenNetworkConfiguration(application.NetworkConfiguration)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
@@ -366,6 +464,10 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		if v, ok := d.GetOk("monitoring_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MonitoringConfiguration = expandMonitoringConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 		}
@@ -797,3 +899,202 @@ func flattenWorkerResourceConfig(apiObject *types.WorkerResourceConfig) map[stri
 
 	return tfMap
 }
+
+func expandMonitoringConfiguration(tfMap map[string]interface{}) *types.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logging_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLoggingConfiguration = expandCloudWatchLoggingConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_persistence_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ManagedPersistenceMonitoringConfiguration = expandManagedPersistenceMonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3MonitoringConfiguration = expandS3MonitoringConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *types.MonitoringConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLoggingConfiguration; v != nil {
+		tfMap["cloudwatch_logging_configuration"] = []interface{}{flattenCloudWatchLoggingConfiguration(v)}
+	}
+
+	if v := apiObject.ManagedPersistenceMonitoringConfiguration; v != nil {
+		tfMap["managed_persistence_monitoring_configuration"] = []interface{}{flattenManagedPersistenceMonitoringConfiguration(v)}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []interface{}{flattenS3MonitoringConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandCloudWatchLoggingConfiguration(tfMap map[string]interface{}) *types.CloudWatchLoggingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CloudWatchLoggingConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+		apiObject.LogStreamNamePrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_types"].(*schema.Set); ok && v.Len() > 0 {
+		logTypes := make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if name, ok := tfMap["name"].(string); ok && name != "" {
+				logTypes[name] = flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))
+			}
+		}
+
+		apiObject.LogTypes = logTypes
+	}
+
+	return apiObject
+}
+
+func flattenCloudWatchLoggingConfiguration(apiObject *types.CloudWatchLoggingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogGroupName; v != nil {
+		tfMap["log_group_name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogStreamNamePrefix; v != nil {
+		tfMap["log_stream_name_prefix"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogTypes; v != nil {
+		var tfList []interface{}
+
+		for name, values := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"name":   name,
+				"values": flex.FlattenStringValueSet(values),
+			})
+		}
+
+		tfMap["log_types"] = tfList
+	}
+
+	return tfMap
+}
+
+func expandManagedPersistenceMonitoringConfiguration(tfMap map[string]interface{}) *types.ManagedPersistenceMonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ManagedPersistenceMonitoringConfiguration{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenManagedPersistenceMonitoringConfiguration(apiObject *types.ManagedPersistenceMonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandS3MonitoringConfiguration(tfMap map[string]interface{}) *types.S3MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3MonitoringConfiguration{}
+
+	if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+		apiObject.EncryptionKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+		apiObject.LogUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3MonitoringConfiguration(apiObject *types.S3MonitoringConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EncryptionKeyArn; v != nil {
+		tfMap["encryption_key_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.LogUri; v != nil {
+		tfMap["log_uri"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
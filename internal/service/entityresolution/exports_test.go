@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceIDMappingWorkflow = newIDMappingWorkflowResource
+	ResourceMatchingWorkflow  = newMatchingWorkflowResource
+	ResourceSchemaMapping     = newSchemaMappingResource
+
+	FindIDMappingWorkflowByName = findIDMappingWorkflowByName
+	FindMatchingWorkflowByName  = findMatchingWorkflowByName
+	FindSchemaMappingByName     = findSchemaMappingByName
+)
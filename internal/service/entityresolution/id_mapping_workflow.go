@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="ID Mapping Workflow")
+// @Tags(identifierAttribute="arn")
+func newIDMappingWorkflowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &idMappingWorkflowResource{}, nil
+}
+
+type idMappingWorkflowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*idMappingWorkflowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_entityresolution_id_mapping_workflow"
+}
+
+func (r *idMappingWorkflowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"id_mapping_techniques": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idMappingTechniquesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id_mapping_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.IdMappingType](),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"provider_properties": providerPropertiesBlock(ctx),
+					},
+				},
+			},
+			"input_source_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idMappingWorkflowInputSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_source_arn": schema.StringAttribute{
+							Required: true,
+						},
+						"schema_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idMappingWorkflowOutputSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_arn": schema.StringAttribute{
+							Optional: true,
+						},
+						"output_s3_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *idMappingWorkflowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data idMappingWorkflowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	input := &entityresolution.CreateIdMappingWorkflowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIdMappingWorkflow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Entity Resolution ID Mapping Workflow (%s)", data.WorkflowName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.WorkflowARN = fwflex.StringToFramework(ctx, output.WorkflowArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *idMappingWorkflowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data idMappingWorkflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	output, err := findIDMappingWorkflowByName(ctx, conn, data.WorkflowName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution ID Mapping Workflow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *idMappingWorkflowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new idMappingWorkflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.IDMappingTechniques.Equal(old.IDMappingTechniques) ||
+		!new.InputSourceConfig.Equal(old.InputSourceConfig) ||
+		!new.OutputSourceConfig.Equal(old.OutputSourceConfig) ||
+		!new.RoleARN.Equal(old.RoleARN) {
+		input := &entityresolution.UpdateIdMappingWorkflowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIdMappingWorkflow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Entity Resolution ID Mapping Workflow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *idMappingWorkflowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data idMappingWorkflowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	_, err := conn.DeleteIdMappingWorkflow(ctx, &entityresolution.DeleteIdMappingWorkflowInput{
+		WorkflowName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Entity Resolution ID Mapping Workflow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *idMappingWorkflowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIDMappingWorkflowByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetIdMappingWorkflowOutput, error) {
+	input := &entityresolution.GetIdMappingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetIdMappingWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type idMappingWorkflowResourceModel struct {
+	Description         types.String                                                        `tfsdk:"description"`
+	ID                  types.String                                                        `tfsdk:"id"`
+	IDMappingTechniques fwtypes.ListNestedObjectValueOf[idMappingTechniquesModel]           `tfsdk:"id_mapping_techniques"`
+	InputSourceConfig   fwtypes.ListNestedObjectValueOf[idMappingWorkflowInputSourceModel]  `tfsdk:"input_source_config"`
+	OutputSourceConfig  fwtypes.ListNestedObjectValueOf[idMappingWorkflowOutputSourceModel] `tfsdk:"output_source_config"`
+	RoleARN             types.String                                                        `tfsdk:"role_arn"`
+	Tags                types.Map                                                           `tfsdk:"tags"`
+	TagsAll             types.Map                                                           `tfsdk:"tags_all"`
+	WorkflowARN         types.String                                                        `tfsdk:"arn"`
+	WorkflowName        types.String                                                        `tfsdk:"name"`
+}
+
+func (data *idMappingWorkflowResourceModel) InitFromID() error {
+	data.WorkflowName = data.ID
+
+	return nil
+}
+
+func (data *idMappingWorkflowResourceModel) setID() {
+	data.ID = data.WorkflowName
+}
+
+type idMappingTechniquesModel struct {
+	IDMappingType      types.String                                             `tfsdk:"id_mapping_type"`
+	ProviderProperties fwtypes.ListNestedObjectValueOf[providerPropertiesModel] `tfsdk:"provider_properties"`
+}
+
+type idMappingWorkflowInputSourceModel struct {
+	InputSourceARN types.String `tfsdk:"input_source_arn"`
+	SchemaName     types.String `tfsdk:"schema_name"`
+}
+
+type idMappingWorkflowOutputSourceModel struct {
+	KMSArn       types.String `tfsdk:"kms_arn"`
+	OutputS3Path types.String `tfsdk:"output_s3_path"`
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ID mapping workflows require an AWS Data Exchange subscription to a provider service
+// such as LiveRamp. Set ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN to the subscribed provider service ARN.
+
+func TestAccEntityResolutionIDMappingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, "ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexache.MustCompile(`idmappingworkflow/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.id_mapping_type", "PROVIDER"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.0.intermediate_source_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_techniques.0.provider_properties.0.provider_service_arn", providerServiceARN),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDMappingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	providerServiceARN := acctest.SkipIfEnvVarNotSet(t, "ENTITY_RESOLUTION_PROVIDER_SERVICE_ARN")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_mapping_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingWorkflowExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceIDMappingWorkflow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_id_mapping_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution ID Mapping Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIDMappingWorkflowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindIDMappingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIDMappingWorkflowConfig_basic(rName, providerServiceARN string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_mapping_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  id_mapping_techniques {
+    id_mapping_type = "PROVIDER"
+
+    provider_properties {
+      provider_service_arn = %[2]q
+
+      intermediate_source_configuration {
+        intermediate_s3_path = "s3://${aws_s3_bucket.test.bucket}/intermediate/"
+      }
+    }
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, providerServiceARN))
+}
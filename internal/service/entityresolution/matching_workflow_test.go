@@ -0,0 +1,498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionMatchingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexache.MustCompile(`matchingworkflow/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", "RULE_MATCHING"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "ONE_TO_ONE"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.0.matching_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.0.matching_keys.0", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.0.rule_name", "email"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceMatchingWorkflow, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_updated(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.0.incremental_run_type", "IMMEDIATE"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.apply_normalization", "true"),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.1.hashed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "MANY_TO_MANY"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMatchingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_matching_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Matching Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMatchingWorkflowExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWorkflowConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/input/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.OpenCSVSerde"
+    }
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "entityresolution.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetDatabase",
+        "glue:GetTable",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:GetSchema",
+        "glue:GetSchemaVersion",
+        "glue:BatchGetPartition",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+      }, {
+      Action = [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:ListBucket",
+        "s3:GetBucketLocation",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "EMAIL"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName)
+}
+
+func testAccMatchingWorkflowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["EMAIL"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_updated(rName, description string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  incremental_run_config {
+    incremental_run_type = "IMMEDIATE"
+  }
+
+  input_source_config {
+    input_source_arn    = aws_glue_catalog_table.test.arn
+    schema_name         = aws_entityresolution_schema_mapping.test.name
+    apply_normalization = true
+  }
+
+  output_source_config {
+    output_s3_path      = "s3://${aws_s3_bucket.test.bucket}/output/"
+    apply_normalization = true
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name   = "email"
+      hashed = true
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "MANY_TO_MANY"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["EMAIL"]
+      }
+
+      rule {
+        rule_name     = "email-again"
+        matching_keys = ["EMAIL"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
+
+func testAccMatchingWorkflowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["EMAIL"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMatchingWorkflowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "email"
+        matching_keys = ["EMAIL"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Schema Mapping")
+// @Tags(identifierAttribute="arn")
+func newSchemaMappingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &schemaMappingResource{}, nil
+}
+
+type schemaMappingResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*schemaMappingResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_entityresolution_schema_mapping"
+}
+
+func (r *schemaMappingResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"has_workflows": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"mapped_input_field": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schemaInputAttributeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 25),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_name": schema.StringAttribute{
+							Required: true,
+						},
+						"group_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"match_key": schema.StringAttribute{
+							Optional: true,
+						},
+						"sub_type": schema.StringAttribute{
+							Optional: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.SchemaAttributeType](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *schemaMappingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data schemaMappingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	input := &entityresolution.CreateSchemaMappingInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateSchemaMapping(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Entity Resolution Schema Mapping (%s)", data.SchemaName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.SchemaARN = fwflex.StringToFramework(ctx, output.SchemaArn)
+	data.HasWorkflows = types.BoolValue(false)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *schemaMappingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data schemaMappingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	output, err := findSchemaMappingByName(ctx, conn, data.SchemaName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Entity Resolution Schema Mapping (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *schemaMappingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new schemaMappingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.MappedInputFields.Equal(old.MappedInputFields) {
+		input := &entityresolution.UpdateSchemaMappingInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateSchemaMapping(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Entity Resolution Schema Mapping (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *schemaMappingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data schemaMappingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EntityResolutionClient(ctx)
+
+	_, err := conn.DeleteSchemaMapping(ctx, &entityresolution.DeleteSchemaMappingInput{
+		SchemaName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Entity Resolution Schema Mapping (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *schemaMappingResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSchemaMappingByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetSchemaMappingOutput, error) {
+	input := &entityresolution.GetSchemaMappingInput{
+		SchemaName: aws.String(name),
+	}
+
+	output, err := conn.GetSchemaMapping(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type schemaMappingResourceModel struct {
+	Description       types.String                                               `tfsdk:"description"`
+	HasWorkflows      types.Bool                                                 `tfsdk:"has_workflows"`
+	ID                types.String                                               `tfsdk:"id"`
+	MappedInputFields fwtypes.ListNestedObjectValueOf[schemaInputAttributeModel] `tfsdk:"mapped_input_field"`
+	SchemaARN         types.String                                               `tfsdk:"arn"`
+	SchemaName        types.String                                               `tfsdk:"name"`
+	Tags              types.Map                                                  `tfsdk:"tags"`
+	TagsAll           types.Map                                                  `tfsdk:"tags_all"`
+}
+
+func (data *schemaMappingResourceModel) InitFromID() error {
+	data.SchemaName = data.ID
+
+	return nil
+}
+
+func (data *schemaMappingResourceModel) setID() {
+	data.ID = data.SchemaName
+}
+
+type schemaInputAttributeModel struct {
+	FieldName types.String `tfsdk:"field_name"`
+	GroupName types.String `tfsdk:"group_name"`
+	MatchKey  types.String `tfsdk:"match_key"`
+	SubType   types.String `tfsdk:"sub_type"`
+	Type      types.String `tfsdk:"type"`
+}
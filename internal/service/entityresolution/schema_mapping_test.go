@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionSchemaMapping_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "entityresolution", regexache.MustCompile(`schemamapping/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "has_workflows", "false"),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.field_name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.type", "UNIQUE_ID"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.field_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.match_key", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.type", "EMAIL_ADDRESS"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceSchemaMapping, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.#", "2"),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_updated(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.field_name", "first_name"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.group_name", "full_name"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.match_key", "NAME"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.2.type", "NAME_FIRST"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_updated(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSchemaMappingConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	input := &entityresolution.ListSchemaMappingsInput{}
+
+	_, err := conn.ListSchemaMappings(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckSchemaMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_schema_mapping" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Schema Mapping %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaMappingExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSchemaMappingConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "EMAIL"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName)
+}
+
+func testAccSchemaMappingConfig_updated(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "EMAIL"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  mapped_input_field {
+    field_name = "first_name"
+    group_name = "full_name"
+    match_key  = "NAME"
+    type       = "NAME_FIRST"
+  }
+
+  mapped_input_field {
+    field_name = "last_name"
+    group_name = "full_name"
+    match_key  = "NAME"
+    type       = "NAME_LAST"
+  }
+}
+`, rName, description)
+}
+
+func testAccSchemaMappingConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "EMAIL"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSchemaMappingConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name = %[1]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "EMAIL"
+    type       = "EMAIL_ADDRESS"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
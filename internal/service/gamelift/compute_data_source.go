@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_gamelift_compute")
+func DataSourceCompute() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComputeRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compute_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"game_lift_service_sdk_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleetID := d.Get("fleet_id").(string)
+	computeName := d.Get("compute_name").(string)
+	id, err := flex.FlattenResourceId([]string{fleetID, computeName}, 2, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	compute, err := findComputeByTwoPartKey(ctx, conn, fleetID, computeName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Compute (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("arn", compute.ComputeArn)
+	d.Set("compute_name", compute.ComputeName)
+	d.Set("compute_status", compute.ComputeStatus)
+	if compute.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(compute.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+	d.Set("dns_name", compute.DnsName)
+	d.Set("fleet_arn", compute.FleetArn)
+	d.Set("fleet_id", compute.FleetId)
+	d.Set("game_lift_service_sdk_endpoint", compute.GameLiftServiceSdkEndpoint)
+	d.Set("ip_address", compute.IpAddress)
+	d.Set("location", compute.Location)
+	d.Set("operating_system", compute.OperatingSystem)
+	d.Set("type", compute.Type)
+
+	return diags
+}
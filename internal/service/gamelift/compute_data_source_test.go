@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Computes are registered out of band by the GameLift Agent or the RegisterCompute API.
+const (
+	envVarComputeName = "GAMELIFT_COMPUTE_NAME"
+	envVarFleetID     = "GAMELIFT_ANYWHERE_FLEET_ID"
+)
+
+func TestAccGameLiftComputeDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	fleetID := acctest.SkipIfEnvVarNotSet(t, envVarFleetID)
+	computeName := acctest.SkipIfEnvVarNotSet(t, envVarComputeName)
+	dataSourceName := "data.aws_gamelift_compute.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDataSourceConfig_basic(fleetID, computeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_name", computeName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "fleet_id", fleetID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftComputeAuthTokenDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	fleetID := acctest.SkipIfEnvVarNotSet(t, envVarFleetID)
+	computeName := acctest.SkipIfEnvVarNotSet(t, envVarComputeName)
+	dataSourceName := "data.aws_gamelift_compute_auth_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAuthTokenDataSourceConfig_basic(fleetID, computeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "auth_token"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_name", computeName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration_timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "fleet_id", fleetID),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeDataSourceConfig_basic(fleetID, computeName string) string {
+	return fmt.Sprintf(`
+data "aws_gamelift_compute" "test" {
+  fleet_id     = %[1]q
+  compute_name = %[2]q
+}
+`, fleetID, computeName)
+}
+
+func testAccComputeAuthTokenDataSourceConfig_basic(fleetID, computeName string) string {
+	return fmt.Sprintf(`
+data "aws_gamelift_compute_auth_token" "test" {
+  fleet_id     = %[1]q
+  compute_name = %[2]q
+}
+`, fleetID, computeName)
+}
@@ -122,3 +122,124 @@ func FindScriptByID(ctx context.Context, conn *gamelift.GameLift, id string) (*g
 
 	return output.Script, nil
 }
+
+func findFleetLocationAttributesByID(ctx context.Context, conn *gamelift.GameLift, id string) ([]*gamelift.LocationAttributes, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(id),
+	}
+	var output []*gamelift.LocationAttributes
+
+	err := conn.DescribeFleetLocationAttributesPagesWithContext(ctx, input, func(page *gamelift.DescribeFleetLocationAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindFleetCapacityByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, location string) (*gamelift.FleetCapacity, error) {
+	input := &gamelift.DescribeFleetLocationCapacityInput{
+		FleetId:  aws.String(fleetID),
+		Location: aws.String(location),
+	}
+
+	output, err := conn.DescribeFleetLocationCapacityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FleetCapacity == nil || output.FleetCapacity.InstanceCounts == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FleetCapacity, nil
+}
+
+func FindLocationByName(ctx context.Context, conn *gamelift.GameLift, name string) (*gamelift.LocationModel, error) {
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	var output *gamelift.LocationModel
+
+	err := conn.ListLocationsPagesWithContext(ctx, input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			if v != nil && aws.StringValue(v.LocationName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findComputeByTwoPartKey(ctx context.Context, conn *gamelift.GameLift, fleetID, computeName string) (*gamelift.Compute, error) {
+	input := &gamelift.DescribeComputeInput{
+		ComputeName: aws.String(computeName),
+		FleetId:     aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeComputeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Compute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Compute, nil
+}
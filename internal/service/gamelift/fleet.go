@@ -5,15 +5,18 @@ package gamelift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,6 +52,23 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"anywhere_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 11),
+								validation.StringMatch(regexache.MustCompile(`^\d{1,5}(?:\.\d{1,5})?$`), "must be a decimal number"),
+							),
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,10 +78,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"build_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"script_id"},
 			},
 			"certificate_configuration": {
 				Type:     schema.TypeList,
@@ -80,6 +100,13 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ComputeType_Values(), false),
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,7 +144,7 @@ func ResourceFleet() *schema.Resource {
 			},
 			"ec2_instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
 			},
@@ -134,6 +161,14 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -230,16 +265,19 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"script_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"build_id"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFleetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -248,9 +286,12 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
 
 	input := &gamelift.CreateFleetInput{
-		EC2InstanceType: aws.String(d.Get("ec2_instance_type").(string)),
-		Name:            aws.String(d.Get("name").(string)),
-		Tags:            getTagsIn(ctx),
+		Name: aws.String(d.Get("name").(string)),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anywhere_configuration"); ok {
+		input.AnywhereConfiguration = expandAnywhereConfiguration(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("build_id"); ok {
@@ -261,9 +302,16 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ScriptId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("compute_type"); ok {
+		input.ComputeType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("ec2_instance_type"); ok {
+		input.EC2InstanceType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("fleet_type"); ok {
 		input.FleetType = aws.String(v.(string))
 	}
@@ -275,6 +323,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(flex.ExpandStringValueSet(v.(*schema.Set)))
+	}
+
 	if v, ok := d.GetOk("metric_groups"); ok {
 		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
 	}
@@ -343,8 +395,12 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.StringValue(fleet.FleetArn)
+	if err := d.Set("anywhere_configuration", flattenAnywhereConfiguration(fleet.AnywhereConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting anywhere_configuration: %s", err)
+	}
 	d.Set("build_arn", fleet.BuildArn)
 	d.Set("build_id", fleet.BuildId)
+	d.Set("compute_type", fleet.ComputeType)
 	d.Set("description", fleet.Description)
 	d.Set("arn", arn)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
@@ -366,6 +422,24 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
 	}
 
+	locations, err := findFleetLocationAttributesByID(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Fleet (%s) locations: %s", d.Id(), err)
+	}
+
+	// The fleet's home Region is always returned and is not a configurable location.
+	var remoteLocations []string
+	for _, v := range locations {
+		if v.LocationState == nil {
+			continue
+		}
+
+		if location := aws.StringValue(v.LocationState.Location); location != meta.(*conns.AWSClient).Region {
+			remoteLocations = append(remoteLocations, location)
+		}
+	}
+	d.Set("locations", remoteLocations)
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -388,8 +462,9 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Updating GameLift Fleet: %s", d.Id())
 
-	if d.HasChanges("description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
+	if d.HasChanges("anywhere_configuration", "description", "metric_groups", "name", "new_game_session_protection_policy", "resource_creation_limit_policy") {
 		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+			AnywhereConfiguration:          expandAnywhereConfiguration(d.Get("anywhere_configuration").([]interface{})),
 			Description:                    aws.String(d.Get("description").(string)),
 			FleetId:                        aws.String(d.Id()),
 			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
@@ -416,6 +491,31 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.HasChange("locations") {
+		o, n := d.GetChange("locations")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			_, err := conn.DeleteFleetLocationsWithContext(ctx, &gamelift.DeleteFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: aws.StringSlice(del),
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting GameLift Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			_, err := conn.CreateFleetLocationsWithContext(ctx, &gamelift.CreateFleetLocationsInput{
+				FleetId:   aws.String(d.Id()),
+				Locations: expandLocationConfigurations(add),
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating GameLift Fleet (%s) locations: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("runtime_configuration") {
 		_, err := conn.UpdateRuntimeConfigurationWithContext(ctx, &gamelift.UpdateRuntimeConfigurationInput{
 			FleetId:              aws.String(d.Id()),
@@ -467,6 +567,64 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// resourceFleetCustomizeDiff requires a build or script and an instance type for fleets with the (default) EC2 compute type.
+func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+
+	if v := config.GetAttr("compute_type"); !v.IsKnown() || (!v.IsNull() && v.AsString() != gamelift.ComputeTypeEc2) {
+		return nil
+	}
+
+	if config.GetAttr("build_id").IsNull() && config.GetAttr("script_id").IsNull() {
+		return errors.New(`one of "build_id" or "script_id" must be specified for an EC2 fleet`)
+	}
+
+	if config.GetAttr("ec2_instance_type").IsNull() {
+		return errors.New(`"ec2_instance_type" must be specified for an EC2 fleet`)
+	}
+
+	return nil
+}
+
+func expandAnywhereConfiguration(tfList []interface{}) *gamelift.AnywhereConfiguration {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &gamelift.AnywhereConfiguration{
+		Cost: aws.String(tfMap["cost"].(string)),
+	}
+}
+
+func flattenAnywhereConfiguration(apiObject *gamelift.AnywhereConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"cost": aws.StringValue(apiObject.Cost),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandLocationConfigurations(locations []string) []*gamelift.LocationConfiguration {
+	apiObjects := make([]*gamelift.LocationConfiguration, 0, len(locations))
+
+	for _, location := range locations {
+		apiObjects = append(apiObjects, &gamelift.LocationConfiguration{
+			Location: aws.String(location),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandIPPermissions(cfgs *schema.Set) []*gamelift.IpPermission {
 	if cfgs.Len() < 1 {
 		return []*gamelift.IpPermission{}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	fleetCapacityResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_gamelift_fleet_capacity", name="Fleet Capacity")
+func ResourceFleetCapacity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCapacityPut,
+		ReadWithoutTimeout:   resourceFleetCapacityRead,
+		UpdateWithoutTimeout: resourceFleetCapacityPut,
+		DeleteWithoutTimeout: resourceFleetCapacityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"desired_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceFleetCapacityPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	fleetID := d.Get("fleet_id").(string)
+	location := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+	id, err := flex.FlattenResourceId([]string{fleetID, location}, fleetCapacityResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &gamelift.UpdateFleetCapacityInput{
+		FleetId:  aws.String(fleetID),
+		Location: aws.String(location),
+	}
+
+	if v, ok := d.GetOk("desired_instances"); ok {
+		input.DesiredInstances = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_size"); ok {
+		input.MaxSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("min_size"); ok {
+		input.MinSize = aws.Int64(int64(v.(int)))
+	}
+
+	if _, err := conn.UpdateFleetCapacityWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting GameLift Fleet Capacity (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceFleetCapacityRead(ctx, d, meta)...)
+}
+
+func resourceFleetCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), fleetCapacityResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	fleetID, location := parts[0], parts[1]
+	capacity, err := FindFleetCapacityByTwoPartKey(ctx, conn, fleetID, location)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Fleet Capacity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Fleet Capacity (%s): %s", d.Id(), err)
+	}
+
+	d.Set("desired_instances", capacity.InstanceCounts.DESIRED)
+	d.Set("fleet_id", fleetID)
+	d.Set("location", location)
+	d.Set("max_size", capacity.InstanceCounts.MAXIMUM)
+	d.Set("min_size", capacity.InstanceCounts.MINIMUM)
+
+	return diags
+}
+
+func resourceFleetCapacityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] GameLift Fleet Capacity (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
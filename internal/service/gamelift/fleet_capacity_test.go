@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGameLiftFleetCapacity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf gamelift.FleetCapacity
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	region := acctest.Region()
+	g, err := testAccSampleGame(region)
+
+	if tfresource.NotFound(err) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := g.Location
+	bucketName := *loc.Bucket
+	roleArn := *loc.RoleArn
+	key := *loc.Key
+
+	launchPath := g.LaunchPath
+	params := g.Parameters(33435)
+	resourceName := "aws_gamelift_fleet_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn, 1, 0, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetCapacityExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "desired_instances", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_gamelift_fleet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "location", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn, 2, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetCapacityExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "desired_instances", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetCapacityExists(ctx context.Context, n string, v *gamelift.FleetCapacity) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn(ctx)
+
+		output, err := tfgamelift.FindFleetCapacityByTwoPartKey(ctx, conn, rs.Primary.Attributes["fleet_id"], rs.Primary.Attributes["location"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFleetCapacityConfig_basic(rName, launchPath, params, bucketName, key, roleArn string, desired, minSize, maxSize int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_basic(rName, launchPath, params, bucketName, key, roleArn), fmt.Sprintf(`
+resource "aws_gamelift_fleet_capacity" "test" {
+  fleet_id          = aws_gamelift_fleet.test.id
+  desired_instances = %[1]d
+  min_size          = %[2]d
+  max_size          = %[3]d
+}
+`, desired, minSize, maxSize))
+}
@@ -585,6 +585,124 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftFleet_anywhere(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.FleetAttributes
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_anywhere(rName, "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "build_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", "ANYWHERE"),
+					resource.TestCheckResourceAttr(resourceName, "ec2_instance_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_anywhere(rName, "1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "1.25"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftFleet_anywhereLocations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.FleetAttributes
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_anywhereLocations(rName, "aws_gamelift_location.test1.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test1", "name"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_anywhereLocations(rName, "aws_gamelift_location.test1.name, aws_gamelift_location.test2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test1", "name"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test2", "name"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_anywhereLocations(rName, "aws_gamelift_location.test2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test2", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftFleet_ec2Required(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_ec2RequiredNoScript(rName),
+				ExpectError: regexache.MustCompile(`one of "build_id" or "script_id" must be specified for an EC2 fleet`),
+			},
+			{
+				Config:      testAccFleetConfig_ec2RequiredNoInstanceType(rName),
+				ExpectError: regexache.MustCompile(`"ec2_instance_type" must be specified for an EC2 fleet`),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1002,3 +1120,78 @@ resource "aws_gamelift_fleet" "test" {
 }
 `, rName)
 }
+
+func testAccFleetConfig_ec2RequiredNoScript(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_fleet" "test" {
+  name              = %[1]q
+  compute_type      = "EC2"
+  ec2_instance_type = "t2.micro"
+
+  runtime_configuration {
+    server_process {
+      concurrent_executions = 1
+      launch_path           = "/local/game/lol"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFleetConfig_ec2RequiredNoInstanceType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_script" "test" {
+  name     = %[1]q
+  zip_file = "test-fixtures/script.zip"
+}
+
+resource "aws_gamelift_fleet" "test" {
+  name         = %[1]q
+  compute_type = "EC2"
+  script_id    = aws_gamelift_script.test.id
+
+  runtime_configuration {
+    server_process {
+      concurrent_executions = 1
+      launch_path           = "/local/game/lol"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFleetConfig_anywhere(rName, cost string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_location" "test" {
+  name = "custom-%[1]s"
+}
+
+resource "aws_gamelift_fleet" "test" {
+  name         = %[1]q
+  compute_type = "ANYWHERE"
+  locations    = [aws_gamelift_location.test.name]
+
+  anywhere_configuration {
+    cost = %[2]q
+  }
+}
+`, rName, cost)
+}
+
+func testAccFleetConfig_anywhereLocations(rName, locations string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_location" "test1" {
+  name = "custom-%[1]s-1"
+}
+
+resource "aws_gamelift_location" "test2" {
+  name = "custom-%[1]s-2"
+}
+
+resource "aws_gamelift_fleet" "test" {
+  name         = %[1]q
+  compute_type = "ANYWHERE"
+  locations    = [%[2]s]
+}
+`, rName, locations)
+}
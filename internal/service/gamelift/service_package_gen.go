@@ -24,7 +24,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCompute,
+			TypeName: "aws_gamelift_compute",
+		},
+		{
+			Factory:  DataSourceComputeAuthToken,
+			TypeName: "aws_gamelift_compute_auth_token",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -53,6 +62,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceFleetCapacity,
+			TypeName: "aws_gamelift_fleet_capacity",
+			Name:     "Fleet Capacity",
+		},
 		{
 			Factory:  ResourceGameServerGroup,
 			TypeName: "aws_gamelift_game_server_group",
@@ -69,6 +83,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceLocation,
+			TypeName: "aws_gamelift_location",
+			Name:     "Location",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceScript,
 			TypeName: "aws_gamelift_script",
@@ -52,6 +52,14 @@ func RegisterSweepers() {
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
+
+	resource.AddTestSweepers("aws_gamelift_location", &resource.Sweeper{
+		Name: "aws_gamelift_location",
+		Dependencies: []string{
+			"aws_gamelift_fleet",
+		},
+		F: sweepLocations,
+	})
 }
 
 func sweepAliases(region string) error {
@@ -319,6 +327,52 @@ func sweepGameSessionQueue(region string) error {
 	return nil
 }
 
+func sweepLocations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.GameLiftConn(ctx)
+	input := &gamelift.ListLocationsInput{
+		Filters: aws.StringSlice([]string{gamelift.LocationFilterCustom}),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListLocationsPagesWithContext(ctx, input, func(page *gamelift.ListLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Locations {
+			r := ResourceLocation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LocationName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping GameLift Location sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing GameLift Locations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Locations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func listAliases(ctx context.Context, input *gamelift.ListAliasesInput, conn *gamelift.GameLift, f func(*gamelift.ListAliasesOutput) error) error {
 	resp, err := conn.ListAliasesWithContext(ctx, input)
 	if err != nil {
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Cross-account Attachment")
+// @Tags(identifierAttribute="id")
+func newCrossAccountAttachmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &crossAccountAttachmentResource{}, nil
+}
+
+type crossAccountAttachmentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*crossAccountAttachmentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_globalaccelerator_cross_account_attachment"
+}
+
+func (r *crossAccountAttachmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"created_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_modified_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"principals": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"resource": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[attachmentResourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_id": schema.StringAttribute{
+							Required: true,
+						},
+						"region": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *crossAccountAttachmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data crossAccountAttachmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorConn(ctx)
+
+	input := &globalaccelerator.CreateCrossAccountAttachmentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.IdempotencyToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCrossAccountAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Global Accelerator Cross-account Attachment (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	attachment := output.CrossAccountAttachment
+	data.AttachmentARN = fwflex.StringToFramework(ctx, attachment.AttachmentArn)
+	data.CreatedTime = timetypes.NewRFC3339TimePointerValue(attachment.CreatedTime)
+	data.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(attachment.LastModifiedTime)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *crossAccountAttachmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data crossAccountAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorConn(ctx)
+
+	output, err := findCrossAccountAttachmentByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *crossAccountAttachmentResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new crossAccountAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorConn(ctx)
+
+	if !new.Name.Equal(old.Name) ||
+		!new.Principals.Equal(old.Principals) ||
+		!new.Resources.Equal(old.Resources) {
+		input := &globalaccelerator.UpdateCrossAccountAttachmentInput{
+			AttachmentArn: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.Name.Equal(old.Name) {
+			input.Name = fwflex.StringFromFramework(ctx, new.Name)
+		}
+
+		if !new.Principals.Equal(old.Principals) {
+			oldPrincipals, newPrincipals := fwflex.ExpandFrameworkStringValueSet(ctx, old.Principals), fwflex.ExpandFrameworkStringValueSet(ctx, new.Principals)
+			input.AddPrincipals = aws.StringSlice(newPrincipals.Difference(oldPrincipals))
+			input.RemovePrincipals = aws.StringSlice(oldPrincipals.Difference(newPrincipals))
+		}
+
+		if !new.Resources.Equal(old.Resources) {
+			var oldResources, newResources []*globalaccelerator.Resource
+			response.Diagnostics.Append(fwflex.Expand(ctx, old.Resources, &oldResources)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.Resources, &newResources)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.AddResources = attachmentResourcesDifference(newResources, oldResources)
+			input.RemoveResources = attachmentResourcesDifference(oldResources, newResources)
+		}
+
+		output, err := conn.UpdateCrossAccountAttachmentWithContext(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Global Accelerator Cross-account Attachment (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(output.CrossAccountAttachment.LastModifiedTime)
+	} else {
+		new.LastModifiedTime = old.LastModifiedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *crossAccountAttachmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data crossAccountAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GlobalAcceleratorConn(ctx)
+
+	_, err := conn.DeleteCrossAccountAttachmentWithContext(ctx, &globalaccelerator.DeleteCrossAccountAttachmentInput{
+		AttachmentArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAttachmentNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Global Accelerator Cross-account Attachment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *crossAccountAttachmentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCrossAccountAttachmentByARN(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, arn string) (*globalaccelerator.Attachment, error) {
+	input := &globalaccelerator.DescribeCrossAccountAttachmentInput{
+		AttachmentArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCrossAccountAttachmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAttachmentNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CrossAccountAttachment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CrossAccountAttachment, nil
+}
+
+// attachmentResourcesDifference returns the resources in s that are not in ns.
+func attachmentResourcesDifference(s, ns []*globalaccelerator.Resource) []*globalaccelerator.Resource {
+	type key struct {
+		endpointID, region string
+	}
+
+	m := make(map[key]struct{})
+	for _, v := range ns {
+		m[key{aws.StringValue(v.EndpointId), aws.StringValue(v.Region)}] = struct{}{}
+	}
+
+	var result []*globalaccelerator.Resource
+	for _, v := range s {
+		if _, ok := m[key{aws.StringValue(v.EndpointId), aws.StringValue(v.Region)}]; !ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+type crossAccountAttachmentResourceModel struct {
+	AttachmentARN    types.String                                            `tfsdk:"arn"`
+	CreatedTime      timetypes.RFC3339                                       `tfsdk:"created_time"`
+	ID               types.String                                            `tfsdk:"id"`
+	LastModifiedTime timetypes.RFC3339                                       `tfsdk:"last_modified_time"`
+	Name             types.String                                            `tfsdk:"name"`
+	Principals       fwtypes.SetValueOf[types.String]                        `tfsdk:"principals"`
+	Resources        fwtypes.SetNestedObjectValueOf[attachmentResourceModel] `tfsdk:"resource"`
+	Tags             types.Map                                               `tfsdk:"tags"`
+	TagsAll          types.Map                                               `tfsdk:"tags_all"`
+}
+
+func (data *crossAccountAttachmentResourceModel) InitFromID() error {
+	data.AttachmentARN = data.ID
+
+	return nil
+}
+
+func (data *crossAccountAttachmentResourceModel) setID() {
+	data.ID = data.AttachmentARN
+}
+
+type attachmentResourceModel struct {
+	EndpointID types.String `tfsdk:"endpoint_id"`
+	Region     types.String `tfsdk:"region"`
+}
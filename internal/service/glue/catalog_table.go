@@ -57,6 +57,26 @@ func ResourceCatalogTable() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 2048),
 			},
+			"federated_table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -474,6 +494,14 @@ func resourceCatalogTableRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
 
+	if table.FederatedTable != nil {
+		if err := d.Set("federated_table", []interface{}{flattenTableFederatedTable(table.FederatedTable)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting federated_table: %s", err)
+		}
+	} else {
+		d.Set("federated_table", nil)
+	}
+
 	if table.TargetTable != nil {
 		if err := d.Set("target_table", []interface{}{flattenTableTargetTable(table.TargetTable)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting target_table: %s", err)
@@ -1156,6 +1184,28 @@ func flattenTableTargetTable(apiObject *glue.TableIdentifier) map[string]interfa
 	return tfMap
 }
 
+func flattenTableFederatedTable(apiObject *glue.FederatedTable) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectionName; v != nil {
+		tfMap["connection_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseIdentifier; v != nil {
+		tfMap["database_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Identifier; v != nil {
+		tfMap["identifier"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenNonManagedParameters(table *glue.TableData) map[string]string {
 	allParameters := table.Parameters
 	if aws.StringValue(allParameters["table_type"]) == "ICEBERG" {
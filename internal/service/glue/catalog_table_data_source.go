@@ -43,6 +43,26 @@ func DataSourceCatalogTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"federated_table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -391,6 +411,14 @@ func dataSourceCatalogTableRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
 
+	if table.FederatedTable != nil {
+		if err := d.Set("federated_table", []interface{}{flattenTableFederatedTable(table.FederatedTable)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting federated_table: %s", err)
+		}
+	} else {
+		d.Set("federated_table", nil)
+	}
+
 	if table.TargetTable != nil {
 		if err := d.Set("target_table", []interface{}{flattenTableTargetTable(table.TargetTable)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting target_table: %s", err)
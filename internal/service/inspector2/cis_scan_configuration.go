@@ -0,0 +1,490 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func ResourceCISScanConfiguration() *schema.Resource {
+	startTimeSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"time_of_day": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([0-1]?[0-9]|2[0-3]):([0-5][0-9])$`), "must be in HH:MM format"),
+				},
+				"timezone": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCISScanConfigurationCreate,
+		ReadWithoutTimeout:   resourceCISScanConfigurationRead,
+		UpdateWithoutTimeout: resourceCISScanConfigurationUpdate,
+		DeleteWithoutTimeout: resourceCISScanConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.daily", "schedule.0.monthly", "schedule.0.one_time", "schedule.0.weekly"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": startTimeSchema,
+								},
+							},
+						},
+						"monthly": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.daily", "schedule.0.monthly", "schedule.0.one_time", "schedule.0.weekly"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.Day](),
+									},
+									"start_time": startTimeSchema,
+								},
+							},
+						},
+						"one_time": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule.0.daily", "schedule.0.monthly", "schedule.0.one_time", "schedule.0.weekly"},
+						},
+						"weekly": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.daily", "schedule.0.monthly", "schedule.0.one_time", "schedule.0.weekly"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 7,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.Day](),
+										},
+									},
+									"start_time": startTimeSchema,
+								},
+							},
+						},
+					},
+				},
+			},
+			"security_level": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.CisSecurityLevel](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"targets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.Any(
+									verify.ValidAccountID,
+									validation.StringInSlice([]string{"SELF"}, false),
+								),
+							},
+						},
+						"target_resource_tags": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCISScanConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("scan_name").(string)
+	input := &inspector2.CreateCisScanConfigurationInput{
+		ScanName:      aws.String(name),
+		Schedule:      expandSchedule(d.Get("schedule").([]interface{})),
+		SecurityLevel: types.CisSecurityLevel(d.Get("security_level").(string)),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		accountIDs, targetResourceTags := expandCISTargets(v.([]interface{})[0].(map[string]interface{}))
+		input.Targets = &types.CreateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		}
+	}
+
+	output, err := conn.CreateCisScanConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector CIS Scan Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ScanConfigurationArn))
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	scanConfiguration, err := FindCISScanConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector CIS Scan Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", scanConfiguration.ScanConfigurationArn)
+	d.Set("scan_name", scanConfiguration.ScanName)
+	if err := d.Set("schedule", flattenSchedule(scanConfiguration.Schedule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+	}
+	d.Set("security_level", scanConfiguration.SecurityLevel)
+	if err := d.Set("targets", flattenCISTargets(scanConfiguration.Targets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
+	}
+
+	setTagsOut(ctx, scanConfiguration.Tags)
+
+	return diags
+}
+
+func resourceCISScanConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateCisScanConfigurationInput{
+			ScanConfigurationArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("scan_name") {
+			input.ScanName = aws.String(d.Get("scan_name").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = expandSchedule(d.Get("schedule").([]interface{}))
+		}
+
+		if d.HasChange("security_level") {
+			input.SecurityLevel = types.CisSecurityLevel(d.Get("security_level").(string))
+		}
+
+		if d.HasChange("targets") {
+			if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				accountIDs, targetResourceTags := expandCISTargets(v.([]interface{})[0].(map[string]interface{}))
+				input.Targets = &types.UpdateCisTargets{
+					AccountIds:         accountIDs,
+					TargetResourceTags: targetResourceTags,
+				}
+			}
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[INFO] Deleting Amazon Inspector CIS Scan Configuration: %s", d.Id())
+	_, err := conn.DeleteCisScanConfiguration(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &types.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []types.CisStringFilter{{
+				Comparison: types.CisStringComparisonEquals,
+				Value:      aws.String(arn),
+			}},
+		},
+	}
+
+	var output []types.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output[0], nil
+}
+
+func expandSchedule(tfList []interface{}) types.Schedule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["daily"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ScheduleMemberDaily{
+			Value: types.DailySchedule{
+				StartTime: expandTime(tfMap["start_time"].([]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["monthly"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ScheduleMemberMonthly{
+			Value: types.MonthlySchedule{
+				Day:       types.Day(tfMap["day"].(string)),
+				StartTime: expandTime(tfMap["start_time"].([]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["weekly"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.ScheduleMemberWeekly{
+			Value: types.WeeklySchedule{
+				Days:      flex.ExpandStringyValueSet[types.Day](tfMap["days"].(*schema.Set)),
+				StartTime: expandTime(tfMap["start_time"].([]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["one_time"].(bool); ok && v {
+		return &types.ScheduleMemberOneTime{}
+	}
+
+	return nil
+}
+
+func expandTime(tfList []interface{}) *types.Time {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.Time{
+		TimeOfDay: aws.String(tfMap["time_of_day"].(string)),
+		Timezone:  aws.String(tfMap["timezone"].(string)),
+	}
+}
+
+func expandCISTargets(tfMap map[string]interface{}) ([]string, map[string][]string) {
+	var accountIDs []string
+	targetResourceTags := map[string][]string{}
+
+	if v, ok := tfMap["account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		accountIDs = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["target_resource_tags"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			targetResourceTags[tfMap["key"].(string)] = flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))
+		}
+	}
+
+	return accountIDs, targetResourceTags
+}
+
+func flattenSchedule(apiObject types.Schedule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.ScheduleMemberDaily:
+		tfMap["daily"] = []interface{}{map[string]interface{}{
+			"start_time": flattenTime(v.Value.StartTime),
+		}}
+	case *types.ScheduleMemberMonthly:
+		tfMap["monthly"] = []interface{}{map[string]interface{}{
+			"day":        string(v.Value.Day),
+			"start_time": flattenTime(v.Value.StartTime),
+		}}
+	case *types.ScheduleMemberOneTime:
+		tfMap["one_time"] = true
+	case *types.ScheduleMemberWeekly:
+		tfMap["weekly"] = []interface{}{map[string]interface{}{
+			"days":       enum.Slice(v.Value.Days...),
+			"start_time": flattenTime(v.Value.StartTime),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTime(apiObject *types.Time) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"time_of_day": aws.ToString(apiObject.TimeOfDay),
+		"timezone":    aws.ToString(apiObject.Timezone),
+	}}
+}
+
+func flattenCISTargets(apiObject *types.CisTargets) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var targetResourceTags []interface{}
+
+	for k, v := range apiObject.TargetResourceTags {
+		targetResourceTags = append(targetResourceTags, map[string]interface{}{
+			"key":    k,
+			"values": v,
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"account_ids":          apiObject.AccountIds,
+		"target_resource_tags": targetResourceTags,
+	}}
+}
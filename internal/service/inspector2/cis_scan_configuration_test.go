@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInspector2CISScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexache.MustCompile(`owner/\d{12}/cis-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "scan_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.time_of_day", "12:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.0.start_time.0.timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel1)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.target_resource_tags.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCISScanConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel1)),
+				),
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_weekly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.daily.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.weekly.0.days.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(types.DayMon)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "schedule.0.weekly.0.days.*", string(types.DayThu)),
+					resource.TestCheckResourceAttr(resourceName, "security_level", string(types.CisSecurityLevelLevel2)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInspector2CISScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CisScanConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_cis_scan_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCISScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCISScanConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCISScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCISScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_cis_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Inspector CIS Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCISScanConfigurationExists(ctx context.Context, n string, v *types.CisScanConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindCISScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCISScanConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    daily {
+      start_time {
+        time_of_day = "12:00"
+        timezone    = "UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_weekly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_2"
+
+  schedule {
+    weekly {
+      days = ["MON", "THU"]
+
+      start_time {
+        time_of_day = "23:30"
+        timezone    = "UTC"
+      }
+    }
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCISScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_cis_scan_configuration" "test" {
+  scan_name      = %[1]q
+  security_level = "LEVEL_1"
+
+  schedule {
+    one_time = true
+  }
+
+  targets {
+    account_ids = ["SELF"]
+
+    target_resource_tags {
+      key    = "Name"
+      values = [%[1]q]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
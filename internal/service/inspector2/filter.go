@@ -0,0 +1,838 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func ResourceFilter() *schema.Resource {
+	filterCriteriaSchema := map[string]*schema.Schema{
+		"port_range": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"begin_inclusive": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+					"end_inclusive": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+				},
+			},
+		},
+		"resource_tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[types.MapComparison](),
+					},
+					"key": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"vulnerable_packages": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"architecture": packageStringFilterSchema(),
+					"epoch": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     numberFilterSchema().Elem,
+					},
+					"name":                    packageStringFilterSchema(),
+					"release":                 packageStringFilterSchema(),
+					"source_lambda_layer_arn": packageStringFilterSchema(),
+					"source_layer_hash":       packageStringFilterSchema(),
+					"version":                 packageStringFilterSchema(),
+				},
+			},
+		},
+	}
+
+	for k := range filterCriteriaStringFilters {
+		filterCriteriaSchema[k] = stringFilterSchema()
+	}
+	for k := range filterCriteriaDateFilters {
+		filterCriteriaSchema[k] = dateFilterSchema()
+	}
+	for k := range filterCriteriaNumberFilters {
+		filterCriteriaSchema[k] = numberFilterSchema()
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterAction](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: filterCriteriaSchema,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// filterCriteriaStringFilters maps each string filter criterion's attribute name to its field in the API object.
+var filterCriteriaStringFilters = map[string]func(*types.FilterCriteria) *[]types.StringFilter{
+	"aws_account_id":                     func(c *types.FilterCriteria) *[]types.StringFilter { return &c.AwsAccountId },
+	"code_vulnerability_detector_name":   func(c *types.FilterCriteria) *[]types.StringFilter { return &c.CodeVulnerabilityDetectorName },
+	"code_vulnerability_detector_tags":   func(c *types.FilterCriteria) *[]types.StringFilter { return &c.CodeVulnerabilityDetectorTags },
+	"code_vulnerability_file_path":       func(c *types.FilterCriteria) *[]types.StringFilter { return &c.CodeVulnerabilityFilePath },
+	"component_id":                       func(c *types.FilterCriteria) *[]types.StringFilter { return &c.ComponentId },
+	"component_type":                     func(c *types.FilterCriteria) *[]types.StringFilter { return &c.ComponentType },
+	"ec2_instance_image_id":              func(c *types.FilterCriteria) *[]types.StringFilter { return &c.Ec2InstanceImageId },
+	"ec2_instance_subnet_id":             func(c *types.FilterCriteria) *[]types.StringFilter { return &c.Ec2InstanceSubnetId },
+	"ec2_instance_vpc_id":                func(c *types.FilterCriteria) *[]types.StringFilter { return &c.Ec2InstanceVpcId },
+	"ecr_image_architecture":             func(c *types.FilterCriteria) *[]types.StringFilter { return &c.EcrImageArchitecture },
+	"ecr_image_hash":                     func(c *types.FilterCriteria) *[]types.StringFilter { return &c.EcrImageHash },
+	"ecr_image_registry":                 func(c *types.FilterCriteria) *[]types.StringFilter { return &c.EcrImageRegistry },
+	"ecr_image_repository_name":          func(c *types.FilterCriteria) *[]types.StringFilter { return &c.EcrImageRepositoryName },
+	"ecr_image_tags":                     func(c *types.FilterCriteria) *[]types.StringFilter { return &c.EcrImageTags },
+	"exploit_available":                  func(c *types.FilterCriteria) *[]types.StringFilter { return &c.ExploitAvailable },
+	"finding_arn":                        func(c *types.FilterCriteria) *[]types.StringFilter { return &c.FindingArn },
+	"finding_status":                     func(c *types.FilterCriteria) *[]types.StringFilter { return &c.FindingStatus },
+	"finding_type":                       func(c *types.FilterCriteria) *[]types.StringFilter { return &c.FindingType },
+	"fix_available":                      func(c *types.FilterCriteria) *[]types.StringFilter { return &c.FixAvailable },
+	"lambda_function_execution_role_arn": func(c *types.FilterCriteria) *[]types.StringFilter { return &c.LambdaFunctionExecutionRoleArn },
+	"lambda_function_layers":             func(c *types.FilterCriteria) *[]types.StringFilter { return &c.LambdaFunctionLayers },
+	"lambda_function_name":               func(c *types.FilterCriteria) *[]types.StringFilter { return &c.LambdaFunctionName },
+	"lambda_function_runtime":            func(c *types.FilterCriteria) *[]types.StringFilter { return &c.LambdaFunctionRuntime },
+	"network_protocol":                   func(c *types.FilterCriteria) *[]types.StringFilter { return &c.NetworkProtocol },
+	"related_vulnerabilities":            func(c *types.FilterCriteria) *[]types.StringFilter { return &c.RelatedVulnerabilities },
+	"resource_id":                        func(c *types.FilterCriteria) *[]types.StringFilter { return &c.ResourceId },
+	"resource_type":                      func(c *types.FilterCriteria) *[]types.StringFilter { return &c.ResourceType },
+	"severity":                           func(c *types.FilterCriteria) *[]types.StringFilter { return &c.Severity },
+	"title":                              func(c *types.FilterCriteria) *[]types.StringFilter { return &c.Title },
+	"vendor_severity":                    func(c *types.FilterCriteria) *[]types.StringFilter { return &c.VendorSeverity },
+	"vulnerability_id":                   func(c *types.FilterCriteria) *[]types.StringFilter { return &c.VulnerabilityId },
+	"vulnerability_source":               func(c *types.FilterCriteria) *[]types.StringFilter { return &c.VulnerabilitySource },
+}
+
+// filterCriteriaDateFilters maps each date filter criterion's attribute name to its field in the API object.
+var filterCriteriaDateFilters = map[string]func(*types.FilterCriteria) *[]types.DateFilter{
+	"ecr_image_pushed_at":              func(c *types.FilterCriteria) *[]types.DateFilter { return &c.EcrImagePushedAt },
+	"first_observed_at":                func(c *types.FilterCriteria) *[]types.DateFilter { return &c.FirstObservedAt },
+	"lambda_function_last_modified_at": func(c *types.FilterCriteria) *[]types.DateFilter { return &c.LambdaFunctionLastModifiedAt },
+	"last_observed_at":                 func(c *types.FilterCriteria) *[]types.DateFilter { return &c.LastObservedAt },
+	"updated_at":                       func(c *types.FilterCriteria) *[]types.DateFilter { return &c.UpdatedAt },
+}
+
+// filterCriteriaNumberFilters maps each number filter criterion's attribute name to its field in the API object.
+var filterCriteriaNumberFilters = map[string]func(*types.FilterCriteria) *[]types.NumberFilter{
+	"epss_score":      func(c *types.FilterCriteria) *[]types.NumberFilter { return &c.EpssScore },
+	"inspector_score": func(c *types.FilterCriteria) *[]types.NumberFilter { return &c.InspectorScore },
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.StringComparison](),
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func packageStringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     stringFilterSchema().Elem,
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				"upper_inclusive": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("name").(string)
+	input := &inspector2.CreateFilterInput{
+		Action:         types.FilterAction(d.Get("action").(string)),
+		FilterCriteria: expandFilterCriteria(d.Get("filter_criteria").([]interface{})),
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	filter, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Amazon Inspector Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", filter.Action)
+	d.Set("arn", filter.Arn)
+	d.Set("description", filter.Description)
+	if err := d.Set("filter_criteria", flattenFilterCriteria(filter.Criteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_criteria: %s", err)
+	}
+	d.Set("name", filter.Name)
+	d.Set("reason", filter.Reason)
+
+	setTagsOut(ctx, filter.Tags)
+
+	return diags
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &inspector2.UpdateFilterInput{
+			FilterArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Action = types.FilterAction(d.Get("action").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("filter_criteria") {
+			input.FilterCriteria = expandFilterCriteria(d.Get("filter_criteria").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("reason") {
+			input.Reason = aws.String(d.Get("reason").(string))
+		}
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Amazon Inspector Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[INFO] Deleting Amazon Inspector Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	var output []types.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output[0], nil
+}
+
+func expandFilterCriteria(tfList []interface{}) *types.FilterCriteria {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.FilterCriteria{}
+
+	for k, f := range filterCriteriaStringFilters {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			*f(apiObject) = expandStringFilters(v.List())
+		}
+	}
+
+	for k, f := range filterCriteriaDateFilters {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			*f(apiObject) = expandDateFilters(v.List())
+		}
+	}
+
+	for k, f := range filterCriteriaNumberFilters {
+		if v, ok := tfMap[k].(*schema.Set); ok && v.Len() > 0 {
+			*f(apiObject) = expandNumberFilters(v.List())
+		}
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRange = expandPortRangeFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerable_packages"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerablePackages = expandPackageFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandStringFilter(tfMap map[string]interface{}) *types.StringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.StringFilter{}
+
+	if v, ok := tfMap["comparison"].(string); ok && v != "" {
+		apiObject.Comparison = types.StringComparison(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandStringFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDateFilters(tfList []interface{}) []types.DateFilter {
+	var apiObjects []types.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilter(tfMap map[string]interface{}) *types.NumberFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NumberFilter{}
+
+	if v, ok := tfMap["lower_inclusive"].(float64); ok && v != 0 {
+		apiObject.LowerInclusive = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["upper_inclusive"].(float64); ok && v != 0 {
+		apiObject.UpperInclusive = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandNumberFilters(tfList []interface{}) []types.NumberFilter {
+	var apiObjects []types.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *expandNumberFilter(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []types.PortRangeFilter {
+	var apiObjects []types.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok {
+			apiObject.BeginInclusive = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok {
+			apiObject.EndInclusive = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []types.MapFilter {
+	var apiObjects []types.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.MapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []interface{}) []types.PackageFilter {
+	var apiObjects []types.PackageFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PackageFilter{}
+
+		if v, ok := tfMap["architecture"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Architecture = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["epoch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Epoch = expandNumberFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Name = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["release"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Release = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_lambda_layer_arn"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceLambdaLayerArn = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["source_layer_hash"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceLayerHash = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["version"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Version = expandStringFilter(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, f := range filterCriteriaStringFilters {
+		if v := *f(apiObject); len(v) > 0 {
+			tfMap[k] = flattenStringFilters(v)
+		}
+	}
+
+	for k, f := range filterCriteriaDateFilters {
+		if v := *f(apiObject); len(v) > 0 {
+			tfMap[k] = flattenDateFilters(v)
+		}
+	}
+
+	for k, f := range filterCriteriaNumberFilters {
+		if v := *f(apiObject); len(v) > 0 {
+			tfMap[k] = flattenNumberFilters(v)
+		}
+	}
+
+	if v := apiObject.PortRange; len(v) > 0 {
+		tfMap["port_range"] = flattenPortRangeFilters(v)
+	}
+
+	if v := apiObject.ResourceTags; len(v) > 0 {
+		tfMap["resource_tags"] = flattenMapFilters(v)
+	}
+
+	if v := apiObject.VulnerablePackages; len(v) > 0 {
+		tfMap["vulnerable_packages"] = flattenPackageFilters(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStringFilter(apiObject *types.StringFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"comparison": string(apiObject.Comparison),
+		"value":      aws.ToString(apiObject.Value),
+	}
+}
+
+func flattenStringFilters(apiObjects []types.StringFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenStringFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDateFilters(apiObjects []types.DateFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilter(apiObject *types.NumberFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"lower_inclusive": aws.ToFloat64(apiObject.LowerInclusive),
+		"upper_inclusive": aws.ToFloat64(apiObject.UpperInclusive),
+	}
+}
+
+func flattenNumberFilters(apiObjects []types.NumberFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		apiObject := apiObject
+		tfList = append(tfList, flattenNumberFilter(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []types.PortRangeFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"begin_inclusive": aws.ToInt32(apiObject.BeginInclusive),
+			"end_inclusive":   aws.ToInt32(apiObject.EndInclusive),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []types.MapFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+			"key":        aws.ToString(apiObject.Key),
+			"value":      aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []types.PackageFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Architecture; v != nil {
+			tfMap["architecture"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Epoch; v != nil {
+			tfMap["epoch"] = []interface{}{flattenNumberFilter(v)}
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Release; v != nil {
+			tfMap["release"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLambdaLayerArn; v != nil {
+			tfMap["source_lambda_layer_arn"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.SourceLayerHash; v != nil {
+			tfMap["source_layer_hash"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		if v := apiObject.Version; v != nil {
+			tfMap["version"] = []interface{}{flattenStringFilter(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -75,6 +75,8 @@ func ResourceDomainConfiguration() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			// Server certificates can't be changed once the domain configuration is created.
+			// Imported certificates are rotated by re-importing into the same ACM certificate ARN.
 			"server_certificate_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -84,6 +86,40 @@ func ResourceDomainConfiguration() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"server_certificate_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_ocsp_check": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"server_certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server_certificate_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_certificate_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_certificate_status_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"service_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -146,6 +182,10 @@ func resourceDomainConfigurationCreate(ctx context.Context, d *schema.ResourceDa
 		input.ServerCertificateArns = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("service_type"); ok {
 		input.ServiceType = aws.String(v.(string))
 	}
@@ -199,6 +239,16 @@ func resourceDomainConfigurationRead(ctx context.Context, d *schema.ResourceData
 	d.Set("server_certificate_arns", tfslices.ApplyToAll(output.ServerCertificates, func(v *iot.ServerCertificateSummary) string {
 		return aws.StringValue(v.ServerCertificateArn)
 	}))
+	if output.ServerCertificateConfig != nil {
+		if err := d.Set("server_certificate_config", []interface{}{flattenServerCertificateConfig(output.ServerCertificateConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_certificate_config: %s", err)
+		}
+	} else {
+		d.Set("server_certificate_config", nil)
+	}
+	if err := d.Set("server_certificates", flattenServerCertificateSummaries(output.ServerCertificates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting server_certificates: %s", err)
+	}
 	d.Set("service_type", output.ServiceType)
 	d.Set("status", output.DomainConfigurationStatus)
 	if output.TlsConfig != nil {
@@ -230,6 +280,16 @@ func resourceDomainConfigurationUpdate(ctx context.Context, d *schema.ResourceDa
 			}
 		}
 
+		if d.HasChange("server_certificate_config") {
+			if v, ok := d.GetOk("server_certificate_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ServerCertificateConfig = expandServerCertificateConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.ServerCertificateConfig = &iot.ServerCertificateConfig{
+					EnableOCSPCheck: aws.Bool(false),
+				}
+			}
+		}
+
 		if d.HasChange("status") {
 			input.DomainConfigurationStatus = aws.String(d.Get("status").(string))
 		}
@@ -329,6 +389,20 @@ func expandAuthorizerConfig(tfMap map[string]interface{}) *iot.AuthorizerConfig
 	return apiObject
 }
 
+func expandServerCertificateConfig(tfMap map[string]interface{}) *iot.ServerCertificateConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iot.ServerCertificateConfig{}
+
+	if v, ok := tfMap["enable_ocsp_check"].(bool); ok {
+		apiObject.EnableOCSPCheck = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandTlsConfig(tfMap map[string]interface{}) *iot.TlsConfig { // nosemgrep:ci.caps5-in-func-name
 	if tfMap == nil {
 		return nil
@@ -361,6 +435,60 @@ func flattenAuthorizerConfig(apiObject *iot.AuthorizerConfig) map[string]interfa
 	return tfMap
 }
 
+func flattenServerCertificateConfig(apiObject *iot.ServerCertificateConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EnableOCSPCheck; v != nil {
+		tfMap["enable_ocsp_check"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenServerCertificateSummary(apiObject *iot.ServerCertificateSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ServerCertificateArn; v != nil {
+		tfMap["server_certificate_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ServerCertificateStatus; v != nil {
+		tfMap["server_certificate_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ServerCertificateStatusDetail; v != nil {
+		tfMap["server_certificate_status_detail"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenServerCertificateSummaries(apiObjects []*iot.ServerCertificateSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenServerCertificateSummary(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenTlsConfig(apiObject *iot.TlsConfig) map[string]interface{} { // nosemgrep:ci.caps5-in-func-name
 	if apiObject == nil {
 		return nil
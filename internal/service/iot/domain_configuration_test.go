@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -41,6 +42,9 @@ func TestAccIoTDomainConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "domain_type", "CUSTOMER_MANAGED"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "server_certificate_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificates.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_certificates.0.server_certificate_arn", "aws_acm_certificate.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificates.0.server_certificate_status", "VALID"),
 					resource.TestCheckResourceAttr(resourceName, "service_type", "DATA"),
 					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
@@ -169,6 +173,61 @@ func TestAccIoTDomainConfiguration_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tls_config.0.security_policy", "IoTSecurityPolicy_TLS13_1_2_2022_10"),
 				),
 			},
+			{
+				Config: testAccDomainConfigurationConfig_basic(rName, rootDomain, domain),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTDomainConfiguration_serverCertificateConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	resourceName := "aws_iot_domain_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_certificate_config.0.enable_ocsp_check", "false"),
+				),
+			},
 		},
 	})
 }
@@ -344,6 +403,22 @@ resource "aws_iot_domain_configuration" "test" {
 `, rName, domain, securityPolicy, allowAuthorizerOverride))
 }
 
+func testAccDomainConfigurationConfig_serverCertificateConfig(rName, rootDomain, domain string, enableOCSPCheck bool) string {
+	return acctest.ConfigCompose(testAccDomainConfigurationConfig_base(rootDomain, domain), fmt.Sprintf(`
+resource "aws_iot_domain_configuration" "test" {
+  depends_on = [aws_acm_certificate_validation.test]
+
+  name                    = %[1]q
+  domain_name             = %[2]q
+  server_certificate_arns = [aws_acm_certificate.test.arn]
+
+  server_certificate_config {
+    enable_ocsp_check = %[3]t
+  }
+}
+`, rName, domain, enableOCSPCheck))
+}
+
 func testAccDomainConfigurationConfig_awsManaged(rName string) string { // nosemgrep:ci.aws-in-func-name
 	return fmt.Sprintf(`
 resource "aws_iot_domain_configuration" "test" {
@@ -96,6 +96,11 @@ func testAccIndexingConfiguration_allAttributes(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.#", "2"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "thing1shadow"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "thing_indexing_configuration.0.filter.0.named_shadow_names.*", "$package"),
+					resource.TestCheckResourceAttr(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "thing_indexing_configuration.0.filter.0.geo_location.*", map[string]string{
+						"name":  "shadow.name.thing1shadow.desired.location",
+						"order": "LonLat",
+					}),
 				),
 			},
 			{
@@ -133,6 +138,11 @@ resource "aws_iot_indexing_configuration" "test" {
 
     filter {
       named_shadow_names = ["thing1shadow", "$package"]
+
+      geo_location {
+        name  = "shadow.name.thing1shadow.desired.location"
+        order = "LonLat"
+      }
     }
 
     custom_field {
@@ -25,6 +25,10 @@ func ResourceLoggingOptions() *schema.Resource {
 		UpdateWithoutTimeout: resourceLoggingOptionsPut,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"default_log_level": {
 				Type:         schema.TypeString,
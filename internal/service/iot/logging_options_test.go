@@ -43,6 +43,11 @@ func testAccLoggingOptions_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "role_arn"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,6 +32,7 @@ func ResourceRecordingConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRecordingConfigurationCreate,
 		ReadWithoutTimeout:   resourceRecordingConfigurationRead,
+		UpdateWithoutTimeout: resourceRecordingConfigurationUpdate,
 		DeleteWithoutTimeout: resourceRecordingConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -58,11 +60,13 @@ func ResourceRecordingConfiguration() *schema.Resource {
 							Type:     schema.TypeList,
 							MaxItems: 1,
 							Required: true,
+							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"bucket_name": {
 										Type:         schema.TypeString,
 										Required:     true,
+										ForceNew:     true,
 										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9a-z.-]{3,63}$`), "must contain only lowercase alphanumeric characters, hyphen, or dot, and between 3 and 63 characters"),
 									},
 								},
@@ -85,11 +89,39 @@ func ResourceRecordingConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 300),
 			},
+			"rendition_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rendition_selection": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRenditionSelection_Values(), false),
+						},
+						"renditions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRendition_Values(), false),
+							},
+						},
+					},
+				},
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrTags:    tftags.TagsSchemaForceNew(),
+			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbnail_configuration": {
 				Type:     schema.TypeList,
@@ -103,13 +135,32 @@ func ResourceRecordingConfiguration() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
+							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(ivs.RecordingMode_Values(), false),
 						},
+						"resolution": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationResolution_Values(), false),
+						},
+						"storage": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationStorage_Values(), false),
+							},
+						},
 						"target_interval_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.IntBetween(5, 60),
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 60),
 						},
 					},
 				},
@@ -142,6 +193,10 @@ func resourceRecordingConfigurationCreate(ctx context.Context, d *schema.Resourc
 		in.RecordingReconnectWindowSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("rendition_configuration"); ok {
+		in.RenditionConfiguration = expandRenditionConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("thumbnail_configuration"); ok {
 		in.ThumbnailConfiguration = expandThumbnailConfiguration(v.([]interface{}))
 
@@ -193,6 +248,11 @@ func resourceRecordingConfigurationRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set("name", out.Name)
 	d.Set("recording_reconnect_window_seconds", out.RecordingReconnectWindowSeconds)
+
+	if err := d.Set("rendition_configuration", flattenRenditionConfiguration(out.RenditionConfiguration)); err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionSetting, ResNameRecordingConfiguration, d.Id(), err)
+	}
+
 	d.Set("state", out.State)
 
 	if err := d.Set("thumbnail_configuration", flattenThumbnailConfiguration(out.ThumbnailConfiguration)); err != nil {
@@ -202,6 +262,14 @@ func resourceRecordingConfigurationRead(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceRecordingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Recording configurations are immutable. Only tags can be updated in place.
+
+	return append(diags, resourceRecordingConfigurationRead(ctx, d, meta)...)
+}
+
 func resourceRecordingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -256,6 +324,24 @@ func flattenS3DestinationConfiguration(apiObject *ivs.S3DestinationConfiguration
 	return []interface{}{m}
 }
 
+func flattenRenditionConfiguration(apiObject *ivs.RenditionConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.RenditionSelection; v != nil {
+		m["rendition_selection"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Renditions; v != nil {
+		m["renditions"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{m}
+}
+
 func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -267,6 +353,14 @@ func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []inte
 		m["recording_mode"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Resolution; v != nil {
+		m["resolution"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Storage; v != nil {
+		m["storage"] = aws.StringValueSlice(v)
+	}
+
 	if v := apiObject.TargetIntervalSeconds; v != nil {
 		m["target_interval_seconds"] = aws.Int64Value(v)
 	}
@@ -303,6 +397,24 @@ func expandS3DestinationConfiguration(vSettings []interface{}) *ivs.S3Destinatio
 	return a
 }
 
+func expandRenditionConfiguration(vSettings []interface{}) *ivs.RenditionConfiguration {
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return nil
+	}
+	a := &ivs.RenditionConfiguration{}
+	tfMap := vSettings[0].(map[string]interface{})
+
+	if v, ok := tfMap["rendition_selection"].(string); ok && v != "" {
+		a.RenditionSelection = aws.String(v)
+	}
+
+	if v, ok := tfMap["renditions"].(*schema.Set); ok && v.Len() > 0 {
+		a.Renditions = flex.ExpandStringSet(v)
+	}
+
+	return a
+}
+
 func expandThumbnailConfiguration(vSettings []interface{}) *ivs.ThumbnailConfiguration {
 	if len(vSettings) == 0 || vSettings[0] == nil {
 		return nil
@@ -314,7 +426,15 @@ func expandThumbnailConfiguration(vSettings []interface{}) *ivs.ThumbnailConfigu
 		a.RecordingMode = aws.String(v)
 	}
 
-	if v, ok := tfMap["target_interval_seconds"].(int); ok {
+	if v, ok := tfMap["resolution"].(string); ok && v != "" {
+		a.Resolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage"].(*schema.Set); ok && v.Len() > 0 {
+		a.Storage = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["target_interval_seconds"].(int); ok && v != 0 {
 		a.TargetIntervalSeconds = aws.Int64(int64(v))
 	}
 
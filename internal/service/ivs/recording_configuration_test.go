@@ -170,7 +170,7 @@ func TestAccIVSRecordingConfiguration_disappears_S3Bucket(t *testing.T) {
 
 func TestAccIVSRecordingConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var recordingConfiguration ivs.RecordingConfiguration
+	var recordingConfiguration, v2 ivs.RecordingConfiguration
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ivs_recording_configuration.test"
 
@@ -200,7 +200,8 @@ func TestAccIVSRecordingConfiguration_tags(t *testing.T) {
 			{
 				Config: testAccRecordingConfigurationConfig_tags2(bucketName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordingConfigurationExists(ctx, resourceName, &recordingConfiguration),
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckRecordingConfigurationNotRecreated(&recordingConfiguration, &v2),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -218,6 +219,127 @@ func TestAccIVSRecordingConfiguration_tags(t *testing.T) {
 	})
 }
 
+func TestAccIVSRecordingConfiguration_renditionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordingConfigurationConfig_renditionConfiguration(bucketName, "ALL", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecordingConfigurationConfig_renditionConfiguration(bucketName, "CUSTOM", `"HD", "SD"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckRecordingConfigurationRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.renditions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "HD"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "SD"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSRecordingConfiguration_thumbnailConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordingConfigurationConfig_thumbnailConfiguration(bucketName, "HD", `"SEQUENTIAL"`, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.recording_mode", "INTERVAL"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "SEQUENTIAL"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.target_interval_seconds", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecordingConfigurationConfig_thumbnailConfiguration(bucketName, "FULL_HD", `"LATEST", "SEQUENTIAL"`, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckRecordingConfigurationRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "FULL_HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "LATEST"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "SEQUENTIAL"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.target_interval_seconds", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSRecordingConfiguration_thumbnailDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var recordingConfiguration ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordingConfigurationConfig_thumbnailDisabled(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &recordingConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.recording_mode", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRecordingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
@@ -280,6 +402,16 @@ func testAccCheckRecordingConfigurationRecreated(before, after *ivs.RecordingCon
 	}
 }
 
+func testAccCheckRecordingConfigurationNotRecreated(before, after *ivs.RecordingConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return create.Error(names.IVS, create.ErrActionCheckingNotRecreated, tfivs.ResNameRecordingConfiguration, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
 func testAccRecordingConfigurationPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
 
@@ -353,6 +485,61 @@ resource "aws_ivs_recording_configuration" "test" {
 `, rName, recordingReconnectWindowSeconds, recordingMode, targetIntervalSeconds))
 }
 
+func testAccRecordingConfigurationConfig_renditionConfiguration(bucketName, renditionSelection, renditions string) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		fmt.Sprintf(`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+  rendition_configuration {
+    rendition_selection = %[1]q
+    renditions          = [%[2]s]
+  }
+}
+`, renditionSelection, renditions))
+}
+
+func testAccRecordingConfigurationConfig_thumbnailConfiguration(bucketName, resolution, storage string, targetIntervalSeconds int) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		fmt.Sprintf(`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+  thumbnail_configuration {
+    recording_mode          = "INTERVAL"
+    resolution              = %[1]q
+    storage                 = [%[2]s]
+    target_interval_seconds = %[3]d
+  }
+}
+`, resolution, storage, targetIntervalSeconds))
+}
+
+func testAccRecordingConfigurationConfig_thumbnailDisabled(bucketName string) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+  thumbnail_configuration {
+    recording_mode = "DISABLED"
+  }
+}
+`)
+}
+
 func testAccRecordingConfigurationConfig_tags1(bucketName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
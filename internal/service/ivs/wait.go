@@ -5,10 +5,13 @@ package ivs
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ivs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitPlaybackKeyPairCreated(ctx context.Context, conn *ivs.IVS, id string, timeout time.Duration) (*ivs.PlaybackKeyPair, error) {
@@ -57,6 +60,10 @@ func waitRecordingConfigurationCreated(ctx context.Context, conn *ivs.IVS, id st
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*ivs.RecordingConfiguration); ok {
+		if aws.StringValue(out.State) == ivs.RecordingConfigurationStateCreateFailed {
+			tfresource.SetLastError(err, errors.New("recording configuration creation failed, verify that the destination S3 bucket exists and is in the same Region"))
+		}
+
 		return out, err
 	}
 
@@ -65,7 +72,7 @@ func waitRecordingConfigurationCreated(ctx context.Context, conn *ivs.IVS, id st
 
 func waitRecordingConfigurationDeleted(ctx context.Context, conn *ivs.IVS, id string, timeout time.Duration) (*ivs.RecordingConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{ivs.RecordingConfigurationStateActive},
+		Pending: []string{ivs.RecordingConfigurationStateActive, ivs.RecordingConfigurationStateCreateFailed},
 		Target:  []string{},
 		Refresh: statusRecordingConfiguration(ctx, conn, id),
 		Timeout: timeout,
@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivsrealtime"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ivsrealtime_composition", name="Composition")
+// @Tags(identifierAttribute="arn")
+func resourceComposition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCompositionCreate,
+		ReadWithoutTimeout:   resourceCompositionRead,
+		UpdateWithoutTimeout: resourceCompositionUpdate,
+		DeleteWithoutTimeout: resourceCompositionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"encoder_configuration_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"s3": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encoder_configuration_arns": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"recording_format": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.RecordingConfigurationFormat](),
+									},
+									"storage_configuration_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"layout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grid": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"featured_participant_attribute": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"stage_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCompositionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	input := &ivsrealtime.StartCompositionInput{
+		Destinations: expandDestinationConfigurations(d.Get("destination").([]interface{})),
+		StageArn:     aws.String(d.Get("stage_arn").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("layout"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Layout = expandLayoutConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.StartComposition(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting IVS Real-Time Composition: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Composition.Arn))
+
+	if _, err := waitCompositionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IVS Real-Time Composition (%s) start: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCompositionRead(ctx, d, meta)...)
+}
+
+func resourceCompositionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	composition, err := findCompositionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Real-Time Composition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IVS Real-Time Composition (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", composition.Arn)
+	if err := d.Set("destination", flattenDestinations(composition.Destinations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting destination: %s", err)
+	}
+	if composition.Layout != nil {
+		if err := d.Set("layout", []interface{}{flattenLayoutConfiguration(composition.Layout)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting layout: %s", err)
+		}
+	} else {
+		d.Set("layout", nil)
+	}
+	d.Set("stage_arn", composition.StageArn)
+	d.Set("state", composition.State)
+
+	setTagsOut(ctx, composition.Tags)
+
+	return diags
+}
+
+func resourceCompositionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceCompositionRead(ctx, d, meta)...)
+}
+
+func resourceCompositionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	log.Printf("[DEBUG] Stopping IVS Real-Time Composition: %s", d.Id())
+	_, err := conn.StopComposition(ctx, &ivsrealtime.StopCompositionInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping IVS Real-Time Composition (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCompositionStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IVS Real-Time Composition (%s) stop: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findCompositionByARN returns the composition with the specified ARN.
+// Stopped compositions can't be restarted, so they are treated as not found.
+func findCompositionByARN(ctx context.Context, conn *ivsrealtime.Client, arn string) (*awstypes.Composition, error) {
+	input := &ivsrealtime.GetCompositionInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetComposition(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Composition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.Composition.State; state == awstypes.CompositionStateStopped {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.Composition, nil
+}
+
+func statusComposition(ctx context.Context, conn *ivsrealtime.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCompositionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitCompositionActive(ctx context.Context, conn *ivsrealtime.Client, arn string, timeout time.Duration) (*awstypes.Composition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CompositionStateStarting),
+		Target:  enum.Slice(awstypes.CompositionStateActive),
+		Refresh: statusComposition(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Composition); ok {
+		if output.State == awstypes.CompositionStateFailed {
+			var destinationErrs []error
+
+			for _, v := range output.Destinations {
+				if v.State == awstypes.DestinationStateFailed {
+					destinationErrs = append(destinationErrs, fmt.Errorf("destination (%s) %s", aws.ToString(v.Id), v.State))
+				}
+			}
+
+			tfresource.SetLastError(err, errors.Join(destinationErrs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCompositionStopped(ctx context.Context, conn *ivsrealtime.Client, arn string, timeout time.Duration) (*awstypes.Composition, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CompositionStateActive, awstypes.CompositionStateStarting, awstypes.CompositionStateStopping),
+		Target:  []string{},
+		Refresh: statusComposition(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Composition); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDestinationConfigurations(tfList []interface{}) []awstypes.DestinationConfiguration {
+	var apiObjects []awstypes.DestinationConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DestinationConfiguration{}
+
+		if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Channel = expandChannelDestinationConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3 = expandS3DestinationConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChannelDestinationConfiguration(tfMap map[string]interface{}) *awstypes.ChannelDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ChannelDestinationConfiguration{}
+
+	if v, ok := tfMap["channel_arn"].(string); ok && v != "" {
+		apiObject.ChannelArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["encoder_configuration_arn"].(string); ok && v != "" {
+		apiObject.EncoderConfigurationArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3DestinationConfiguration(tfMap map[string]interface{}) *awstypes.S3DestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.S3DestinationConfiguration{}
+
+	if v, ok := tfMap["encoder_configuration_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EncoderConfigurationArns = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["recording_format"].(string); ok && v != "" {
+		apiObject.RecordingConfiguration = &awstypes.RecordingConfiguration{
+			Format: awstypes.RecordingConfigurationFormat(v),
+		}
+	}
+
+	if v, ok := tfMap["storage_configuration_arn"].(string); ok && v != "" {
+		apiObject.StorageConfigurationArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLayoutConfiguration(tfMap map[string]interface{}) *awstypes.LayoutConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.LayoutConfiguration{}
+
+	if v, ok := tfMap["grid"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Grid = &awstypes.GridConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["featured_participant_attribute"].(string); ok && v != "" {
+			apiObject.Grid.FeaturedParticipantAttribute = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDestinations(apiObjects []awstypes.Destination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject.Configuration == nil {
+			continue
+		}
+
+		configuration := apiObject.Configuration
+		tfMap := map[string]interface{}{
+			"name": aws.ToString(configuration.Name),
+		}
+
+		if v := configuration.Channel; v != nil {
+			tfMap["channel"] = []interface{}{map[string]interface{}{
+				"channel_arn":               aws.ToString(v.ChannelArn),
+				"encoder_configuration_arn": aws.ToString(v.EncoderConfigurationArn),
+			}}
+		}
+
+		if v := configuration.S3; v != nil {
+			s3 := map[string]interface{}{
+				"encoder_configuration_arns": v.EncoderConfigurationArns,
+				"storage_configuration_arn":  aws.ToString(v.StorageConfigurationArn),
+			}
+
+			if v := v.RecordingConfiguration; v != nil {
+				s3["recording_format"] = v.Format
+			}
+
+			tfMap["s3"] = []interface{}{s3}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenLayoutConfiguration(apiObject *awstypes.LayoutConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Grid; v != nil {
+		tfMap["grid"] = []interface{}{map[string]interface{}{
+			"featured_participant_attribute": aws.ToString(v.FeaturedParticipantAttribute),
+		}}
+	}
+
+	return tfMap
+}
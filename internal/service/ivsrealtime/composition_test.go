@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivsrealtime "github.com/hashicorp/terraform-provider-aws/internal/service/ivsrealtime"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSRealTimeComposition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Composition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_composition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexache.MustCompile(`composition/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.s3.0.encoder_configuration_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.s3.0.storage_configuration_arn", "aws_ivsrealtime_storage_configuration.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage_arn", "aws_ivsrealtime_stage.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", string(awstypes.CompositionStateActive)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeComposition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Composition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_composition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivsrealtime.ResourceComposition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeComposition_layout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Composition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_composition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCompositionConfig_layout(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.s3.0.recording_format", string(awstypes.RecordingConfigurationFormatHls)),
+					resource.TestCheckResourceAttr(resourceName, "layout.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "layout.0.grid.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "layout.0.grid.0.featured_participant_attribute", "featured"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCompositionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivsrealtime_composition" {
+				continue
+			}
+
+			_, err := tfivsrealtime.FindCompositionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IVS Real-Time Composition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCompositionExists(ctx context.Context, n string, v *awstypes.Composition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+		output, err := tfivsrealtime.FindCompositionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCompositionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccStorageConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_ivsrealtime_stage" "test" {
+  name = %[1]q
+}
+
+resource "aws_ivsrealtime_encoder_configuration" "test" {
+  name = %[1]q
+}
+
+resource "aws_ivsrealtime_storage_configuration" "test" {
+  name = %[1]q
+
+  s3 {
+    bucket_name = aws_s3_bucket.test.id
+  }
+}
+`, rName))
+}
+
+func testAccCompositionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCompositionConfig_base(rName), `
+resource "aws_ivsrealtime_composition" "test" {
+  stage_arn = aws_ivsrealtime_stage.test.arn
+
+  destination {
+    s3 {
+      encoder_configuration_arns = [aws_ivsrealtime_encoder_configuration.test.arn]
+      storage_configuration_arn  = aws_ivsrealtime_storage_configuration.test.arn
+    }
+  }
+}
+`)
+}
+
+func testAccCompositionConfig_layout(rName string) string {
+	return acctest.ConfigCompose(testAccCompositionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ivsrealtime_composition" "test" {
+  stage_arn = aws_ivsrealtime_stage.test.arn
+
+  destination {
+    name = %[1]q
+
+    s3 {
+      encoder_configuration_arns = [aws_ivsrealtime_encoder_configuration.test.arn]
+      recording_format           = "HLS"
+      storage_configuration_arn  = aws_ivsrealtime_storage_configuration.test.arn
+    }
+  }
+
+  layout {
+    grid {
+      featured_participant_attribute = "featured"
+    }
+  }
+}
+`, rName))
+}
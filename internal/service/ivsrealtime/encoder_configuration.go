@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivsrealtime"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ivsrealtime_encoder_configuration", name="Encoder Configuration")
+// @Tags(identifierAttribute="arn")
+func resourceEncoderConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEncoderConfigurationCreate,
+		ReadWithoutTimeout:   resourceEncoderConfigurationRead,
+		UpdateWithoutTimeout: resourceEncoderConfigurationUpdate,
+		DeleteWithoutTimeout: resourceEncoderConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"video": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bitrate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 8500000),
+						},
+						"framerate": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.FloatBetween(1, 60),
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 1920),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 1920),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEncoderConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	input := &ivsrealtime.CreateEncoderConfigurationInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("video"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Video = expandVideo(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateEncoderConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IVS Real-Time Encoder Configuration: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.EncoderConfiguration.Arn))
+
+	return append(diags, resourceEncoderConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceEncoderConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	encoderConfiguration, err := findEncoderConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Real-Time Encoder Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IVS Real-Time Encoder Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", encoderConfiguration.Arn)
+	d.Set("name", encoderConfiguration.Name)
+	if encoderConfiguration.Video != nil {
+		if err := d.Set("video", []interface{}{flattenVideo(encoderConfiguration.Video)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting video: %s", err)
+		}
+	} else {
+		d.Set("video", nil)
+	}
+
+	setTagsOut(ctx, encoderConfiguration.Tags)
+
+	return diags
+}
+
+func resourceEncoderConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceEncoderConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceEncoderConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IVS Real-Time Encoder Configuration: %s", d.Id())
+	_, err := conn.DeleteEncoderConfiguration(ctx, &ivsrealtime.DeleteEncoderConfigurationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IVS Real-Time Encoder Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEncoderConfigurationByARN(ctx context.Context, conn *ivsrealtime.Client, arn string) (*awstypes.EncoderConfiguration, error) {
+	input := &ivsrealtime.GetEncoderConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetEncoderConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EncoderConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EncoderConfiguration, nil
+}
+
+func expandVideo(tfMap map[string]interface{}) *awstypes.Video {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.Video{}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["framerate"].(float64); ok && v != 0 {
+		apiObject.Framerate = aws.Float32(float32(v))
+	}
+
+	if v, ok := tfMap["height"].(int); ok && v != 0 {
+		apiObject.Height = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["width"].(int); ok && v != 0 {
+		apiObject.Width = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenVideo(apiObject *awstypes.Video) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bitrate; v != nil {
+		tfMap["bitrate"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Framerate; v != nil {
+		// Format the float32 to its shortest decimal representation so that configured values are kept as written.
+		tfMap["framerate"], _ = strconv.ParseFloat(strconv.FormatFloat(float64(aws.ToFloat32(v)), 'f', -1, 32), 64)
+	}
+
+	if v := apiObject.Height; v != nil {
+		tfMap["height"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Width; v != nil {
+		tfMap["width"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
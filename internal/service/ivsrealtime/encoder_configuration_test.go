@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivsrealtime "github.com/hashicorp/terraform-provider-aws/internal/service/ivsrealtime"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSRealTimeEncoderConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexache.MustCompile(`encoder-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "video.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeEncoderConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivsrealtime.ResourceEncoderConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeEncoderConfiguration_video(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EncoderConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivsrealtime_encoder_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEncoderConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEncoderConfigurationConfig_video(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEncoderConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "video.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.bitrate", "2500000"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.framerate", "30"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.height", "720"),
+					resource.TestCheckResourceAttr(resourceName, "video.0.width", "1280"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEncoderConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivsrealtime_encoder_configuration" {
+				continue
+			}
+
+			_, err := tfivsrealtime.FindEncoderConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IVS Real-Time Encoder Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEncoderConfigurationExists(ctx context.Context, n string, v *awstypes.EncoderConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+		output, err := tfivsrealtime.FindEncoderConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEncoderConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivsrealtime_encoder_configuration" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEncoderConfigurationConfig_video(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivsrealtime_encoder_configuration" "test" {
+  name = %[1]q
+
+  video {
+    bitrate   = 2500000
+    framerate = 30
+    height    = 720
+    width     = 1280
+  }
+}
+`, rName)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+// Exports for use in tests only.
+var (
+	FindCompositionByARN          = findCompositionByARN
+	FindEncoderConfigurationByARN = findEncoderConfigurationByARN
+	FindStageByARN                = findStageByARN
+	FindStorageConfigurationByARN = findStorageConfigurationByARN
+
+	ResourceComposition          = resourceComposition
+	ResourceEncoderConfiguration = resourceEncoderConfiguration
+	ResourceStage                = resourceStage
+	ResourceStorageConfiguration = resourceStorageConfiguration
+)
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivsrealtime"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_ivsrealtime_participant_token", name="Participant Token")
+func dataSourceParticipantToken() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceParticipantTokenRead,
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.ParticipantTokenCapability](),
+				},
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 20160),
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"participant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stage_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+		},
+	}
+}
+
+func dataSourceParticipantTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	stageARN := d.Get("stage_arn").(string)
+	input := &ivsrealtime.CreateParticipantTokenInput{
+		StageArn: aws.String(stageARN),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Attributes = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.ParticipantTokenCapability](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("duration"); ok {
+		input.Duration = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("user_id"); ok {
+		input.UserId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateParticipantToken(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IVS Real-Time Stage (%s) Participant Token: %s", stageARN, err)
+	}
+
+	token := output.ParticipantToken
+	d.SetId(aws.ToString(token.ParticipantId))
+	d.Set("capabilities", token.Capabilities)
+	d.Set("duration", token.Duration)
+	if token.ExpirationTime != nil {
+		d.Set("expiration_time", aws.ToTime(token.ExpirationTime).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_time", nil)
+	}
+	d.Set("participant_id", token.ParticipantId)
+	d.Set("token", token.Token)
+
+	return diags
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSRealTimeParticipantTokenDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ivsrealtime_participant_token.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSRealTimeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParticipantTokenDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "capabilities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "capabilities.*", "SUBSCRIBE"),
+					resource.TestCheckResourceAttr(dataSourceName, "duration", "60"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "participant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage_arn", "aws_ivsrealtime_stage.test", "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_id", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccParticipantTokenDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivsrealtime_stage" "test" {
+  name = %[1]q
+}
+
+data "aws_ivsrealtime_participant_token" "test" {
+  stage_arn    = aws_ivsrealtime_stage.test.arn
+  capabilities = ["SUBSCRIBE"]
+  duration     = 60
+  user_id      = %[1]q
+
+  attributes = {
+    role = "viewer"
+  }
+}
+`, rName)
+}
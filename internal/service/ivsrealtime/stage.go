@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivsrealtime"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ivsrealtime_stage", name="Stage")
+// @Tags(identifierAttribute="arn")
+func resourceStage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStageCreate,
+		ReadWithoutTimeout:   resourceStageRead,
+		UpdateWithoutTimeout: resourceStageUpdate,
+		DeleteWithoutTimeout: resourceStageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_session_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	input := &ivsrealtime.CreateStageInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateStage(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IVS Real-Time Stage: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Stage.Arn))
+
+	return append(diags, resourceStageRead(ctx, d, meta)...)
+}
+
+func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	stage, err := findStageByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Real-Time Stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IVS Real-Time Stage (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_session_id", stage.ActiveSessionId)
+	d.Set("arn", stage.Arn)
+	d.Set("name", stage.Name)
+
+	setTagsOut(ctx, stage.Tags)
+
+	return diags
+}
+
+func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &ivsrealtime.UpdateStageInput{
+			Arn:  aws.String(d.Id()),
+			Name: aws.String(d.Get("name").(string)),
+		}
+
+		_, err := conn.UpdateStage(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IVS Real-Time Stage (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStageRead(ctx, d, meta)...)
+}
+
+func resourceStageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IVS Real-Time Stage: %s", d.Id())
+	_, err := conn.DeleteStage(ctx, &ivsrealtime.DeleteStageInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IVS Real-Time Stage (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStageByARN(ctx context.Context, conn *ivsrealtime.Client, arn string) (*awstypes.Stage, error) {
+	input := &ivsrealtime.GetStageInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetStage(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Stage == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Stage, nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ivsrealtime
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ivsrealtime"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ivsrealtime/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ivsrealtime_storage_configuration", name="Storage Configuration")
+// @Tags(identifierAttribute="arn")
+func resourceStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStorageConfigurationCreate,
+		ReadWithoutTimeout:   resourceStorageConfigurationRead,
+		UpdateWithoutTimeout: resourceStorageConfigurationUpdate,
+		DeleteWithoutTimeout: resourceStorageConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
+			},
+			"s3": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStorageConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	input := &ivsrealtime.CreateStorageConfigurationInput{
+		S3:   expandS3StorageConfiguration(d.Get("s3").([]interface{})[0].(map[string]interface{})),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateStorageConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IVS Real-Time Storage Configuration: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.StorageConfiguration.Arn))
+
+	return append(diags, resourceStorageConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceStorageConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	storageConfiguration, err := findStorageConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Real-Time Storage Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IVS Real-Time Storage Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", storageConfiguration.Arn)
+	d.Set("name", storageConfiguration.Name)
+	if storageConfiguration.S3 != nil {
+		if err := d.Set("s3", []interface{}{flattenS3StorageConfiguration(storageConfiguration.S3)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3: %s", err)
+		}
+	} else {
+		d.Set("s3", nil)
+	}
+
+	setTagsOut(ctx, storageConfiguration.Tags)
+
+	return diags
+}
+
+func resourceStorageConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceStorageConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceStorageConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IVSRealTimeClient(ctx)
+
+	log.Printf("[DEBUG] Deleting IVS Real-Time Storage Configuration: %s", d.Id())
+	_, err := conn.DeleteStorageConfiguration(ctx, &ivsrealtime.DeleteStorageConfigurationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IVS Real-Time Storage Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStorageConfigurationByARN(ctx context.Context, conn *ivsrealtime.Client, arn string) (*awstypes.StorageConfiguration, error) {
+	input := &ivsrealtime.GetStorageConfigurationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetStorageConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageConfiguration, nil
+}
+
+func expandS3StorageConfiguration(tfMap map[string]interface{}) *awstypes.S3StorageConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.S3StorageConfiguration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3StorageConfiguration(apiObject *awstypes.S3StorageConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BucketName; v != nil {
+		tfMap["bucket_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -512,23 +512,25 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	conn := meta.(*conns.AWSClient).KafkaConnectConn(ctx)
 
-	input := &kafkaconnect.UpdateConnectorInput{
-		Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
-		ConnectorArn:   aws.String(d.Id()),
-		CurrentVersion: aws.String(d.Get("version").(string)),
-	}
+	if d.HasChange("capacity") {
+		input := &kafkaconnect.UpdateConnectorInput{
+			Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+		}
 
-	log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
-	_, err := conn.UpdateConnectorWithContext(ctx, input)
+		log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
+		_, err := conn.UpdateConnectorWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating MSK Connect Connector (%s): %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MSK Connect Connector (%s): %s", d.Id(), err)
+		}
 
-	_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = waitConnectorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MSK Connect Connector (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceConnectorRead(ctx, d, meta)...)
@@ -27,6 +27,14 @@ func RegisterSweepers() {
 			"aws_mskconnect_connector",
 		},
 	})
+
+	resource.AddTestSweepers("aws_mskconnect_worker_configuration", &resource.Sweeper{
+		Name: "aws_mskconnect_worker_configuration",
+		F:    sweepWorkerConfigurations,
+		Dependencies: []string{
+			"aws_mskconnect_connector",
+		},
+	})
 }
 
 func sweepConnectors(region string) error {
@@ -116,3 +124,47 @@ func sweepCustomPlugins(region string) error {
 
 	return nil
 }
+
+func sweepWorkerConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.KafkaConnectConn(ctx)
+	input := &kafkaconnect.ListWorkerConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListWorkerConfigurationsPagesWithContext(ctx, input, func(page *kafkaconnect.ListWorkerConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkerConfigurations {
+			r := ResourceWorkerConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkerConfigurationArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MSK Connect Worker Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MSK Connect Worker Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MSK Connect Worker Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
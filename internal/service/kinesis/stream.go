@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -470,9 +471,18 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
 	name := d.Get("name").(string)
 
+	enforceConsumerDeletion := d.Get("enforce_consumer_deletion").(bool)
+
+	// Deregister any consumers up front so that the stream doesn't get stuck in DELETING.
+	if enforceConsumerDeletion {
+		if err := deregisterStreamConsumers(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Kinesis Stream (%s): %s", name, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Kinesis Stream: (%s)", name)
 	_, err := conn.DeleteStream(ctx, &kinesis.DeleteStreamInput{
-		EnforceConsumerDeletion: aws.Bool(d.Get("enforce_consumer_deletion").(bool)),
+		EnforceConsumerDeletion: aws.Bool(enforceConsumerDeletion),
 		StreamName:              aws.String(name),
 	})
 
@@ -480,6 +490,15 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if errs.IsA[*types.ResourceInUseException](err) && !enforceConsumerDeletion {
+		if consumers, err := findStreamConsumers(ctx, conn, &kinesis.ListStreamConsumersInput{StreamARN: aws.String(d.Id())}, tfslices.PredicateTrue[*types.Consumer]()); err == nil && len(consumers) > 0 {
+			consumerNames := tfslices.ApplyToAll(consumers, func(v types.Consumer) string {
+				return aws.ToString(v.ConsumerName)
+			})
+			return sdkdiag.AppendErrorf(diags, "deleting Kinesis Stream (%s): stream has registered consumers (%s), set enforce_consumer_deletion to deregister them", name, strings.Join(consumerNames, ", "))
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Stream (%s): %s", name, err)
 	}
@@ -491,6 +510,46 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func deregisterStreamConsumers(ctx context.Context, conn *kinesis.Client, streamARN string) error {
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamARN),
+	}
+	consumers, err := findStreamConsumers(ctx, conn, input, tfslices.PredicateTrue[*types.Consumer]())
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing consumers: %w", err)
+	}
+
+	for _, v := range consumers {
+		arn := aws.ToString(v.ConsumerARN)
+
+		if v.ConsumerStatus != types.ConsumerStatusDeleting {
+			log.Printf("[DEBUG] Deregistering Kinesis Stream Consumer: (%s)", arn)
+			_, err := conn.DeregisterStreamConsumer(ctx, &kinesis.DeregisterStreamConsumerInput{
+				ConsumerARN: aws.String(arn),
+			})
+
+			if errs.IsA[*types.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deregistering consumer (%s): %w", arn, err)
+			}
+		}
+
+		if _, err := waitStreamConsumerDeleted(ctx, conn, arn); err != nil {
+			return fmt.Errorf("waiting for consumer (%s) delete: %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceStreamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).KinesisClient(ctx)
 
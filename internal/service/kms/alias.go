@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -64,6 +65,12 @@ func ResourceAlias() *schema.Resource {
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentKeyARNOrID,
 			},
+
+			"verify_target_key_compatibility": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -139,6 +146,14 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).KMSConn(ctx)
 
 	if d.HasChange("target_key_id") {
+		if d.Get("verify_target_key_compatibility").(bool) {
+			o, n := d.GetChange("target_key_id")
+
+			if err := verifyAliasTargetKeysCompatible(ctx, conn, o.(string), n.(string)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating KMS Alias (%s): %s", d.Id(), err)
+			}
+		}
+
 		input := &kms.UpdateAliasInput{
 			AliasName:   aws.String(d.Id()),
 			TargetKeyId: aws.String(d.Get("target_key_id").(string)),
@@ -175,6 +190,46 @@ func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// verifyAliasTargetKeysCompatible returns an error if the new alias target key
+// doesn't have the same key spec and key usage as the current target key.
+// The current target key is described directly, as it may be pending deletion if it is being replaced.
+func verifyAliasTargetKeysCompatible(ctx context.Context, conn *kms.KMS, oldKeyID, newKeyID string) error {
+	output, err := conn.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(oldKeyID),
+	})
+
+	// The current target key no longer exists, so there is nothing to compare against.
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading KMS Key (%s): %w", oldKeyID, err)
+	}
+
+	if output == nil || output.KeyMetadata == nil {
+		return nil
+	}
+
+	oldKey := output.KeyMetadata
+
+	newKey, err := FindKeyByID(ctx, conn, newKeyID)
+
+	if err != nil {
+		return fmt.Errorf("reading KMS Key (%s): %w", newKeyID, err)
+	}
+
+	if o, n := aws.StringValue(oldKey.KeySpec), aws.StringValue(newKey.KeySpec); o != n {
+		return fmt.Errorf("target KMS Key (%s) key spec (%s) does not match current target KMS Key (%s) key spec (%s)", newKeyID, n, oldKeyID, o)
+	}
+
+	if o, n := aws.StringValue(oldKey.KeyUsage), aws.StringValue(newKey.KeyUsage); o != n {
+		return fmt.Errorf("target KMS Key (%s) key usage (%s) does not match current target KMS Key (%s) key usage (%s)", newKeyID, n, oldKeyID, o)
+	}
+
+	return nil
+}
+
 func suppressEquivalentKeyARNOrID(k, old, new string, d *schema.ResourceData) bool {
 	return KeyARNOrIDEqual(old, new)
 }
@@ -45,9 +45,10 @@ func TestAccKMSAlias_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
 			},
 		},
 	})
@@ -98,9 +99,10 @@ func TestAccKMSAlias_Name_generated(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
 			},
 		},
 	})
@@ -127,9 +129,10 @@ func TestAccKMSAlias_namePrefix(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
 			},
 		},
 	})
@@ -166,9 +169,79 @@ func TestAccKMSAlias_updateKeyID(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
+			},
+		},
+	})
+}
+
+func TestAccKMSAlias_verifyTargetKeyCompatibility(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias kms.AliasListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_alias.test"
+	key1ResourceName := "aws_kms_key.test"
+	key2ResourceName := "aws_kms_key.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_verifyTargetKeyCompatibility(rName, "aws_kms_key.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "target_key_id", key1ResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "verify_target_key_compatibility", "true"),
+				),
+			},
+			{
+				Config:      testAccAliasConfig_verifyTargetKeyCompatibility(rName, "aws_kms_key.test3.id"),
+				ExpectError: regexache.MustCompile(`key spec \(RSA_2048\) does not match current target KMS Key`),
+			},
+			{
+				Config: testAccAliasConfig_verifyTargetKeyCompatibility(rName, "aws_kms_key.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "target_key_id", key2ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSAlias_verifyTargetKeyCompatibilityKeyReplaced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias kms.AliasListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_alias.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_verifyTargetKeyCompatibilityKeyReplaced(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "target_key_id", keyResourceName, "id"),
+				),
+			},
+			{
+				// The current target key is pending deletion when the alias is repointed.
+				Config: testAccAliasConfig_verifyTargetKeyCompatibilityKeyReplaced(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "target_key_id", keyResourceName, "id"),
+				),
 			},
 		},
 	})
@@ -200,9 +273,10 @@ func TestAccKMSAlias_multipleAliasesForSameKey(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
 			},
 		},
 	})
@@ -228,9 +302,10 @@ func TestAccKMSAlias_arnDiffSuppress(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_target_key_compatibility"},
 			},
 			{
 				ExpectNonEmptyPlan: false,
@@ -352,6 +427,51 @@ resource "aws_kms_alias" "test" {
 `, rName)
 }
 
+func testAccAliasConfig_verifyTargetKeyCompatibility(rName, targetKeyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test2" {
+  description             = "%[1]s-2"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test3" {
+  description              = "%[1]s-3"
+  deletion_window_in_days  = 7
+  customer_master_key_spec = "RSA_2048"
+  key_usage                = "ENCRYPT_DECRYPT"
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = %[2]s
+
+  verify_target_key_compatibility = true
+}
+`, rName, targetKeyID)
+}
+
+func testAccAliasConfig_verifyTargetKeyCompatibilityKeyReplaced(rName string, multiRegion bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = %[2]t
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.id
+
+  verify_target_key_compatibility = true
+}
+`, rName, multiRegion)
+}
+
 func testAccAliasConfig_multiple(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
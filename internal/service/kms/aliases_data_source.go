@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_kms_aliases")
+func DataSourceAliases() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateKeyOrAlias,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"target_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	keyID := d.Get("key_id").(string)
+
+	// ListAliases only accepts a key ID or key ARN, so resolve any alias to its target key first.
+	keyMetadata, err := FindKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	targetKeyID := aws.StringValue(keyMetadata.KeyId)
+
+	aliases, err := FindAliasesByTargetKeyID(ctx, conn, targetKeyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Aliases for KMS Key (%s): %s", keyID, err)
+	}
+
+	var arns, names []string
+
+	for _, alias := range aliases {
+		arns = append(arns, aws.StringValue(alias.AliasArn))
+		names = append(names, aws.StringValue(alias.AliasName))
+	}
+
+	d.SetId(aws.StringValue(keyMetadata.Arn))
+	d.Set("arns", arns)
+	d.Set("names", names)
+	d.Set("target_key_arn", keyMetadata.Arn)
+	d.Set("target_key_id", targetKeyID)
+
+	return diags
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSAliasesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_aliases.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kms_alias.test1", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "arns.*", "aws_kms_alias.test2", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", fmt.Sprintf("alias/%s-1", rName)),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", fmt.Sprintf("alias/%s-2", rName)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_key_arn", keyResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_key_id", keyResourceName, "key_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliasesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "other" {
+  description             = "%[1]s-other"
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test1" {
+  name          = "alias/%[1]s-1"
+  target_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_kms_alias" "test2" {
+  name          = "alias/%[1]s-2"
+  target_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_kms_alias" "other" {
+  name          = "alias/%[1]s-other"
+  target_key_id = aws_kms_key.other.key_id
+}
+
+data "aws_kms_aliases" "test" {
+  key_id = aws_kms_key.test.arn
+
+  depends_on = [aws_kms_alias.test1, aws_kms_alias.test2, aws_kms_alias.other]
+}
+`, rName)
+}
@@ -46,6 +46,40 @@ func FindAliasByName(ctx context.Context, conn *kms.KMS, name string) (*kms.Alia
 	return output, nil
 }
 
+func FindAliasesByTargetKeyID(ctx context.Context, conn *kms.KMS, keyID string) ([]*kms.AliasListEntry, error) {
+	input := &kms.ListAliasesInput{
+		KeyId: aws.String(keyID),
+	}
+	var output []*kms.AliasListEntry
+
+	err := conn.ListAliasesPagesWithContext(ctx, input, func(page *kms.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindCustomKeyStoreByID(ctx context.Context, conn *kms.KMS, in *kms.DescribeCustomKeyStoresInput) (*kms.CustomKeyStoresListEntry, error) {
 	out, err := conn.DescribeCustomKeyStoresWithContext(ctx, in)
 
@@ -10,10 +10,13 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -21,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -44,7 +49,10 @@ func ResourceKey() *schema.Resource {
 			Create: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceKeyCustomizeDiffSimulatePolicyLockout,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -119,6 +127,11 @@ func ResourceKey() *schema.Resource {
 					return json
 				},
 			},
+			"simulate_policy_lockout_safety_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"xks_key_id": {
@@ -348,6 +361,121 @@ type kmsKey struct {
 	tags     []*kms.Tag
 }
 
+// resourceKeyCustomizeDiffSimulatePolicyLockout uses the IAM policy simulator to check,
+// at plan time, that a new key policy still allows the caller to update the key policy.
+// The check is opt-in via simulate_policy_lockout_safety_check and is skipped when
+// bypass_policy_lockout_safety_check is set.
+func resourceKeyCustomizeDiffSimulatePolicyLockout(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("simulate_policy_lockout_safety_check").(bool) || d.Get("bypass_policy_lockout_safety_check").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("policy") {
+		return nil
+	}
+
+	if !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy := d.Get("policy").(string)
+
+	if policy == "" {
+		return nil
+	}
+
+	client := meta.(*conns.AWSClient)
+
+	principalARN, err := keyPolicySimulationPrincipal(ctx, client)
+
+	if err != nil {
+		return fmt.Errorf("simulating KMS Key policy: %w", err)
+	}
+
+	if principalARN == "" {
+		return nil
+	}
+
+	resourceARN := d.Get("arn").(string)
+
+	if !d.NewValueKnown("arn") || resourceARN == "" {
+		resourceARN = arn.ARN{
+			Partition: client.Partition,
+			Service:   kms.ServiceName,
+			Region:    client.Region,
+			AccountID: client.AccountID,
+			Resource:  "key/00000000-0000-0000-0000-000000000000",
+		}.String()
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     aws.StringSlice([]string{"kms:PutKeyPolicy"}),
+		CallerArn:       aws.String(principalARN),
+		PolicySourceArn: aws.String(principalARN),
+		ResourceArns:    aws.StringSlice([]string{resourceARN}),
+		ResourceOwner: aws.String(arn.ARN{
+			Partition: client.Partition,
+			Service:   iam.ServiceName,
+			AccountID: client.AccountID,
+			Resource:  "root",
+		}.String()),
+		ResourcePolicy: aws.String(policy),
+	}
+
+	output, err := client.IAMConn(ctx).SimulatePrincipalPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("simulating KMS Key policy for %s: %w", principalARN, err)
+	}
+
+	for _, v := range output.EvaluationResults {
+		if v == nil {
+			continue
+		}
+
+		if decision := aws.StringValue(v.EvalDecision); decision != iam.PolicyEvaluationDecisionTypeAllowed {
+			return fmt.Errorf("the new key policy would prevent %s from updating the key policy in the future (kms:PutKeyPolicy evaluation: %s)", principalARN, decision)
+		}
+	}
+
+	return nil
+}
+
+// keyPolicySimulationPrincipal returns the ARN of the IAM principal that the provider is running as.
+// Assumed role sessions are resolved to their role.
+// An empty string is returned for principals that can't be simulated, such as the account root user.
+func keyPolicySimulationPrincipal(ctx context.Context, client *conns.AWSClient) (string, error) {
+	output, err := tfsts.FindCallerIdentity(ctx, client.STSClient(ctx))
+
+	if err != nil {
+		return "", fmt.Errorf("reading caller identity: %w", err)
+	}
+
+	callerARN := aws.StringValue(output.Arn)
+
+	if roleName, _ := tfiam.RoleNameSessionFromARN(callerARN); roleName != "" {
+		role, err := tfiam.FindRoleByName(ctx, client.IAMConn(ctx), roleName)
+
+		if err != nil {
+			return "", fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+		}
+
+		return aws.StringValue(role.Arn), nil
+	}
+
+	parsedARN, err := arn.Parse(callerARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	if parsedARN.Service != iam.ServiceName || parsedARN.Resource == "root" {
+		return "", nil
+	}
+
+	return callerARN, nil
+}
+
 func findKey(ctx context.Context, conn *kms.KMS, keyID string, isNewResource bool) (*kmsKey, error) {
 	// Wait for propagation since KMS is eventually consistent.
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, PropagationTimeout, func() (interface{}, error) {
@@ -47,7 +47,7 @@ func TestAccKMSKey_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 			{
 				// Set deletion window to 7 days
@@ -107,7 +107,7 @@ func TestAccKMSKey_multiRegion(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 		},
 	})
@@ -185,7 +185,7 @@ func TestAccKMSKey_Policy_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 			{
 				Config: testAccKeyConfig_removedPolicy(rName),
@@ -224,7 +224,63 @@ func TestAccKMSKey_Policy_bypass(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_Policy_simulateLockout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyConfig_policySimulateLockout(rName, "kms:DescribeKey", false),
+				ExpectError: regexache.MustCompile(`the new key policy would prevent .* from updating the key policy in the future`),
+			},
+			{
+				Config: testAccKeyConfig_policySimulateLockout(rName, "kms:*", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "simulate_policy_lockout_safety_check", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
+			},
+			{
+				Config:      testAccKeyConfig_policySimulateLockout(rName, "kms:DescribeKey", false),
+				ExpectError: regexache.MustCompile(`the new key policy would prevent .* from updating the key policy in the future`),
+			},
+		},
+	})
+}
+
+func TestAccKMSKey_Policy_simulateLockoutBypass(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccKeyConfig_policySimulateLockout(rName, "kms:DescribeKey", true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
@@ -282,7 +338,7 @@ func TestAccKMSKey_Policy_iamRole(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 		},
 	})
@@ -383,7 +439,7 @@ func TestAccKMSKey_Policy_iamServiceLinkedRole(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 		},
 	})
@@ -435,7 +491,7 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 			{
 				Config: testAccKeyConfig_disabled(rName),
@@ -481,7 +537,7 @@ func TestAccKMSKey_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 			{
 				Config: testAccKeyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -511,7 +567,7 @@ func TestAccKMSKey_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check", "simulate_policy_lockout_safety_check"},
 			},
 		},
 	})
@@ -822,6 +878,40 @@ resource "aws_kms_key" "test" {
 `, rName, bypassFlag)
 }
 
+func testAccKeyConfig_policySimulateLockout(rName, action string, bypassFlag bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  bypass_policy_lockout_safety_check   = %[3]t
+  simulate_policy_lockout_safety_check = true
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [
+      {
+        Action = %[2]q
+        Effect = "Allow"
+        Principal = {
+          AWS = data.aws_iam_session_context.current.issuer_arn
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+`, rName, action, bypassFlag)
+}
+
 func testAccKeyConfig_policyIAMRole(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
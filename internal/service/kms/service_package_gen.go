@@ -29,6 +29,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceAlias,
 			TypeName: "aws_kms_alias",
 		},
+		{
+			Factory:  DataSourceAliases,
+			TypeName: "aws_kms_aliases",
+		},
 		{
 			Factory:  DataSourceCiphertext,
 			TypeName: "aws_kms_ciphertext",
@@ -15,6 +15,8 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -35,6 +37,10 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 )
 
+var (
+	ecrImageURITagRegex = regexache.MustCompile(`^(\d{12})\.dkr\.ecr(?:-fips)?\.[0-9a-z-]+\.amazonaws\.com(?:\.cn)?/([^:@]+):([^:@]+)$`)
+)
+
 const (
 	FunctionVersionLatest = "$LATEST"
 	mutexKey              = `aws_lambda_function`
@@ -205,6 +211,10 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -293,6 +303,12 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"redeploy_on_digest_change": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"image_uri"},
+			},
 			"replace_security_groups_on_destroy": {
 				Deprecated: "AWS no longer supports this operation. This attribute now has " +
 					"no effect and will be removed in a future major version.",
@@ -458,6 +474,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateImageDigestOnRedeploy,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -677,6 +694,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting image_config: %s", err)
 	}
 	if output.Code != nil {
+		d.Set("image_digest", imageDigestFromResolvedImageURI(aws.ToString(output.Code.ResolvedImageUri)))
 		d.Set("image_uri", output.Code.ImageUri)
 	}
 	d.Set("invoke_arn", functionInvokeARN(functionARN, meta))
@@ -700,6 +718,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
+	d.Set("redeploy_on_digest_change", d.Get("redeploy_on_digest_change"))
 	d.Set("skip_destroy", d.Get("skip_destroy"))
 	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
@@ -1266,6 +1285,45 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateImageDigestOnRedeploy resolves the digest currently referenced by a mutable ECR image tag.
+// If it differs from the digest recorded in state the function code is redeployed.
+// A changed image URI always resolves to a new digest on apply.
+func updateImageDigestOnRedeploy(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("image_uri") {
+		return d.SetNewComputed("image_digest")
+	}
+
+	if !d.Get("redeploy_on_digest_change").(bool) {
+		return nil
+	}
+
+	imageURI := d.Get("image_uri").(string)
+	registryID, repositoryName, imageTag, ok := parseECRImageURITag(imageURI)
+	if !ok {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	digest, err := findImageDigestByTag(ctx, conn, registryID, repositoryName, imageTag)
+
+	if err != nil {
+		return fmt.Errorf("resolving ECR image (%s) digest: %w", imageURI, err)
+	}
+
+	if digest != d.Get("image_digest").(string) {
+		if err := d.SetNew("image_digest", digest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1290,6 +1348,7 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
 		d.HasChange("image_uri") ||
+		d.HasChange("image_digest") ||
 		d.HasChange("architectures")
 }
 
@@ -1327,6 +1386,59 @@ func readFileContents(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+// parseECRImageURITag returns the registry ID, repository name and tag of an ECR image URI that references a tag.
+// URIs that reference an image by digest, or that do not point at a private ECR registry, are not parsed.
+func parseECRImageURITag(imageURI string) (string, string, string, bool) {
+	m := ecrImageURITagRegex.FindStringSubmatch(imageURI)
+	if m == nil {
+		return "", "", "", false
+	}
+
+	return m[1], m[2], m[3], true
+}
+
+// imageDigestFromResolvedImageURI returns the digest part of a resolved image URI.
+func imageDigestFromResolvedImageURI(resolvedImageURI string) string {
+	if _, digest, ok := strings.Cut(resolvedImageURI, "@"); ok {
+		return digest
+	}
+
+	return ""
+}
+
+func findImageDigestByTag(ctx context.Context, conn *ecr.Client, registryID, repositoryName, imageTag string) (string, error) {
+	input := &ecr.DescribeImagesInput{
+		ImageIds: []ecrtypes.ImageIdentifier{{
+			ImageTag: aws.String(imageTag),
+		}},
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	output, err := conn.DescribeImages(ctx, input)
+
+	if errs.IsA[*ecrtypes.ImageNotFoundException](err) || errs.IsA[*ecrtypes.RepositoryNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || len(output.ImageDetails) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ImageDetails); count > 1 {
+		return "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return aws.ToString(output.ImageDetails[0].ImageDigest), nil
+}
+
 func functionInvokeARN(functionARN string, meta interface{}) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -1033,6 +1033,7 @@ func TestAccLambdaFunction_image(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFunctionExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttr(resourceName, "image_uri", imageV1ID),
+					resource.TestMatchResourceAttr(resourceName, "image_digest", regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)),
 				),
 			},
 			// Ensure lambda image config can be updated
@@ -1048,6 +1049,43 @@ func TestAccLambdaFunction_image(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_imageRedeployOnDigestChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_LAMBDA_IMAGE_LATEST_ID"
+	imageLatestID := os.Getenv(key)
+	if imageLatestID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_imageRedeployOnDigestChange(rName, imageLatestID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "image_uri", imageLatestID),
+					resource.TestMatchResourceAttr(resourceName, "image_digest", regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "redeploy_on_digest_change", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish", "redeploy_on_digest_change"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_architectures(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3307,6 +3345,21 @@ resource "aws_lambda_function" "test" {
 `, imageID, rName))
 }
 
+func testAccFunctionConfig_imageRedeployOnDigestChange(rName, imageID string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  image_uri                 = %[1]q
+  function_name             = %[2]q
+  role                      = aws_iam_role.iam_for_lambda.arn
+  package_type              = "Image"
+  publish                   = true
+  redeploy_on_digest_change = true
+}
+`, imageID, rName))
+}
+
 func testAccFunctionConfig_architecturesARM64(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Bot Locale Build")
+func newResourceBotLocaleBuild(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotLocaleBuild{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotLocaleBuild = "Bot Locale Build"
+)
+
+type resourceBotLocaleBuild struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[resourceBotLocaleBuildData]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *resourceBotLocaleBuild) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_bot_locale_build"
+}
+
+func (r *resourceBotLocaleBuild) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_locale_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotLocaleStatus](),
+				Computed:   true,
+			},
+			// Only the DRAFT version of a bot locale can be built.
+			"bot_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("DRAFT"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_build_submitted_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"locale_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBotLocaleBuild) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotLocaleBuildData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.BuildBotLocaleInput{
+		BotId:      aws.String(plan.BotID.ValueString()),
+		BotVersion: aws.String(plan.BotVersion.ValueString()),
+		LocaleId:   aws.String(plan.LocaleID.ValueString()),
+	}
+
+	out, err := conn.BuildBotLocale(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocaleBuild, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocaleBuild, plan.LocaleID.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	// The ID matches that of the corresponding aws_lexv2models_bot_locale.
+	idParts := []string{
+		aws.ToString(out.LocaleId),
+		aws.ToString(out.BotId),
+		aws.ToString(out.BotVersion),
+	}
+	id, err := intflex.FlattenResourceId(idParts, botLocaleIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocaleBuild, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	waitOut, err := waitBotLocaleBuilt(ctx, conn, plan.ID.ValueString(), r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotLocaleBuild, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.BotLocaleStatus = fwtypes.StringEnumValue(waitOut.BotLocaleStatus)
+	plan.LastBuildSubmittedDateTime = timetypes.NewRFC3339TimePointerValue(waitOut.LastBuildSubmittedDateTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotLocaleBuild) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotLocaleBuildData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindBotLocaleByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotLocaleBuild, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// A locale that is modified after it is built reverts to NotBuilt. Remove the build from state so it is rebuilt.
+	if out.BotLocaleStatus == awstypes.BotLocaleStatusNotBuilt {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.BotID = flex.StringToFramework(ctx, out.BotId)
+	state.BotLocaleStatus = fwtypes.StringEnumValue(out.BotLocaleStatus)
+	state.BotVersion = flex.StringToFramework(ctx, out.BotVersion)
+	state.LastBuildSubmittedDateTime = timetypes.NewRFC3339TimePointerValue(out.LastBuildSubmittedDateTime)
+	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotLocaleStatusBuilding, awstypes.BotLocaleStatusNotBuilt, awstypes.BotLocaleStatusReadyExpressTesting),
+		Target:                    enum.Slice(awstypes.BotLocaleStatusBuilt),
+		Refresh:                   statusBotLocale(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+type resourceBotLocaleBuildData struct {
+	BotID                      types.String                                 `tfsdk:"bot_id"`
+	BotLocaleStatus            fwtypes.StringEnum[awstypes.BotLocaleStatus] `tfsdk:"bot_locale_status"`
+	BotVersion                 types.String                                 `tfsdk:"bot_version"`
+	ID                         types.String                                 `tfsdk:"id"`
+	LastBuildSubmittedDateTime timetypes.RFC3339                            `tfsdk:"last_build_submitted_date_time"`
+	LocaleID                   types.String                                 `tfsdk:"locale_id"`
+	Triggers                   types.Map                                    `tfsdk:"triggers"`
+	Timeouts                   timeouts.Value                               `tfsdk:"timeouts"`
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotLocaleBuild_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale_build.test"
+	botLocaleResourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Builds are not deleted. The bot locale is destroyed with the bot.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleBuildConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botLocaleResourceName, "bot_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_locale_status", "Built"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", botLocaleResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_build_submitted_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "locale_id", botLocaleResourceName, "locale_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "triggers"},
+			},
+			{
+				Config: testAccBotLocaleBuildConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bot_locale_status", "Built"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.redeployment", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBotLocaleBuildConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(
+		testAccIntentConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  name        = %[1]q
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  sample_utterance {
+    utterance = "hello"
+  }
+}
+
+resource "aws_lexv2models_bot_locale_build" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+
+  triggers = {
+    redeployment = %[2]q
+  }
+
+  depends_on = [aws_lexv2models_intent.test]
+}
+`, rName, trigger))
+}
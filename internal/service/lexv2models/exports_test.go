@@ -5,12 +5,17 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newResourceBot
-	ResourceBotLocale  = newResourceBotLocale
-	ResourceBotVersion = newResourceBotVersion
-	ResourceIntent     = newResourceIntent
-	ResourceSlot       = newResourceSlot
-	ResourceSlotType   = newResourceSlotType
+	ResourceBot            = newResourceBot
+	ResourceBotLocale      = newResourceBotLocale
+	ResourceBotLocaleBuild = newResourceBotLocaleBuild
+	ResourceBotReplica     = newResourceBotReplica
+	ResourceBotVersion     = newResourceBotVersion
+	ResourceIntent         = newResourceIntent
+	ResourceSlot           = newResourceSlot
+	ResourceSlotType       = newResourceSlotType
+	ResourceTestSet        = newResourceTestSet
 
-	FindSlotByID = findSlotByID
+	FindBotReplicaByID = findBotReplicaByID
+	FindSlotByID       = findSlotByID
+	FindTestSetByID    = findTestSetByID
 )
@@ -31,6 +31,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceBotLocale,
 			Name:    "Bot Locale",
 		},
+		{
+			Factory: newResourceBotLocaleBuild,
+			Name:    "Bot Locale Build",
+		},
+		{
+			Factory: newResourceBotReplica,
+			Name:    "Bot Replica",
+		},
 		{
 			Factory: newResourceBotVersion,
 			Name:    "Bot Version",
@@ -47,6 +55,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceSlotType,
 			Name:    "Slot Type",
 		},
+		{
+			Factory: newResourceTestSet,
+			Name:    "Test Set",
+		},
 	}
 }
 
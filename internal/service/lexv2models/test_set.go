@@ -0,0 +1,558 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Test Set")
+func newResourceTestSet(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTestSet{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameTestSet = "Test Set"
+)
+
+type resourceTestSet struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceTestSet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_test_set"
+}
+
+func (r *resourceTestSet) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"modality": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetModality](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_turns": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetStatus](),
+				Computed:   true,
+			},
+			"test_set_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_set_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"generation_data_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetGenerationDataSourceData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"conversation_logs_data_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conversationLogsDataSourceData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_alias_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"locale_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"filter": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[conversationLogsDataSourceFilterByData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"end_time": schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+												"input_mode": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ConversationLogsInputModeFilter](),
+													Required:   true,
+												},
+												"start_time": schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"storage_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetStorageLocationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_key_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"s3_bucket_name": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"s3_path": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceTestSet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceTestSetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test sets are created by generating them from a bot's conversation logs.
+	in := &lexmodelsv2.StartTestSetGenerationInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, &plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.StartTestSetGeneration(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestSet, plan.TestSetName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.TestSetGenerationId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestSet, plan.TestSetName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	generationID := aws.ToString(out.TestSetGenerationId)
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+
+	generation, err := waitTestSetGenerationReady(ctx, conn, generationID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameTestSet, generationID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, generation.TestSetId)
+
+	testSet, err := waitTestSetReady(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameTestSet, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, testSet)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTestSet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestSetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTestSetByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTestSet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan, state resourceTestSetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.TestSetName.Equal(state.TestSetName) {
+		in := &lexmodelsv2.UpdateTestSetInput{
+			Description: flex.StringFromFramework(ctx, plan.Description),
+			TestSetId:   flex.StringFromFramework(ctx, plan.ID),
+			TestSetName: flex.StringFromFramework(ctx, plan.TestSetName),
+		}
+
+		_, err := conn.UpdateTestSet(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameTestSet, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTestSet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestSetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteTestSetInput{
+		TestSetId: aws.String(state.ID.ValueString()),
+	}
+
+	_, err := conn.DeleteTestSet(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = waitTestSetDeleted(ctx, conn, state.ID.ValueString(), r.DeleteTimeout(ctx, state.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitTestSetGenerationReady(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetGenerationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.TestSetGenerationStatusPending, awstypes.TestSetGenerationStatusGenerating),
+		Target:                    enum.Slice(awstypes.TestSetGenerationStatusReady),
+		Refresh:                   statusTestSetGeneration(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestSetGenerationOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTestSetReady(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.TestSetStatusImporting),
+		Target:         enum.Slice(awstypes.TestSetStatusReady, awstypes.TestSetStatusPendingAnnotation),
+		Refresh:        statusTestSet(ctx, conn, id),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTestSetDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TestSetStatusDeleting),
+		Target:  []string{},
+		Refresh: statusTestSet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusTestSetGeneration(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTestSetGenerationByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.TestSetGenerationStatus), nil
+	}
+}
+
+func statusTestSet(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTestSetByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findTestSetGenerationByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestSetGenerationOutput, error) {
+	in := &lexmodelsv2.DescribeTestSetGenerationInput{
+		TestSetGenerationId: aws.String(id),
+	}
+
+	out, err := conn.DescribeTestSetGeneration(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findTestSetByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	in := &lexmodelsv2.DescribeTestSetInput{
+		TestSetId: aws.String(id),
+	}
+
+	out, err := conn.DescribeTestSet(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.TestSetId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// refreshFromOutput sets the computed attributes from the API response.
+// The generation data source is not returned by DescribeTestSet and is left as configured.
+func (rd *resourceTestSetData) refreshFromOutput(ctx context.Context, out *lexmodelsv2.DescribeTestSetOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rd.Description = flex.StringToFramework(ctx, out.Description)
+	rd.Modality = fwtypes.StringEnumValue(out.Modality)
+	rd.NumTurns = flex.Int32ToFramework(ctx, out.NumTurns)
+	rd.RoleARN = flex.StringToFrameworkARN(ctx, out.RoleArn)
+	rd.Status = fwtypes.StringEnumValue(out.Status)
+	rd.TestSetID = flex.StringToFramework(ctx, out.TestSetId)
+	rd.TestSetName = flex.StringToFramework(ctx, out.TestSetName)
+
+	var storageLocation testSetStorageLocationData
+	diags.Append(flex.Flatten(ctx, out.StorageLocation, &storageLocation)...)
+	if diags.HasError() {
+		return diags
+	}
+	rd.StorageLocation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &storageLocation)
+
+	return diags
+}
+
+type resourceTestSetData struct {
+	Description          types.String                                                     `tfsdk:"description"`
+	GenerationDataSource fwtypes.ListNestedObjectValueOf[testSetGenerationDataSourceData] `tfsdk:"generation_data_source"`
+	ID                   types.String                                                     `tfsdk:"id"`
+	Modality             fwtypes.StringEnum[awstypes.TestSetModality]                     `tfsdk:"modality"`
+	NumTurns             types.Int64                                                      `tfsdk:"num_turns"`
+	RoleARN              fwtypes.ARN                                                      `tfsdk:"role_arn"`
+	Status               fwtypes.StringEnum[awstypes.TestSetStatus]                       `tfsdk:"status"`
+	StorageLocation      fwtypes.ListNestedObjectValueOf[testSetStorageLocationData]      `tfsdk:"storage_location"`
+	TestSetID            types.String                                                     `tfsdk:"test_set_id"`
+	TestSetName          types.String                                                     `tfsdk:"test_set_name"`
+	Timeouts             timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type testSetGenerationDataSourceData struct {
+	ConversationLogsDataSource fwtypes.ListNestedObjectValueOf[conversationLogsDataSourceData] `tfsdk:"conversation_logs_data_source"`
+}
+
+type conversationLogsDataSourceData struct {
+	BotAliasID types.String                                                            `tfsdk:"bot_alias_id"`
+	BotID      types.String                                                            `tfsdk:"bot_id"`
+	Filter     fwtypes.ListNestedObjectValueOf[conversationLogsDataSourceFilterByData] `tfsdk:"filter"`
+	LocaleID   types.String                                                            `tfsdk:"locale_id"`
+}
+
+type conversationLogsDataSourceFilterByData struct {
+	EndTime   timetypes.RFC3339                                            `tfsdk:"end_time"`
+	InputMode fwtypes.StringEnum[awstypes.ConversationLogsInputModeFilter] `tfsdk:"input_mode"`
+	StartTime timetypes.RFC3339                                            `tfsdk:"start_time"`
+}
+
+type testSetStorageLocationData struct {
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3Path       types.String `tfsdk:"s3_path"`
+}
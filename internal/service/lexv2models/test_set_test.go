@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Test sets are generated from conversation logs, so the bot alias must already have logged conversations.
+const (
+	envVarTestSetBotID      = "LEXV2_TEST_SET_BOT_ID"
+	envVarTestSetBotAliasID = "LEXV2_TEST_SET_BOT_ALIAS_ID"
+)
+
+func TestAccLexV2ModelsTestSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	botID := acctest.SkipIfEnvVarNotSet(t, envVarTestSetBotID)
+	botAliasID := acctest.SkipIfEnvVarNotSet(t, envVarTestSetBotAliasID)
+
+	var testset lexmodelsv2.DescribeTestSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_test_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTestSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestSetConfig_basic(rName, rName, botID, botAliasID, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestSetExists(ctx, resourceName, &testset),
+					resource.TestCheckResourceAttr(resourceName, "description", "initial"),
+					resource.TestCheckResourceAttr(resourceName, "generation_data_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "generation_data_source.0.conversation_logs_data_source.0.bot_id", botID),
+					resource.TestCheckResourceAttrSet(resourceName, "modality"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "storage_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_location.0.s3_bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "test_set_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "test_set_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generation_data_source", "timeouts"},
+			},
+			{
+				Config: testAccTestSetConfig_basic(rName, rNameUpdated, botID, botAliasID, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestSetExists(ctx, resourceName, &testset),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "test_set_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsTestSet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	botID := acctest.SkipIfEnvVarNotSet(t, envVarTestSetBotID)
+	botAliasID := acctest.SkipIfEnvVarNotSet(t, envVarTestSetBotAliasID)
+
+	var testset lexmodelsv2.DescribeTestSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_test_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTestSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestSetConfig_basic(rName, rName, botID, botAliasID, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestSetExists(ctx, resourceName, &testset),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceTestSet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTestSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_test_set" {
+				continue
+			}
+
+			_, err := tflexv2models.FindTestSetByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameTestSet, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTestSetExists(ctx context.Context, name string, testset *lexmodelsv2.DescribeTestSetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestSet, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestSet, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindTestSetByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestSet, rs.Primary.ID, err)
+		}
+
+		*testset = *resp
+
+		return nil
+	}
+}
+
+func testAccTestSetConfig_basic(rName, testSetName, botID, botAliasID, description string) string {
+	now := time.Now().UTC()
+
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lexv2.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonLexFullAccess"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lexv2models_test_set" "test" {
+  test_set_name = %[2]q
+  description   = %[5]q
+  role_arn      = aws_iam_role.test.arn
+
+  generation_data_source {
+    conversation_logs_data_source {
+      bot_id       = %[3]q
+      bot_alias_id = %[4]q
+      locale_id    = "en_US"
+
+      filter {
+        input_mode = "Text"
+        start_time = %[6]q
+        end_time   = %[7]q
+      }
+    }
+  }
+
+  storage_location {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_path        = "test-sets/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, testSetName, botID, botAliasID, description, now.AddDate(0, 0, -7).Format(time.RFC3339), now.Format(time.RFC3339))
+}
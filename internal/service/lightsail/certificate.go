@@ -112,6 +112,37 @@ func ResourceCertificate() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				// Attempt to calculate the domain validation options based on domains present in domain_name and subject_alternative_names
+				// so that the domain names are known at plan time and can be used as for_each keys.
+				if diff.HasChange("domain_name") || diff.HasChange("subject_alternative_names") {
+					domainValidationOptionsList := []interface{}{map[string]interface{}{
+						"domain_name": diff.Get("domain_name").(string),
+					}}
+
+					if sanSet, ok := diff.Get("subject_alternative_names").(*schema.Set); ok {
+						for _, sanRaw := range sanSet.List() {
+							san, ok := sanRaw.(string)
+
+							if !ok {
+								continue
+							}
+
+							m := map[string]interface{}{
+								"domain_name": san,
+							}
+
+							domainValidationOptionsList = append(domainValidationOptionsList, m)
+						}
+					}
+
+					if err := diff.SetNew("domain_validation_options", schema.NewSet(domainValidationOptionsHash, domainValidationOptionsList)); err != nil {
+						return fmt.Errorf("setting new domain_validation_options diff: %w", err)
+					}
+				}
+
+				return nil
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				// Lightsail automatically adds the domain_name value to the list of SANs. Mimic Lightsail's behavior
 				// so that the user doesn't need to explicitly set it themselves.
@@ -58,6 +58,7 @@ func ResourceContainerService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"current_deployment": containerServiceDeploymentSummarySchema(),
 			"is_disabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -72,6 +73,7 @@ func ResourceContainerService() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^[0-9a-z]{1,2}|[0-9a-z][0-9a-z-]+[0-9a-z]$`), ""),
 				),
 			},
+			"next_deployment": containerServiceDeploymentSummarySchema(),
 			"power": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -252,6 +254,12 @@ func resourceContainerServiceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("arn", cs.Arn)
 	d.Set("availability_zone", cs.Location.AvailabilityZone)
 	d.Set("created_at", aws.ToTime(cs.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("current_deployment", flattenContainerServiceDeploymentSummary(cs.CurrentDeployment)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting current_deployment for Lightsail Container Service (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("next_deployment", flattenContainerServiceDeploymentSummary(cs.NextDeployment)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting next_deployment for Lightsail Container Service (%s): %s", d.Id(), err)
+	}
 	d.Set("power_id", cs.PowerId)
 	d.Set("principal_arn", cs.PrincipalArn)
 	d.Set("private_domain_name", cs.PrivateDomainName)
@@ -382,6 +390,46 @@ func expandECRImagePullerRole(tfMap map[string]interface{}) *types.ContainerServ
 	return apiObject
 }
 
+// containerServiceDeploymentSummarySchema returns the schema of the current or next
+// deployment of a container service. The next deployment is the one being activated
+// while the current deployment continues to serve traffic.
+func containerServiceDeploymentSummarySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"created_at": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenContainerServiceDeploymentSummary(apiObject *types.ContainerServiceDeployment) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"created_at": aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+		"state":      apiObject.State,
+		"version":    aws.ToInt32(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenPrivateRegistryAccess(apiObject *types.PrivateRegistryAccess) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -135,6 +135,16 @@ func TestAccLightsailContainerServiceDeploymentVersion_container_basic(t *testin
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// Refresh the container service now that the deployment has been activated.
+				Config: testAccContainerServiceDeploymentVersionConfig_Container_basic(rName, containerName, helloWorldImage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_lightsail_container_service.test", "current_deployment.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_lightsail_container_service.test", "current_deployment.0.version", resourceName, "version"),
+					resource.TestCheckResourceAttr("aws_lightsail_container_service.test", "current_deployment.0.state", string(types.ContainerServiceDeploymentStateActive)),
+					resource.TestCheckResourceAttr("aws_lightsail_container_service.test", "next_deployment.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertJobTemplatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_media_convert_job_templates.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplatesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "job_templates.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_templates.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_templates.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "job_templates.0.type", "SYSTEM"),
+				),
+			},
+		},
+	})
+}
+
+const testAccJobTemplatesDataSourceConfig_basic = `
+data "aws_media_convert_job_templates" "test" {
+  type = "SYSTEM"
+}
+`
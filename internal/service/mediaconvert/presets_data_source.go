@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_media_convert_presets", name="Presets")
+func dataSourcePresets() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePresetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"presets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Type](),
+			},
+		},
+	}
+}
+
+func dataSourcePresetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	input := &mediaconvert.ListPresetsInput{
+		ListBy: types.PresetListByName,
+		Order:  types.OrderAscending,
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	presets, err := findPresets(ctx, conn, input, func(v types.Preset) bool {
+		if t, ok := d.GetOk("type"); ok {
+			return v.Type == types.Type(t.(string))
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Presets: %s", err)
+	}
+
+	tfList := make([]interface{}, 0, len(presets))
+
+	for _, v := range presets {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":         aws.ToString(v.Arn),
+			"category":    aws.ToString(v.Category),
+			"description": aws.ToString(v.Description),
+			"name":        aws.ToString(v.Name),
+			"type":        v.Type,
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("presets", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting presets: %s", err)
+	}
+
+	return diags
+}
+
+func findPresets(ctx context.Context, conn *mediaconvert.Client, input *mediaconvert.ListPresetsInput, filter func(types.Preset) bool) ([]types.Preset, error) {
+	var output []types.Preset
+
+	pages := mediaconvert.NewListPresetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Presets {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
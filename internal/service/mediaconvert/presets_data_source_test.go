@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertPresetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_media_convert_presets.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "presets.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "presets.0.arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "presets.0.category", "MP4"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "presets.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "presets.0.type", "SYSTEM"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPresetsDataSourceConfig_basic = `
+data "aws_media_convert_presets" "test" {
+  category = "MP4"
+  type     = "SYSTEM"
+}
+`
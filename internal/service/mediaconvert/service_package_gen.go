@@ -24,6 +24,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceJobTemplates,
+			TypeName: "aws_media_convert_job_templates",
+			Name:     "Job Templates",
+		},
+		{
+			Factory:  dataSourcePresets,
+			TypeName: "aws_media_convert_presets",
+			Name:     "Presets",
+		},
 		{
 			Factory:  dataSourceQueue,
 			TypeName: "aws_media_convert_queue",
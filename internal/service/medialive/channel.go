@@ -39,7 +39,10 @@ func ResourceChannel() *schema.Resource {
 		DeleteWithoutTimeout: resourceChannelDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("restart_channel_on_update", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -678,6 +681,11 @@ func ResourceChannel() *schema.Resource {
 					Type:     schema.TypeString,
 					Required: true,
 				},
+				"restart_channel_on_update": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				"role_arn": {
 					Type:             schema.TypeString,
 					Optional:         true,
@@ -855,7 +863,9 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all", "start_channel") {
+	var stoppedForUpdate bool
+
+	if d.HasChangesExcept("tags", "tags_all", "start_channel", "restart_channel_on_update") {
 		in := &medialive.UpdateChannelInput{
 			ChannelId: aws.String(d.Id()),
 		}
@@ -902,10 +912,13 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 		}
 
+		// Channel settings can only be updated while the channel is idle.
 		if channel.State == types.ChannelStateRunning {
 			if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
 				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 			}
+
+			stoppedForUpdate = true
 		}
 
 		out, err := conn.UpdateChannel(ctx, in)
@@ -922,6 +935,11 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Get("name").(string), err)
 		}
+	} else if stoppedForUpdate && !d.HasChange("start_channel") && d.Get("restart_channel_on_update").(bool) {
+		// The channel was started outside of Terraform, so return it to the running state it was in before the update.
+		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		}
 	}
 
 	if d.HasChange("start_channel") {
@@ -0,0 +1,771 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_channel_schedule", name="Channel Schedule")
+func ResourceChannelSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelScheduleCreate,
+		ReadWithoutTimeout:   resourceChannelScheduleRead,
+		UpdateWithoutTimeout: resourceChannelScheduleUpdate,
+		DeleteWithoutTimeout: resourceChannelScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schedule_action_settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hls_timed_metadata_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id3": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"input_switch_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_attachment_name_reference": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"url_path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"pause_state_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"pipelines": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"pipeline_id": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.PipelineId](),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"scte35_return_to_network_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"splice_event_id": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+											},
+										},
+									},
+									"scte35_splice_insert_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"splice_event_id": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+											},
+										},
+									},
+									"static_image_activate_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"fade_in": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"fade_out": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"height": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"image": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"password_param": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"uri": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"username": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"image_x": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"image_y": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"layer": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 7),
+												},
+												"opacity": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 100),
+												},
+												"width": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"static_image_deactivate_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"fade_out": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"layer": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 7),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"schedule_action_start_settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fixed_mode_schedule_action_start_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"time": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidUTCTimestamp,
+												},
+											},
+										},
+									},
+									"follow_mode_schedule_action_start_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"follow_point": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.FollowPoint](),
+												},
+												"reference_action_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"immediate_mode_schedule_action_start_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameChannelSchedule = "Channel Schedule"
+)
+
+func resourceChannelScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	channelID := d.Get("channel_id").(string)
+	in := &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(channelID),
+		Creates: &types.BatchScheduleActionCreateRequest{
+			ScheduleActions: expandScheduleActions(d.Get("action").(*schema.Set).List()),
+		},
+	}
+
+	_, err := conn.BatchUpdateSchedule(ctx, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameChannelSchedule, channelID, err)
+	}
+
+	d.SetId(channelID)
+
+	return append(diags, resourceChannelScheduleRead(ctx, d, meta)...)
+}
+
+func resourceChannelScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	actions, err := findScheduleActionsByChannelID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	d.Set("channel_id", d.Id())
+	if err := d.Set("action", flattenScheduleActions(actions, d.Get("action").(*schema.Set).List())); err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionSetting, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceChannelScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	if d.HasChange("action") {
+		// Schedule actions are immutable. Changed actions are deleted and created again in the same batch.
+		o, n := d.GetChange("action")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		del, add := os.Difference(ns).List(), ns.Difference(os).List()
+
+		in := &medialive.BatchUpdateScheduleInput{
+			ChannelId: aws.String(d.Id()),
+		}
+
+		if len(del) > 0 {
+			in.Deletes = &types.BatchScheduleActionDeleteRequest{
+				ActionNames: scheduleActionNames(del),
+			}
+		}
+
+		if len(add) > 0 {
+			in.Creates = &types.BatchScheduleActionCreateRequest{
+				ScheduleActions: expandScheduleActions(add),
+			}
+		}
+
+		_, err := conn.BatchUpdateSchedule(ctx, in)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannelSchedule, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceChannelScheduleRead(ctx, d, meta)...)
+}
+
+func resourceChannelScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	log.Printf("[INFO] Deleting MediaLive Channel Schedule %s", d.Id())
+
+	actionNames := scheduleActionNames(d.Get("action").(*schema.Set).List())
+
+	if len(actionNames) == 0 {
+		return diags
+	}
+
+	_, err := conn.BatchUpdateSchedule(ctx, &medialive.BatchUpdateScheduleInput{
+		ChannelId: aws.String(d.Id()),
+		Deletes: &types.BatchScheduleActionDeleteRequest{
+			ActionNames: actionNames,
+		},
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameChannelSchedule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findScheduleActionsByChannelID(ctx context.Context, conn *medialive.Client, channelID string) ([]types.ScheduleAction, error) {
+	in := &medialive.DescribeScheduleInput{
+		ChannelId: aws.String(channelID),
+	}
+	var out []types.ScheduleAction
+
+	pages := medialive.NewDescribeSchedulePaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.ScheduleActions...)
+	}
+
+	return out, nil
+}
+
+func scheduleActionNames(tfList []interface{}) []string {
+	var actionNames []string
+	for _, v := range tfList {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		actionNames = append(actionNames, m["action_name"].(string))
+	}
+
+	return actionNames
+}
+
+func expandScheduleActions(tfList []interface{}) []types.ScheduleAction {
+	var actions []types.ScheduleAction
+	for _, v := range tfList {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var a types.ScheduleAction
+		if v, ok := m["action_name"].(string); ok && v != "" {
+			a.ActionName = aws.String(v)
+		}
+		if v, ok := m["schedule_action_settings"].([]interface{}); ok && len(v) > 0 {
+			a.ScheduleActionSettings = expandScheduleActionSettings(v)
+		}
+		if v, ok := m["schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 {
+			a.ScheduleActionStartSettings = expandScheduleActionStartSettings(v)
+		}
+
+		actions = append(actions, a)
+	}
+
+	return actions
+}
+
+func expandScheduleActionSettings(tfList []interface{}) *types.ScheduleActionSettings {
+	if tfList == nil || tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.ScheduleActionSettings
+	if v, ok := m["hls_timed_metadata_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.HlsTimedMetadataSettings = &types.HlsTimedMetadataScheduleActionSettings{
+			Id3: aws.String(tfMap["id3"].(string)),
+		}
+	}
+	if v, ok := m["input_switch_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.InputSwitchSettings = &types.InputSwitchScheduleActionSettings{
+			InputAttachmentNameReference: aws.String(tfMap["input_attachment_name_reference"].(string)),
+		}
+		if v, ok := tfMap["url_path"].([]interface{}); ok && len(v) > 0 {
+			out.InputSwitchSettings.UrlPath = flex.ExpandStringValueList(v)
+		}
+	}
+	if v, ok := m["pause_state_settings"].([]interface{}); ok && len(v) > 0 {
+		out.PauseStateSettings = expandPauseStateSettings(v)
+	}
+	if v, ok := m["scte35_return_to_network_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.Scte35ReturnToNetworkSettings = &types.Scte35ReturnToNetworkScheduleActionSettings{
+			SpliceEventId: aws.Int64(int64(tfMap["splice_event_id"].(int))),
+		}
+	}
+	if v, ok := m["scte35_splice_insert_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.Scte35SpliceInsertSettings = &types.Scte35SpliceInsertScheduleActionSettings{
+			SpliceEventId: aws.Int64(int64(tfMap["splice_event_id"].(int))),
+		}
+		if v, ok := tfMap["duration"].(int); ok && v != 0 {
+			out.Scte35SpliceInsertSettings.Duration = aws.Int64(int64(v))
+		}
+	}
+	if v, ok := m["static_image_activate_settings"].([]interface{}); ok && len(v) > 0 {
+		out.StaticImageActivateSettings = expandStaticImageActivateSettings(v)
+	}
+	if v, ok := m["static_image_deactivate_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.StaticImageDeactivateSettings = &types.StaticImageDeactivateScheduleActionSettings{}
+		if v, ok := tfMap["fade_out"].(int); ok && v != 0 {
+			out.StaticImageDeactivateSettings.FadeOut = aws.Int32(int32(v))
+		}
+		if v, ok := tfMap["layer"].(int); ok && v != 0 {
+			out.StaticImageDeactivateSettings.Layer = aws.Int32(int32(v))
+		}
+	}
+
+	return &out
+}
+
+func expandPauseStateSettings(tfList []interface{}) *types.PauseStateScheduleActionSettings {
+	// An empty pause_state_settings block unpauses all pipelines.
+	out := &types.PauseStateScheduleActionSettings{}
+
+	if tfList[0] == nil {
+		return out
+	}
+
+	m := tfList[0].(map[string]interface{})
+	if v, ok := m["pipelines"].(*schema.Set); ok && v.Len() > 0 {
+		for _, p := range v.List() {
+			tfMap, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			out.Pipelines = append(out.Pipelines, types.PipelinePauseStateSettings{
+				PipelineId: types.PipelineId(tfMap["pipeline_id"].(string)),
+			})
+		}
+	}
+
+	return out
+}
+
+func expandStaticImageActivateSettings(tfList []interface{}) *types.StaticImageActivateScheduleActionSettings {
+	if tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.StaticImageActivateScheduleActionSettings
+	if v, ok := m["image"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.Image = &types.InputLocation{
+			Uri: aws.String(tfMap["uri"].(string)),
+		}
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			out.Image.PasswordParam = aws.String(v)
+		}
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			out.Image.Username = aws.String(v)
+		}
+	}
+	if v, ok := m["duration"].(int); ok && v != 0 {
+		out.Duration = aws.Int32(int32(v))
+	}
+	if v, ok := m["fade_in"].(int); ok && v != 0 {
+		out.FadeIn = aws.Int32(int32(v))
+	}
+	if v, ok := m["fade_out"].(int); ok && v != 0 {
+		out.FadeOut = aws.Int32(int32(v))
+	}
+	if v, ok := m["height"].(int); ok && v != 0 {
+		out.Height = aws.Int32(int32(v))
+	}
+	if v, ok := m["image_x"].(int); ok && v != 0 {
+		out.ImageX = aws.Int32(int32(v))
+	}
+	if v, ok := m["image_y"].(int); ok && v != 0 {
+		out.ImageY = aws.Int32(int32(v))
+	}
+	if v, ok := m["layer"].(int); ok && v != 0 {
+		out.Layer = aws.Int32(int32(v))
+	}
+	if v, ok := m["opacity"].(int); ok && v != 0 {
+		out.Opacity = aws.Int32(int32(v))
+	}
+	if v, ok := m["width"].(int); ok && v != 0 {
+		out.Width = aws.Int32(int32(v))
+	}
+
+	return &out
+}
+
+func expandScheduleActionStartSettings(tfList []interface{}) *types.ScheduleActionStartSettings {
+	if tfList[0] == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.ScheduleActionStartSettings
+	if v, ok := m["fixed_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.FixedModeScheduleActionStartSettings = &types.FixedModeScheduleActionStartSettings{
+			Time: aws.String(tfMap["time"].(string)),
+		}
+	}
+	if v, ok := m["follow_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		out.FollowModeScheduleActionStartSettings = &types.FollowModeScheduleActionStartSettings{
+			FollowPoint:         types.FollowPoint(tfMap["follow_point"].(string)),
+			ReferenceActionName: aws.String(tfMap["reference_action_name"].(string)),
+		}
+	}
+	// The block has no arguments, so an empty block is represented as a single nil element.
+	if v, ok := m["immediate_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 {
+		out.ImmediateModeScheduleActionStartSettings = &types.ImmediateModeScheduleActionStartSettings{}
+	}
+
+	return &out
+}
+
+func flattenScheduleActions(apiObjects []types.ScheduleAction, configured []interface{}) []interface{} {
+	// MediaLive reports immediate mode actions as fixed mode actions once they have been scheduled.
+	// Keep the configured start settings for those actions to avoid a perpetual diff.
+	configuredStartSettings := make(map[string]interface{})
+	for _, v := range configured {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configuredStartSettings[m["action_name"].(string)] = m["schedule_action_start_settings"]
+	}
+
+	var tfList []interface{}
+	for _, apiObject := range apiObjects {
+		name := aws.ToString(apiObject.ActionName)
+		startSettings := flattenScheduleActionStartSettings(apiObject.ScheduleActionStartSettings)
+
+		if v, ok := configuredStartSettings[name].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["immediate_mode_schedule_action_start_settings"].([]interface{}); ok && len(v) > 0 {
+				startSettings = configuredStartSettings[name].([]interface{})
+			}
+		}
+
+		m := map[string]interface{}{
+			"action_name":                    name,
+			"schedule_action_settings":       flattenScheduleActionSettings(apiObject.ScheduleActionSettings),
+			"schedule_action_start_settings": startSettings,
+		}
+
+		tfList = append(tfList, m)
+	}
+
+	return tfList
+}
+
+func flattenScheduleActionSettings(apiObject *types.ScheduleActionSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.HlsTimedMetadataSettings; v != nil {
+		m["hls_timed_metadata_settings"] = []interface{}{map[string]interface{}{
+			"id3": aws.ToString(v.Id3),
+		}}
+	}
+	if v := apiObject.InputSwitchSettings; v != nil {
+		m["input_switch_settings"] = []interface{}{map[string]interface{}{
+			"input_attachment_name_reference": aws.ToString(v.InputAttachmentNameReference),
+			"url_path":                        flex.FlattenStringValueList(v.UrlPath),
+		}}
+	}
+	if v := apiObject.PauseStateSettings; v != nil {
+		var pipelines []interface{}
+		for _, p := range v.Pipelines {
+			pipelines = append(pipelines, map[string]interface{}{
+				"pipeline_id": string(p.PipelineId),
+			})
+		}
+
+		m["pause_state_settings"] = []interface{}{map[string]interface{}{
+			"pipelines": pipelines,
+		}}
+	}
+	if v := apiObject.Scte35ReturnToNetworkSettings; v != nil {
+		m["scte35_return_to_network_settings"] = []interface{}{map[string]interface{}{
+			"splice_event_id": int(aws.ToInt64(v.SpliceEventId)),
+		}}
+	}
+	if v := apiObject.Scte35SpliceInsertSettings; v != nil {
+		m["scte35_splice_insert_settings"] = []interface{}{map[string]interface{}{
+			"duration":        int(aws.ToInt64(v.Duration)),
+			"splice_event_id": int(aws.ToInt64(v.SpliceEventId)),
+		}}
+	}
+	if v := apiObject.StaticImageActivateSettings; v != nil {
+		tfMap := map[string]interface{}{
+			"duration": int(aws.ToInt32(v.Duration)),
+			"fade_in":  int(aws.ToInt32(v.FadeIn)),
+			"fade_out": int(aws.ToInt32(v.FadeOut)),
+			"height":   int(aws.ToInt32(v.Height)),
+			"image_x":  int(aws.ToInt32(v.ImageX)),
+			"image_y":  int(aws.ToInt32(v.ImageY)),
+			"layer":    int(aws.ToInt32(v.Layer)),
+			"opacity":  int(aws.ToInt32(v.Opacity)),
+			"width":    int(aws.ToInt32(v.Width)),
+		}
+		if v := v.Image; v != nil {
+			tfMap["image"] = []interface{}{map[string]interface{}{
+				"password_param": aws.ToString(v.PasswordParam),
+				"uri":            aws.ToString(v.Uri),
+				"username":       aws.ToString(v.Username),
+			}}
+		}
+
+		m["static_image_activate_settings"] = []interface{}{tfMap}
+	}
+	if v := apiObject.StaticImageDeactivateSettings; v != nil {
+		m["static_image_deactivate_settings"] = []interface{}{map[string]interface{}{
+			"fade_out": int(aws.ToInt32(v.FadeOut)),
+			"layer":    int(aws.ToInt32(v.Layer)),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenScheduleActionStartSettings(apiObject *types.ScheduleActionStartSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.FixedModeScheduleActionStartSettings; v != nil {
+		m["fixed_mode_schedule_action_start_settings"] = []interface{}{map[string]interface{}{
+			"time": aws.ToString(v.Time),
+		}}
+	}
+	if v := apiObject.FollowModeScheduleActionStartSettings; v != nil {
+		m["follow_mode_schedule_action_start_settings"] = []interface{}{map[string]interface{}{
+			"follow_point":          string(v.FollowPoint),
+			"reference_action_name": aws.ToString(v.ReferenceActionName),
+		}}
+	}
+	if v := apiObject.ImmediateModeScheduleActionStartSettings; v != nil {
+		m["immediate_mode_schedule_action_start_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{m}
+}
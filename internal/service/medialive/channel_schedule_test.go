@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveChannelSchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var actions []types.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule.test"
+	startTime := time.Now().UTC().Add(2 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName, &actions),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "aws_medialive_channel.test", "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_name": "input-switch",
+						"schedule_action_settings.0.input_switch_settings.0.input_attachment_name_reference": "example-input1",
+						"schedule_action_start_settings.0.fixed_mode_schedule_action_start_settings.0.time":  startTime,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelScheduleConfig_updated(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName, &actions),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.*", map[string]string{
+						"action_name": "splice-insert",
+						"schedule_action_settings.0.scte35_splice_insert_settings.0.duration":                        "2700000",
+						"schedule_action_settings.0.scte35_splice_insert_settings.0.splice_event_id":                 "1",
+						"schedule_action_start_settings.0.follow_mode_schedule_action_start_settings.0.follow_point": "END",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannelSchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var actions []types.ScheduleAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel_schedule.test"
+	startTime := time.Now().UTC().Add(2 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelScheduleConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelScheduleExists(ctx, resourceName, &actions),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmedialive.ResourceChannelSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_channel_schedule" {
+				continue
+			}
+
+			actions, err := tfmedialive.FindScheduleActionsByChannelID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, err)
+			}
+
+			if len(actions) > 0 {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelScheduleExists(ctx context.Context, name string, actions *[]types.ScheduleAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		resp, err := tfmedialive.FindScheduleActionsByChannelID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameChannelSchedule, rs.Primary.ID, err)
+		}
+
+		*actions = resp
+
+		return nil
+	}
+}
+
+func testAccChannelScheduleConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel_schedule" "test" {
+  channel_id = aws_medialive_channel.test.channel_id
+
+  action {
+    action_name = "input-switch"
+
+    schedule_action_settings {
+      input_switch_settings {
+        input_attachment_name_reference = "example-input1"
+      }
+    }
+
+    schedule_action_start_settings {
+      fixed_mode_schedule_action_start_settings {
+        time = %[1]q
+      }
+    }
+  }
+}
+`, startTime))
+}
+
+func testAccChannelScheduleConfig_updated(rName, startTime string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel_schedule" "test" {
+  channel_id = aws_medialive_channel.test.channel_id
+
+  action {
+    action_name = "input-switch"
+
+    schedule_action_settings {
+      input_switch_settings {
+        input_attachment_name_reference = "example-input1"
+      }
+    }
+
+    schedule_action_start_settings {
+      fixed_mode_schedule_action_start_settings {
+        time = %[1]q
+      }
+    }
+  }
+
+  action {
+    action_name = "splice-insert"
+
+    schedule_action_settings {
+      scte35_splice_insert_settings {
+        duration        = 2700000
+        splice_event_id = 1
+      }
+    }
+
+    schedule_action_start_settings {
+      follow_mode_schedule_action_start_settings {
+        follow_point          = "END"
+        reference_action_name = "input-switch"
+      }
+    }
+  }
+}
+`, startTime))
+}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -807,6 +809,60 @@ func TestAccMediaLiveChannel_update(t *testing.T) {
 	})
 }
 
+func TestAccMediaLiveChannel_restartChannelOnUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := fmt.Sprintf("%s-updated", rName)
+	resourceName := "aws_medialive_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_restartChannelOnUpdate(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "restart_channel_on_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					testAccCheckChannelStatus(ctx, resourceName, types.ChannelStateIdle),
+				),
+			},
+			{
+				// Start the channel outside of Terraform.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+					if _, err := conn.StartChannel(ctx, &medialive.StartChannelInput{ChannelId: channel.Id}); err != nil {
+						t.Fatalf("starting MediaLive Channel: %s", err)
+					}
+
+					if _, err := tfmedialive.WaitChannelStarted(ctx, conn, aws.ToString(channel.Id), 15*time.Minute); err != nil {
+						t.Fatalf("waiting for MediaLive Channel start: %s", err)
+					}
+				},
+				Config: testAccChannelConfig_restartChannelOnUpdate(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					testAccCheckChannelStatus(ctx, resourceName, types.ChannelStateRunning),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMediaLiveChannel_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2112,6 +2168,88 @@ resource "aws_medialive_channel" "test" {
 `, rName, start))
 }
 
+func testAccChannelConfig_restartChannelOnUpdate(rName, rNameUpdated string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_base(rName),
+		testAccChannelConfig_baseS3(rName),
+		testAccChannelConfig_baseMultiplex(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name                      = %[2]q
+  channel_class             = "STANDARD"
+  role_arn                  = aws_iam_role.test.arn
+  restart_channel_on_update = true
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "example-input1"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  destinations {
+    id = %[1]q
+
+    settings {
+      url = "s3://${aws_s3_bucket.test1.id}/test1"
+    }
+
+    settings {
+      url = "s3://${aws_s3_bucket.test2.id}/test2"
+    }
+  }
+
+  encoder_settings {
+    timecode_config {
+      source = "EMBEDDED"
+    }
+
+    audio_descriptions {
+      audio_selector_name = %[1]q
+      name                = %[1]q
+    }
+
+    video_descriptions {
+      name = "test-video-name"
+    }
+
+    output_groups {
+      output_group_settings {
+        archive_group_settings {
+          destination {
+            destination_ref_id = %[1]q
+          }
+        }
+      }
+
+      outputs {
+        output_name             = "test-output-name"
+        video_description_name  = "test-video-name"
+        audio_description_names = [%[1]q]
+        output_settings {
+          archive_output_settings {
+            name_modifier = "_1"
+            extension     = "m2ts"
+            container_settings {
+              m2ts_settings {
+                audio_buffer_model = "ATSC"
+                buffer_model       = "MULTIPLEX"
+                rate_mode          = "CBR"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, rNameUpdated))
+}
+
 func testAccChannelConfig_update(rName, rNameUpdated, codec, inputResolution string) string {
 	return acctest.ConfigCompose(
 		testAccChannelConfig_base(rName),
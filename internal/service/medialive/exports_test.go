@@ -5,3 +5,8 @@ package medialive
 
 // Exports for use in tests only.
 var ResourceMultiplexProgram = newResourceMultiplexProgram
+
+var (
+	FindScheduleActionsByChannelID = findScheduleActionsByChannelID
+	WaitChannelStarted             = waitChannelStarted
+)
@@ -45,6 +45,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceChannelSchedule,
+			TypeName: "aws_medialive_channel_schedule",
+			Name:     "Channel Schedule",
+		},
 		{
 			Factory:  ResourceInput,
 			TypeName: "aws_medialive_input",
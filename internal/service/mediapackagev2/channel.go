@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Channel")
+// @Tags(identifierAttribute="arn")
+func newChannelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelResource{}, nil
+}
+
+// @FrameworkResource(name="Channel")
+// @Tags(identifierAttribute="arn")
+func newChannelResourceDeprecated(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelResourceDeprecated{}, nil
+}
+
+type channelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*channelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediapackagev2_channel"
+}
+
+func (*channelResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		framework.RenamedResourceStateMover("aws_media_packagev2_channel"),
+	}
+}
+
+func (r *channelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ingest_endpoints": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ingestEndpointModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[ingestEndpointModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *channelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	input := &mediapackagev2.CreateChannelInput{
+		ChannelGroupName: fwflex.StringFromFramework(ctx, data.ChannelGroupName),
+		ChannelName:      fwflex.StringFromFramework(ctx, data.Name),
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Description:      fwflex.StringFromFramework(ctx, data.Description),
+		Tags:             getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateChannel(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage v2 Channel (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	// The create response does not include the ingest endpoints.
+	channel, err := findChannelByTwoPartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, channel.IngestEndpoints, &data.IngestEndpoints)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	output, err := findChannelByTwoPartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.IngestEndpoints, &data.IngestEndpoints)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new channelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	if !new.Description.Equal(old.Description) {
+		input := &mediapackagev2.UpdateChannelInput{
+			ChannelGroupName: fwflex.StringFromFramework(ctx, new.ChannelGroupName),
+			ChannelName:      fwflex.StringFromFramework(ctx, new.Name),
+			Description:      fwflex.StringFromFramework(ctx, new.Description),
+		}
+
+		_, err := conn.UpdateChannel(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage v2 Channel (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *channelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	_, err := conn.DeleteChannel(ctx, &mediapackagev2.DeleteChannelInput{
+		ChannelGroupName: fwflex.StringFromFramework(ctx, data.ChannelGroupName),
+		ChannelName:      fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage v2 Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *channelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// channelResourceDeprecated is the channel resource under its previous type name,
+// which doesn't follow the service's resource prefix.
+type channelResourceDeprecated struct {
+	channelResource
+}
+
+func (*channelResourceDeprecated) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_media_packagev2_channel"
+}
+
+func (r *channelResourceDeprecated) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.channelResource.Schema(ctx, request, response)
+
+	response.Schema.DeprecationMessage = "use the aws_mediapackagev2_channel resource instead"
+}
+
+func (*channelResourceDeprecated) MoveState(context.Context) []resource.StateMover {
+	return nil
+}
+
+func findChannelByTwoPartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName string) (*mediapackagev2.GetChannelOutput, error) {
+	input := &mediapackagev2.GetChannelInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	}
+
+	output, err := conn.GetChannel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type channelResourceModel struct {
+	ARN              types.String                                         `tfsdk:"arn"`
+	ChannelGroupName types.String                                         `tfsdk:"channel_group_name"`
+	Description      types.String                                         `tfsdk:"description"`
+	ID               types.String                                         `tfsdk:"id"`
+	IngestEndpoints  fwtypes.ListNestedObjectValueOf[ingestEndpointModel] `tfsdk:"ingest_endpoints"`
+	Name             types.String                                         `tfsdk:"name"`
+	Tags             types.Map                                            `tfsdk:"tags"`
+	TagsAll          types.Map                                            `tfsdk:"tags_all"`
+}
+
+const (
+	channelResourceIDPartCount = 2
+)
+
+func (data *channelResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), channelResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ChannelGroupName = types.StringValue(parts[0])
+	data.Name = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *channelResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ChannelGroupName.ValueString(), data.Name.ValueString()}, channelResourceIDPartCount, false)))
+}
+
+type ingestEndpointModel struct {
+	ID  types.String `tfsdk:"id"`
+	URL types.String `tfsdk:"url"`
+}
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Channel Group")
+// @Tags(identifierAttribute="arn")
+func newChannelGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelGroupResource{}, nil
+}
+
+// @FrameworkResource(name="Channel Group")
+// @Tags(identifierAttribute="arn")
+func newChannelGroupResourceDeprecated(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelGroupResourceDeprecated{}, nil
+}
+
+type channelGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*channelGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediapackagev2_channel_group"
+}
+
+func (*channelGroupResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		framework.RenamedResourceStateMover("aws_media_packagev2_channel_group"),
+	}
+}
+
+func (r *channelGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"egress_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *channelGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	input := &mediapackagev2.CreateChannelGroupInput{
+		ChannelGroupName: fwflex.StringFromFramework(ctx, data.Name),
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Description:      fwflex.StringFromFramework(ctx, data.Description),
+		Tags:             getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateChannelGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage v2 Channel Group (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.EgressDomain = fwflex.StringToFramework(ctx, output.EgressDomain)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	output, err := findChannelGroupByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Channel Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.EgressDomain = fwflex.StringToFramework(ctx, output.EgressDomain)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new channelGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	if !new.Description.Equal(old.Description) {
+		input := &mediapackagev2.UpdateChannelGroupInput{
+			ChannelGroupName: fwflex.StringFromFramework(ctx, new.Name),
+			Description:      fwflex.StringFromFramework(ctx, new.Description),
+		}
+
+		_, err := conn.UpdateChannelGroup(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage v2 Channel Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *channelGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	_, err := conn.DeleteChannelGroup(ctx, &mediapackagev2.DeleteChannelGroupInput{
+		ChannelGroupName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage v2 Channel Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *channelGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// channelGroupResourceDeprecated is the channel group resource under its previous type name,
+// which doesn't follow the service's resource prefix.
+type channelGroupResourceDeprecated struct {
+	channelGroupResource
+}
+
+func (*channelGroupResourceDeprecated) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_media_packagev2_channel_group"
+}
+
+func (r *channelGroupResourceDeprecated) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.channelGroupResource.Schema(ctx, request, response)
+
+	response.Schema.DeprecationMessage = "use the aws_mediapackagev2_channel_group resource instead"
+}
+
+func (*channelGroupResourceDeprecated) MoveState(context.Context) []resource.StateMover {
+	return nil
+}
+
+func findChannelGroupByName(ctx context.Context, conn *mediapackagev2.Client, name string) (*mediapackagev2.GetChannelGroupOutput, error) {
+	input := &mediapackagev2.GetChannelGroupInput{
+		ChannelGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetChannelGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type channelGroupResourceModel struct {
+	ARN          types.String `tfsdk:"arn"`
+	Description  types.String `tfsdk:"description"`
+	EgressDomain types.String `tfsdk:"egress_domain"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Tags         types.Map    `tfsdk:"tags"`
+	TagsAll      types.Map    `tfsdk:"tags_all"`
+}
+
+func (data *channelGroupResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *channelGroupResourceModel) setID() {
+	data.ID = data.Name
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+// Exports for use in tests only.
+var (
+	ResourceChannel        = newChannelResource
+	ResourceChannelGroup   = newChannelGroupResource
+	ResourceOriginEndpoint = newOriginEndpointResource
+
+	FindChannelByTwoPartKey          = findChannelByTwoPartKey
+	FindChannelGroupByName           = findChannelGroupByName
+	FindOriginEndpointByThreePartKey = findOriginEndpointByThreePartKey
+)
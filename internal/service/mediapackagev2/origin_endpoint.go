@@ -0,0 +1,811 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+func newOriginEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &originEndpointResource{}, nil
+}
+
+// @FrameworkResource(name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+func newOriginEndpointResourceDeprecated(context.Context) (resource.ResourceWithConfigure, error) {
+	return &originEndpointResourceDeprecated{}, nil
+}
+
+type originEndpointResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*originEndpointResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediapackagev2_origin_endpoint"
+}
+
+func (*originEndpointResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		framework.RenamedResourceStateMover("aws_media_packagev2_origin_endpoint"),
+	}
+}
+
+func (r *originEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	manifestBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hlsManifestModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"child_manifest_name": schema.StringAttribute{
+					Optional: true,
+				},
+				"manifest_name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 256),
+						stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+					},
+				},
+				"manifest_window_seconds": schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+				"program_date_time_interval_seconds": schema.Int64Attribute{
+					Optional: true,
+				},
+				"url": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"scte_hls": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[scteHLSModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"ad_marker_hls": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.AdMarkerHls](),
+								Optional:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"container_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores or hyphens"),
+				},
+			},
+			"startover_window_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 1209600),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"hls_manifest":             manifestBlock,
+			"low_latency_hls_manifest": manifestBlock,
+			"segment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[segmentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_iframe_only_streams": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_duration_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(1, 30),
+							},
+						},
+						"segment_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ts_include_dvb_subtitles": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ts_use_audio_rendition_group": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"constant_initialization_vector": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Fa-f]{32}$`), "must be a 32-character hexadecimal string"),
+										},
+									},
+									"key_rotation_interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(300, 31536000),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"encryption_method": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionMethodModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"cmaf_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.CmafEncryptionMethod](),
+													Optional:   true,
+												},
+												"ts_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.TsEncryptionMethod](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									"speke_key_provider": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[spekeKeyProviderModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"drm_systems": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+													Validators: []validator.Set{
+														setvalidator.SizeBetween(1, 4),
+														setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.DrmSystem]()),
+													},
+												},
+												"resource_id": schema.StringAttribute{
+													Required: true,
+												},
+												"role_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												"url": schema.StringAttribute{
+													Required: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"encryption_contract_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionContractConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"preset_speke20_audio": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Audio](),
+																Required:   true,
+															},
+															"preset_speke20_video": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Video](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"scte": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scteModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"scte_filter": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.ScteFilter]()),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *originEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	input := &mediapackagev2.CreateOriginEndpointInput{
+		ChannelGroupName:       fwflex.StringFromFramework(ctx, data.ChannelGroupName),
+		ChannelName:            fwflex.StringFromFramework(ctx, data.ChannelName),
+		ClientToken:            aws.String(sdkid.UniqueId()),
+		ContainerType:          data.ContainerType.ValueEnum(),
+		Description:            fwflex.StringFromFramework(ctx, data.Description),
+		OriginEndpointName:     fwflex.StringFromFramework(ctx, data.Name),
+		StartoverWindowSeconds: fwflex.Int32FromFramework(ctx, data.StartoverWindowSeconds),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(data.expandManifests(ctx, &input.HlsManifests, &input.LowLatencyHlsManifests)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	segment, diags := expandSegment(ctx, data.Segment)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Segment = segment
+
+	_, err := conn.CreateOriginEndpoint(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage v2 Origin Endpoint (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Origin Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	output, err := findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Origin Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.HLSManifests.Equal(old.HLSManifests) ||
+		!new.LowLatencyHLSManifests.Equal(old.LowLatencyHLSManifests) ||
+		!new.Segment.Equal(old.Segment) ||
+		!new.StartoverWindowSeconds.Equal(old.StartoverWindowSeconds) {
+		// The update replaces the whole endpoint configuration.
+		input := &mediapackagev2.UpdateOriginEndpointInput{
+			ChannelGroupName:       fwflex.StringFromFramework(ctx, new.ChannelGroupName),
+			ChannelName:            fwflex.StringFromFramework(ctx, new.ChannelName),
+			ContainerType:          new.ContainerType.ValueEnum(),
+			Description:            fwflex.StringFromFramework(ctx, new.Description),
+			OriginEndpointName:     fwflex.StringFromFramework(ctx, new.Name),
+			StartoverWindowSeconds: fwflex.Int32FromFramework(ctx, new.StartoverWindowSeconds),
+		}
+
+		response.Diagnostics.Append(new.expandManifests(ctx, &input.HlsManifests, &input.LowLatencyHlsManifests)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		segment, diags := expandSegment(ctx, new.Segment)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Segment = segment
+
+		_, err := conn.UpdateOriginEndpoint(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage v2 Origin Endpoint (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findOriginEndpointByThreePartKey(ctx, conn, new.ChannelGroupName.ValueString(), new.ChannelName.ValueString(), new.Name.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Origin Endpoint (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.refreshFromOutput(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *originEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data originEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	_, err := conn.DeleteOriginEndpoint(ctx, &mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   fwflex.StringFromFramework(ctx, data.ChannelGroupName),
+		ChannelName:        fwflex.StringFromFramework(ctx, data.ChannelName),
+		OriginEndpointName: fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage v2 Origin Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *originEndpointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// originEndpointResourceDeprecated is the origin endpoint resource under its previous type name,
+// which doesn't follow the service's resource prefix.
+type originEndpointResourceDeprecated struct {
+	originEndpointResource
+}
+
+func (*originEndpointResourceDeprecated) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_media_packagev2_origin_endpoint"
+}
+
+func (r *originEndpointResourceDeprecated) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.originEndpointResource.Schema(ctx, request, response)
+
+	response.Schema.DeprecationMessage = "use the aws_mediapackagev2_origin_endpoint resource instead"
+}
+
+func (*originEndpointResourceDeprecated) MoveState(context.Context) []resource.StateMover {
+	return nil
+}
+
+func findOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	input := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	output, err := conn.GetOriginEndpoint(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type originEndpointResourceModel struct {
+	ARN                    types.String                                      `tfsdk:"arn"`
+	ChannelGroupName       types.String                                      `tfsdk:"channel_group_name"`
+	ChannelName            types.String                                      `tfsdk:"channel_name"`
+	ContainerType          fwtypes.StringEnum[awstypes.ContainerType]        `tfsdk:"container_type"`
+	Description            types.String                                      `tfsdk:"description"`
+	HLSManifests           fwtypes.ListNestedObjectValueOf[hlsManifestModel] `tfsdk:"hls_manifest"`
+	ID                     types.String                                      `tfsdk:"id"`
+	LowLatencyHLSManifests fwtypes.ListNestedObjectValueOf[hlsManifestModel] `tfsdk:"low_latency_hls_manifest"`
+	Name                   types.String                                      `tfsdk:"name"`
+	Segment                fwtypes.ListNestedObjectValueOf[segmentModel]     `tfsdk:"segment"`
+	StartoverWindowSeconds types.Int64                                       `tfsdk:"startover_window_seconds"`
+	Tags                   types.Map                                         `tfsdk:"tags"`
+	TagsAll                types.Map                                         `tfsdk:"tags_all"`
+}
+
+const (
+	originEndpointResourceIDPartCount = 3
+)
+
+func (data *originEndpointResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), originEndpointResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ChannelGroupName = types.StringValue(parts[0])
+	data.ChannelName = types.StringValue(parts[1])
+	data.Name = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *originEndpointResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString()}, originEndpointResourceIDPartCount, false)))
+}
+
+func (data *originEndpointResourceModel) expandManifests(ctx context.Context, hlsManifests *[]awstypes.CreateHlsManifestConfiguration, lowLatencyHLSManifests *[]awstypes.CreateLowLatencyHlsManifestConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Expand(ctx, data.HLSManifests, hlsManifests)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Expand(ctx, data.LowLatencyHLSManifests, lowLatencyHLSManifests)...)
+
+	return diags
+}
+
+func (data *originEndpointResourceModel) refreshFromOutput(ctx context.Context, output *mediapackagev2.GetOriginEndpointOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ContainerType = fwtypes.StringEnumValue(output.ContainerType)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.StartoverWindowSeconds = fwflex.Int32ToFramework(ctx, output.StartoverWindowSeconds)
+
+	diags.Append(fwflex.Flatten(ctx, output.HlsManifests, &data.HLSManifests)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, output.LowLatencyHlsManifests, &data.LowLatencyHLSManifests)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, output.Segment, &data.Segment)...)
+
+	return diags
+}
+
+type hlsManifestModel struct {
+	ChildManifestName              types.String                                  `tfsdk:"child_manifest_name"`
+	ManifestName                   types.String                                  `tfsdk:"manifest_name"`
+	ManifestWindowSeconds          types.Int64                                   `tfsdk:"manifest_window_seconds"`
+	ProgramDateTimeIntervalSeconds types.Int64                                   `tfsdk:"program_date_time_interval_seconds"`
+	ScteHLS                        fwtypes.ListNestedObjectValueOf[scteHLSModel] `tfsdk:"scte_hls"`
+	URL                            types.String                                  `tfsdk:"url"`
+}
+
+type scteHLSModel struct {
+	AdMarkerHLS fwtypes.StringEnum[awstypes.AdMarkerHls] `tfsdk:"ad_marker_hls"`
+}
+
+type segmentModel struct {
+	Encryption               fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	IncludeIframeOnlyStreams types.Bool                                       `tfsdk:"include_iframe_only_streams"`
+	Scte                     fwtypes.ListNestedObjectValueOf[scteModel]       `tfsdk:"scte"`
+	SegmentDurationSeconds   types.Int64                                      `tfsdk:"segment_duration_seconds"`
+	SegmentName              types.String                                     `tfsdk:"segment_name"`
+	TsIncludeDvbSubtitles    types.Bool                                       `tfsdk:"ts_include_dvb_subtitles"`
+	TsUseAudioRenditionGroup types.Bool                                       `tfsdk:"ts_use_audio_rendition_group"`
+}
+
+type scteModel struct {
+	ScteFilter fwtypes.SetValueOf[types.String] `tfsdk:"scte_filter"`
+}
+
+type encryptionModel struct {
+	ConstantInitializationVector types.String                                           `tfsdk:"constant_initialization_vector"`
+	EncryptionMethod             fwtypes.ListNestedObjectValueOf[encryptionMethodModel] `tfsdk:"encryption_method"`
+	KeyRotationIntervalSeconds   types.Int64                                            `tfsdk:"key_rotation_interval_seconds"`
+	SpekeKeyProvider             fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type encryptionMethodModel struct {
+	CmafEncryptionMethod fwtypes.StringEnum[awstypes.CmafEncryptionMethod] `tfsdk:"cmaf_encryption_method"`
+	TsEncryptionMethod   fwtypes.StringEnum[awstypes.TsEncryptionMethod]   `tfsdk:"ts_encryption_method"`
+}
+
+type spekeKeyProviderModel struct {
+	DrmSystems                      fwtypes.SetValueOf[types.String]                                      `tfsdk:"drm_systems"`
+	EncryptionContractConfiguration fwtypes.ListNestedObjectValueOf[encryptionContractConfigurationModel] `tfsdk:"encryption_contract_configuration"`
+	ResourceID                      types.String                                                          `tfsdk:"resource_id"`
+	RoleARN                         fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	URL                             types.String                                                          `tfsdk:"url"`
+}
+
+type encryptionContractConfigurationModel struct {
+	PresetSpeke20Audio fwtypes.StringEnum[awstypes.PresetSpeke20Audio] `tfsdk:"preset_speke20_audio"`
+	PresetSpeke20Video fwtypes.StringEnum[awstypes.PresetSpeke20Video] `tfsdk:"preset_speke20_video"`
+}
+
+// expandSegment is hand-written as AutoFlex cannot expand string sets into slices of enum values.
+func expandSegment(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[segmentModel]) (*awstypes.Segment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.Segment{
+		IncludeIframeOnlyStreams: fwflex.BoolFromFramework(ctx, data.IncludeIframeOnlyStreams),
+		SegmentDurationSeconds:   fwflex.Int32FromFramework(ctx, data.SegmentDurationSeconds),
+		SegmentName:              fwflex.StringFromFramework(ctx, data.SegmentName),
+		TsIncludeDvbSubtitles:    fwflex.BoolFromFramework(ctx, data.TsIncludeDvbSubtitles),
+		TsUseAudioRenditionGroup: fwflex.BoolFromFramework(ctx, data.TsUseAudioRenditionGroup),
+	}
+
+	scte, d := data.Scte.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if scte != nil {
+		apiObject.Scte = &awstypes.Scte{
+			ScteFilter: expandStringEnumSet[awstypes.ScteFilter](ctx, scte.ScteFilter),
+		}
+	}
+
+	encryption, d := data.Encryption.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if encryption != nil {
+		apiObject.Encryption, d = expandEncryption(ctx, encryption)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return apiObject, diags
+}
+
+func expandEncryption(ctx context.Context, data *encryptionModel) (*awstypes.Encryption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := &awstypes.Encryption{
+		ConstantInitializationVector: fwflex.StringFromFramework(ctx, data.ConstantInitializationVector),
+		KeyRotationIntervalSeconds:   fwflex.Int32FromFramework(ctx, data.KeyRotationIntervalSeconds),
+	}
+
+	encryptionMethod, d := data.EncryptionMethod.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if encryptionMethod != nil {
+		apiObject.EncryptionMethod = &awstypes.EncryptionMethod{
+			CmafEncryptionMethod: encryptionMethod.CmafEncryptionMethod.ValueEnum(),
+			TsEncryptionMethod:   encryptionMethod.TsEncryptionMethod.ValueEnum(),
+		}
+	}
+
+	spekeKeyProvider, d := data.SpekeKeyProvider.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if spekeKeyProvider != nil {
+		apiObject.SpekeKeyProvider = &awstypes.SpekeKeyProvider{
+			DrmSystems: expandStringEnumSet[awstypes.DrmSystem](ctx, spekeKeyProvider.DrmSystems),
+			ResourceId: fwflex.StringFromFramework(ctx, spekeKeyProvider.ResourceID),
+			RoleArn:    fwflex.StringFromFramework(ctx, spekeKeyProvider.RoleARN),
+			Url:        fwflex.StringFromFramework(ctx, spekeKeyProvider.URL),
+		}
+
+		encryptionContractConfiguration, d := spekeKeyProvider.EncryptionContractConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if encryptionContractConfiguration != nil {
+			apiObject.SpekeKeyProvider.EncryptionContractConfiguration = &awstypes.EncryptionContractConfiguration{
+				PresetSpeke20Audio: encryptionContractConfiguration.PresetSpeke20Audio.ValueEnum(),
+				PresetSpeke20Video: encryptionContractConfiguration.PresetSpeke20Video.ValueEnum(),
+			}
+		}
+	}
+
+	return apiObject, diags
+}
+
+func expandStringEnumSet[T ~string](ctx context.Context, v fwtypes.SetValueOf[types.String]) []T {
+	var apiObjects []T
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, v) {
+		apiObjects = append(apiObjects, T(v))
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackagev2", regexache.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_mediapackagev2_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "segment.0.segment_duration_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "0"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_window_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.scte_hls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.scte_hls.0.ad_marker_hls", "DATERANGE"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.0.manifest_name", "lowlatency"),
+					resource.TestCheckResourceAttrSet(resourceName, "low_latency_hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.scte.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.scte.0.scte_filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testAccOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel_group.test.name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "TS"
+  description              = "updated"
+  startover_window_seconds = 3600
+
+  segment {
+    segment_duration_seconds = 4
+
+    scte {
+      scte_filter = ["SPLICE_INSERT", "BREAK"]
+    }
+  }
+
+  hls_manifest {
+    manifest_name           = "index"
+    manifest_window_seconds = 120
+
+    scte_hls {
+      ad_marker_hls = "DATERANGE"
+    }
+  }
+
+  low_latency_hls_manifest {
+    manifest_name = "lowlatency"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
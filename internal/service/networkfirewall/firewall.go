@@ -47,6 +47,9 @@ func ResourceFirewall() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("endpoint_ids", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("subnet_mapping")
+			}),
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("subnet_mapping")
 			}),
@@ -68,6 +71,11 @@ func ResourceFirewall() *schema.Resource {
 				Optional: true,
 			},
 			"encryption_configuration": encryptionConfigurationSchema(),
+			"endpoint_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"firewall_policy_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -201,6 +209,10 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) create: %s", d.Id(), err)
 	}
 
+	if _, err := waitFirewallAttachmentsReady(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) subnets association: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceFirewallRead(ctx, d, meta)...)
 }
 
@@ -228,6 +240,7 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("encryption_configuration", flattenEncryptionConfiguration(firewall.EncryptionConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting encryption_configuration: %s", err)
 	}
+	d.Set("endpoint_ids", flattenEndpointIDs(output.FirewallStatus.SyncStates))
 	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
 	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
 	if err := d.Set("firewall_status", flattenFirewallStatus(output.FirewallStatus)); err != nil {
@@ -355,6 +368,34 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		o, n := d.GetChange("subnet_mapping")
 		subnetsToRemove, subnetsToAdd := subnetMappingsDiff(o.(*schema.Set), n.(*schema.Set))
 
+		// Subnets are disassociated first so that a subnet in an Availability Zone
+		// can be replaced by another subnet in the same Availability Zone.
+		if len(subnetsToRemove) > 0 {
+			input := &networkfirewall.DisassociateSubnetsInput{
+				FirewallArn: aws.String(d.Id()),
+				SubnetIds:   aws.StringSlice(subnetsToRemove),
+				UpdateToken: aws.String(updateToken),
+			}
+
+			_, err := conn.DisassociateSubnetsWithContext(ctx, input)
+
+			if err == nil {
+				updateToken, err = waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
+				}
+
+				updateToken, err = waitFirewallAttachmentsReady(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) subnets disassociation: %s", d.Id(), err)
+				}
+			} else if !tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidRequestException, "inaccessible") {
+				return sdkdiag.AppendErrorf(diags, "disassociating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
+			}
+		}
+
 		if len(subnetsToAdd) > 0 {
 			input := &networkfirewall.AssociateSubnetsInput{
 				FirewallArn:    aws.String(d.Id()),
@@ -368,30 +409,16 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "associating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
 			}
 
-			updateToken, err = waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+			_, err = waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
 			}
-		}
 
-		if len(subnetsToRemove) > 0 {
-			input := &networkfirewall.DisassociateSubnetsInput{
-				FirewallArn: aws.String(d.Id()),
-				SubnetIds:   aws.StringSlice(subnetsToRemove),
-				UpdateToken: aws.String(updateToken),
-			}
-
-			_, err := conn.DisassociateSubnetsWithContext(ctx, input)
+			_, err = waitFirewallAttachmentsReady(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
 
-			if err == nil {
-				/*updateToken*/ _, err = waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
-
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
-				}
-			} else if !tfawserr.ErrMessageContains(err, networkfirewall.ErrCodeInvalidRequestException, "inaccessible") {
-				return sdkdiag.AppendErrorf(diags, "disassociating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) subnets association: %s", d.Id(), err)
 			}
 		}
 	}
@@ -503,6 +530,57 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.NetworkFirew
 	return "", err
 }
 
+// statusFirewallAttachments returns the least ready status of the firewall's
+// per-Availability Zone subnet attachments.
+func statusFirewallAttachments(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFirewallByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := networkfirewall.AttachmentStatusReady
+
+		for availabilityZone, syncState := range output.FirewallStatus.SyncStates {
+			if syncState == nil || syncState.Attachment == nil {
+				continue
+			}
+
+			switch v := aws.StringValue(syncState.Attachment.Status); v {
+			case networkfirewall.AttachmentStatusError, networkfirewall.AttachmentStatusFailed:
+				return output, v, fmt.Errorf("%s (%s): %s", availabilityZone, aws.StringValue(syncState.Attachment.SubnetId), aws.StringValue(syncState.Attachment.StatusMessage))
+			case networkfirewall.AttachmentStatusReady:
+			default:
+				status = v
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitFirewallAttachmentsReady(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string) (string, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.AttachmentStatusCreating, networkfirewall.AttachmentStatusDeleting, networkfirewall.AttachmentStatusScaling},
+		Target:  []string{networkfirewall.AttachmentStatusReady},
+		Refresh: statusFirewallAttachments(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
+		return aws.StringValue(output.UpdateToken), err
+	}
+
+	return "", err
+}
+
 func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, arn string) (*networkfirewall.Firewall, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{networkfirewall.FirewallStatusValueDeleting},
@@ -583,6 +661,18 @@ func flattenSyncStates(s map[string]*networkfirewall.SyncState) []interface{} {
 	return syncStates
 }
 
+func flattenEndpointIDs(s map[string]*networkfirewall.SyncState) map[string]string {
+	endpointIDs := make(map[string]string, len(s))
+	for k, v := range s {
+		if v == nil || v.Attachment == nil || v.Attachment.EndpointId == nil {
+			continue
+		}
+		endpointIDs[k] = aws.StringValue(v.Attachment.EndpointId)
+	}
+
+	return endpointIDs
+}
+
 func flattenSyncStateAttachment(a *networkfirewall.Attachment) []interface{} {
 	if a == nil {
 		return nil
@@ -56,6 +56,11 @@ func DataSourceFirewall() *schema.Resource {
 					},
 				},
 			},
+			"endpoint_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"firewall_policy_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -226,6 +231,9 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("description", firewall.Description)
 	d.Set("name", firewall.FirewallName)
 	d.Set("encryption_configuration", flattenDataSourceEncryptionConfiguration(firewall.EncryptionConfiguration))
+	if output.FirewallStatus != nil {
+		d.Set("endpoint_ids", flattenEndpointIDs(output.FirewallStatus.SyncStates))
+	}
 	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
 	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
 	d.Set("firewall_status", flattenDataSourceFirewallStatus(output.FirewallStatus))
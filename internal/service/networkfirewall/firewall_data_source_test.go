@@ -39,6 +39,7 @@ func TestAccNetworkFirewallFirewallDataSource_arn(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.key_id", "AWS_OWNED_KMS_KEY"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_ids.%", resourceName, "endpoint_ids.%"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_policy_arn", policyResourceName, "arn"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.capacity_usage_summary.#", "0"),
@@ -87,6 +88,7 @@ func TestAccNetworkFirewallFirewallDataSource_name(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.key_id", "AWS_OWNED_KMS_KEY"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_ids.%", resourceName, "endpoint_ids.%"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_policy_arn", policyResourceName, "arn"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.capacity_usage_summary.#", "0"),
@@ -135,6 +137,7 @@ func TestAccNetworkFirewallFirewallDataSource_arnandname(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.key_id", "AWS_OWNED_KMS_KEY"),
 					resource.TestCheckResourceAttr(dataSourceName, "encryption_configuration.0.type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_ids.%", resourceName, "endpoint_ids.%"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_policy_arn", policyResourceName, "arn"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.#", "1"),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.capacity_usage_summary.#", "0"),
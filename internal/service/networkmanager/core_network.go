@@ -34,8 +34,6 @@ const (
 	coreNetworkStatePending = "PENDING"
 	// Minimum valid policy version id is 1
 	minimumValidPolicyVersionID = 1
-	// Using the following in the FindCoreNetworkPolicyByID function will default to get the latest policy version
-	latestPolicyVersionID = -1
 	// Wait time value for core network policy - the default update for the core network policy of 30 minutes is excessive
 	waitCoreNetworkPolicyCreatedTimeInMinutes = 5
 )
@@ -373,6 +371,19 @@ func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager
 		input.PolicyVersionId = aws.Int64(policyVersionID)
 	}
 
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicyByAlias(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
 	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -53,16 +54,25 @@ func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 					validation.StringLenBetween(0, 10000000),
 					validation.StringIsJSON,
 				),
-				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
 			},
+			"policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"track_latest_policy_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -93,12 +103,19 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 	d.Set("core_network_id", coreNetwork.CoreNetworkId)
 	d.Set("state", coreNetwork.State)
 
-	// getting the policy document uses a different API call
-	// pass in latestPolicyVersionId to get the latest version id by default
-	coreNetworkPolicy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), latestPolicyVersionID)
+	// getting the policy document uses a different API call.
+	// By default the LIVE policy version is read. When tracking the latest policy version,
+	// policy versions created outside of Terraform show up as drift and are replaced on apply.
+	alias := networkmanager.CoreNetworkPolicyAliasLive
+	if d.Get("track_latest_policy_version").(bool) {
+		alias = networkmanager.CoreNetworkPolicyAliasLatest
+	}
+
+	coreNetworkPolicy, err := findCoreNetworkPolicyByAlias(ctx, conn, d.Id(), alias)
 
 	if tfresource.NotFound(err) {
 		d.Set("policy_document", nil)
+		d.Set("policy_version_id", nil)
 	} else if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
 	} else {
@@ -108,7 +125,14 @@ func resourceCoreNetworkPolicyAttachmentRead(ctx context.Context, d *schema.Reso
 			return sdkdiag.AppendErrorf(diags, "encoding Network Manager Core Network (%s) policy document: %s", d.Id(), err)
 		}
 
-		d.Set("policy_document", encodedPolicyDocument)
+		policyDocument, err := structure.NormalizeJsonString(encodedPolicyDocument)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "normalizing Network Manager Core Network (%s) policy document: %s", d.Id(), err)
+		}
+
+		d.Set("policy_document", policyDocument)
+		d.Set("policy_version_id", coreNetworkPolicy.PolicyVersionId)
 	}
 	return diags
 }
@@ -9,9 +9,12 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -61,6 +64,44 @@ func TestAccNetworkManagerCoreNetworkPolicyAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_trackLatestPolicyVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+	segmentValue := "segmentValue1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreNetworkPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_trackLatestPolicyVersion(segmentValue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_version_id"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest_policy_version", "true"),
+					testAccCheckCoreNetworkPolicyAttachmentPutPolicy(ctx, resourceName, "outOfBandSegment"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_trackLatestPolicyVersion(segmentValue),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", fmt.Sprintf("{\"core-network-configuration\":{\"asn-ranges\":[\"65022-65534\"],\"edge-locations\":[{\"location\":\"%s\"}],\"vpn-ecmp-support\":true},\"segments\":[{\"isolate-attachments\":false,\"name\":\"%s\",\"require-attachment-acceptance\":true}],\"version\":\"2021.12\"}", acctest.Region(), segmentValue)),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerCoreNetworkPolicyAttachment_vpcAttachment(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
@@ -181,6 +222,39 @@ func testAccCheckCoreNetworkPolicyAttachmentExists(ctx context.Context, n string
 	}
 }
 
+// testAccCheckCoreNetworkPolicyAttachmentPutPolicy creates a new policy version outside of Terraform without executing its change set.
+func testAccCheckCoreNetworkPolicyAttachmentPutPolicy(ctx context.Context, n, segmentValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn(ctx)
+
+		policyDocument := aws.JSONValue{
+			"core-network-configuration": map[string]interface{}{
+				"asn-ranges": []interface{}{"65022-65534"},
+				"edge-locations": []interface{}{
+					map[string]interface{}{"location": acctest.Region()},
+				},
+			},
+			"segments": []interface{}{
+				map[string]interface{}{"name": segmentValue},
+			},
+			"version": "2021.12",
+		}
+
+		_, err := conn.PutCoreNetworkPolicyWithContext(ctx, &networkmanager.PutCoreNetworkPolicyInput{
+			ClientToken:    aws.String(id.UniqueId()),
+			CoreNetworkId:  aws.String(rs.Primary.ID),
+			PolicyDocument: policyDocument,
+		})
+
+		return err
+	}
+}
+
 func testAccCoreNetworkPolicyAttachmentConfig_basic(segmentValue string) string {
 	return fmt.Sprintf(`
 resource "aws_networkmanager_global_network" "test" {}
@@ -210,6 +284,36 @@ resource "aws_networkmanager_core_network_policy_attachment" "test" {
 `, segmentValue, acctest.Region())
 }
 
+func testAccCoreNetworkPolicyAttachmentConfig_trackLatestPolicyVersion(segmentValue string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[2]q
+    }
+  }
+
+  segments {
+    name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id             = aws_networkmanager_core_network.test.id
+  policy_document             = data.aws_networkmanager_core_network_policy_document.test.json
+  track_latest_policy_version = true
+}
+`, segmentValue, acctest.Region())
+}
+
 func testAccCoreNetworkPolicyAttachmentConfig_vpcAttachmentCreate() string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
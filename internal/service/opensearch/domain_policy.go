@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -104,6 +105,10 @@ func resourceDomainPolicyUpsert(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain Policy (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	if _, err := waitDomainAccessPoliciesActive(ctx, conn, domainName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain Policy (%s): waiting for access policies to become active: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceDomainPolicyRead(ctx, d, meta)...)
 }
 
@@ -111,19 +116,30 @@ func resourceDomainPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
 
+	domainName := d.Get("domain_name").(string)
 	_, err := conn.UpdateDomainConfigWithContext(ctx, &opensearchservice.UpdateDomainConfigInput{
-		DomainName:     aws.String(d.Get("domain_name").(string)),
+		DomainName:     aws.String(domainName),
 		AccessPolicies: aws.String(""),
 	})
+
+	// The domain may already have been destroyed, taking its policy with it.
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain Policy (%s): %s", d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Waiting for OpenSearch domain policy %q to be deleted", d.Get("domain_name").(string))
+	log.Printf("[DEBUG] Waiting for OpenSearch domain policy %q to be deleted", domainName)
 
-	if err := waitForDomainUpdate(ctx, conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waitForDomainUpdate(ctx, conn, domainName, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain Policy (%s): waiting for completion: %s", d.Id(), err)
 	}
 
+	if _, err := waitDomainAccessPoliciesActive(ctx, conn, domainName, d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Domain Policy (%s): waiting for access policies to become active: %s", d.Id(), err)
+	}
+
 	return diags
 }
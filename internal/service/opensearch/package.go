@@ -5,12 +5,15 @@ package opensearch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,11 +34,23 @@ func ResourcePackage() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("available_package_version", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("package_source")
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"available_package_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"package_description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -53,19 +68,16 @@ func ResourcePackage() *schema.Resource {
 			"package_source": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"s3_bucket_name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"s3_key": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -135,16 +147,26 @@ func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
 
-	input := &opensearchservice.UpdatePackageInput{
-		PackageID:          aws.String(d.Id()),
-		PackageDescription: aws.String(d.Get("package_description").(string)),
-		PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})[0].(map[string]interface{})),
-	}
+	if d.HasChanges("package_description", "package_source") {
+		input := &opensearchservice.UpdatePackageInput{
+			PackageID:          aws.String(d.Id()),
+			PackageDescription: aws.String(d.Get("package_description").(string)),
+			PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})[0].(map[string]interface{})),
+		}
+
+		if v, ok := d.GetOk("commit_message"); ok {
+			input.CommitMessage = aws.String(v.(string))
+		}
 
-	_, err := conn.UpdatePackageWithContext(ctx, input)
+		_, err := conn.UpdatePackageWithContext(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPackageAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourcePackageRead(ctx, d, meta)...)
@@ -224,6 +246,44 @@ func findPackages(ctx context.Context, conn *opensearchservice.OpenSearchService
 	return output, nil
 }
 
+func statusPackage(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PackageStatus), nil
+	}
+}
+
+func waitPackageAvailable(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusCopying, opensearchservice.PackageStatusValidating},
+		Target:  []string{opensearchservice.PackageStatusAvailable},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if details := output.ErrorDetails; details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(details.ErrorType), aws.StringValue(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandPackageSource(v interface{}) *opensearchservice.PackageSource {
 	if v == nil {
 		return nil
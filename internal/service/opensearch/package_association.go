@@ -26,6 +26,7 @@ func ResourcePackageAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePackageAssociationCreate,
 		ReadWithoutTimeout:   resourcePackageAssociationRead,
+		UpdateWithoutTimeout: resourcePackageAssociationUpdate,
 		DeleteWithoutTimeout: resourcePackageAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -34,9 +35,12 @@ func ResourcePackageAssociation() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourcePackageAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -48,6 +52,15 @@ func ResourcePackageAssociation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"package_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"reference_path": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -63,6 +76,12 @@ func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceDat
 	domainName := d.Get("domain_name").(string)
 	packageID := d.Get("package_id").(string)
 	id := fmt.Sprintf("%s-%s", domainName, packageID)
+
+	// A package can only be associated once it has been validated.
+	if _, err := waitPackageAvailable(ctx, conn, packageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) to become available: %s", packageID, err)
+	}
+
 	input := &opensearchservice.AssociatePackageInput{
 		DomainName: aws.String(domainName),
 		PackageID:  aws.String(packageID),
@@ -103,11 +122,47 @@ func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("domain_name", pkgAssociation.DomainName)
 	d.Set("package_id", pkgAssociation.PackageID)
+	d.Set("package_name", pkgAssociation.PackageName)
+	d.Set("package_version", pkgAssociation.PackageVersion)
 	d.Set("reference_path", pkgAssociation.ReferencePath)
 
 	return diags
 }
 
+func resourcePackageAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	if d.HasChange("package_version") {
+		domainName := d.Get("domain_name").(string)
+		packageID := d.Get("package_id").(string)
+
+		pkg, err := waitPackageAvailable(ctx, conn, packageID, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) to become available: %s", packageID, err)
+		}
+
+		// Associating an already associated package updates the domain to the package's latest available version.
+		input := &opensearchservice.AssociatePackageInput{
+			DomainName: aws.String(domainName),
+			PackageID:  aws.String(packageID),
+		}
+
+		_, err = conn.AssociatePackageWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPackageAssociationUpdated(ctx, conn, domainName, packageID, aws.StringValue(pkg.AvailablePackageVersion), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
 func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
@@ -135,6 +190,37 @@ func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func resourcePackageAssociationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only the latest available version of a package can be associated with a domain.
+	if !d.NewValueKnown("package_id") || !d.NewValueKnown("package_version") {
+		return nil
+	}
+
+	packageVersion := d.Get("package_version").(string)
+
+	if packageVersion == "" || !d.HasChange("package_version") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+	packageID := d.Get("package_id").(string)
+	pkg, err := FindPackageByID(ctx, conn, packageID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading OpenSearch Package (%s): %w", packageID, err)
+	}
+
+	if v := aws.StringValue(pkg.AvailablePackageVersion); v != "" && v != packageVersion {
+		return fmt.Errorf("package_version %q is not the latest available version (%s) of OpenSearch Package (%s)", packageVersion, v, packageID)
+	}
+
+	return nil
+}
+
 func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
 	input := &opensearchservice.ListPackagesForDomainInput{
 		DomainName: aws.String(domainName),
@@ -225,6 +311,51 @@ func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.
 	return nil, err
 }
 
+func statusPackageAssociationVersion(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID, packageVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.DomainPackageStatus)
+
+		// The association reports its previous version as active until the update starts.
+		if status == opensearchservice.DomainPackageStatusActive && packageVersion != "" && aws.StringValue(output.PackageVersion) != packageVersion {
+			return output, opensearchservice.DomainPackageStatusAssociating, nil
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitPackageAssociationUpdated(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID, packageVersion string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
+		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Refresh: statusPackageAssociationVersion(ctx, conn, domainName, packageID, packageVersion),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if status, details := aws.StringValue(output.DomainPackageStatus), output.ErrorDetails; status == opensearchservice.DomainPackageStatusAssociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(details.ErrorType), aws.StringValue(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -37,6 +38,43 @@ func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
 					testAccCheckPackageAssociationExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainResourceName, "domain_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "package_id", packageResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_name", packageResourceName, "package_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_packageVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	pkgName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+	packageResourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_packageVersion(pkgName, domainName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccPackageAssociationConfig_packageVersion(pkgName, domainName, "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(packageResourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
 				),
 			},
 		},
@@ -149,3 +187,45 @@ resource "aws_opensearch_package_association" "test" {
 }
 `, pkgName, domainName)
 }
+
+func testAccPackageAssociationConfig_packageVersion(pkgName, domainName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-%[3]s"
+  content = "quick,fast,speedy %[3]s"
+}
+
+resource "aws_opensearch_package" "test" {
+  package_name = %[1]q
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+  package_type = "TXT-DICTIONARY"
+}
+
+resource "aws_opensearch_domain" "test" {
+  domain_name = %[2]q
+
+  cluster_config {
+    instance_type = "t3.small.search" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_package_association" "test" {
+  package_id      = aws_opensearch_package.test.id
+  package_version = aws_opensearch_package.test.available_package_version
+  domain_name     = aws_opensearch_domain.test.domain_name
+}
+`, pkgName, domainName, version)
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,6 +53,52 @@ func TestAccOpenSearchPackage_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchPackage_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	pkgName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_update(pkgName, "v1", "description1", "Initial version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"commit_message",
+					"package_source", // This isn't returned by the API
+				},
+			},
+			{
+				Config: testAccPackageConfig_update(pkgName, "v2", "description2", "Add synonyms"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPackageExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "commit_message", "Add synonyms"),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "package_source.0.s3_key", fmt.Sprintf("%s-v2", pkgName)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchPackage_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	pkgName := testAccRandomDomainName()
@@ -139,3 +186,30 @@ resource "aws_opensearch_package" "test" {
 }
 `, rName)
 }
+
+func testAccPackageConfig_update(rName, version, description, commitMessage string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-%[2]s"
+  content = "quick,fast,speedy %[2]s"
+}
+
+resource "aws_opensearch_package" "test" {
+  package_name        = %[1]q
+  package_description = %[3]q
+  commit_message      = %[4]q
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+
+  package_type = "TXT-DICTIONARY"
+}
+`, rName, version, description, commitMessage)
+}
@@ -57,3 +57,25 @@ func domainConfigStatus(ctx context.Context, conn *opensearchservice.OpenSearchS
 		return out, ConfigStatusExists, nil
 	}
 }
+
+func statusDomainAccessPolicies(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeDomainConfigWithContext(ctx, &opensearchservice.DescribeDomainConfigInput{
+			DomainName: aws.String(name),
+		})
+
+		if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if out == nil || out.DomainConfig == nil || out.DomainConfig.AccessPolicies == nil || out.DomainConfig.AccessPolicies.Status == nil {
+			return nil, "", nil
+		}
+
+		return out.DomainConfig.AccessPolicies, aws.StringValue(out.DomainConfig.AccessPolicies.Status.State), nil
+	}
+}
@@ -159,3 +159,23 @@ func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearch
 
 	return err
 }
+
+// waitDomainAccessPoliciesActive waits for a domain's access policy change to propagate.
+// Data plane requests made before then may fail with 403 Forbidden.
+func waitDomainAccessPoliciesActive(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, timeout time.Duration) (*opensearchservice.AccessPoliciesStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{opensearchservice.OptionStateProcessing, opensearchservice.OptionStateRequiresIndexDocuments},
+		Target:     []string{opensearchservice.OptionStateActive},
+		Refresh:    statusDomainAccessPolicies(ctx, conn, domainName),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.AccessPoliciesStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
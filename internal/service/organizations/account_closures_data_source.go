@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_organizations_account_closures")
+func DataSourceAccountClosures() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAccountClosuresRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"all_closed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"closed_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"missing_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"pending_closure_account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAccountClosuresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
+
+	accounts, err := findAccounts(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Organizations Accounts: %s", err)
+	}
+
+	accountIDs := flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set))
+	accounts = filterAccountClosures(accounts, accountIDs)
+
+	// Requested accounts that aren't returned can't be confirmed as closed.
+	missingAccountIDs := slices.DeleteFunc(slices.Clone(accountIDs), func(id string) bool {
+		return slices.ContainsFunc(accounts, func(account *organizations.Account) bool {
+			return aws.StringValue(account.Id) == id
+		})
+	})
+
+	allClosed := len(accounts) > 0 && len(missingAccountIDs) == 0
+	var closedAccountIDs, pendingClosureAccountIDs []string
+
+	for _, account := range accounts {
+		switch id := aws.StringValue(account.Id); aws.StringValue(account.Status) {
+		case organizations.AccountStatusSuspended:
+			closedAccountIDs = append(closedAccountIDs, id)
+		case organizations.AccountStatusPendingClosure:
+			pendingClosureAccountIDs = append(pendingClosureAccountIDs, id)
+			allClosed = false
+		default:
+			// The closure of a requested account hasn't been registered yet.
+			allClosed = false
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	if err := d.Set("accounts", flattenAccounts(accounts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting accounts: %s", err)
+	}
+	d.Set("all_closed", allClosed)
+	d.Set("closed_account_ids", closedAccountIDs)
+	d.Set("missing_account_ids", missingAccountIDs)
+	d.Set("pending_closure_account_ids", pendingClosureAccountIDs)
+
+	return diags
+}
+
+// filterAccountClosures returns the specified accounts or, if none are specified,
+// the accounts that are closed or pending closure.
+func filterAccountClosures(accounts []*organizations.Account, accountIDs []string) []*organizations.Account {
+	var output []*organizations.Account
+
+	ids := make(map[string]struct{}, len(accountIDs))
+	for _, id := range accountIDs {
+		ids[id] = struct{}{}
+	}
+
+	for _, account := range accounts {
+		if account == nil {
+			continue
+		}
+
+		if len(ids) > 0 {
+			if _, ok := ids[aws.StringValue(account.Id)]; !ok {
+				continue
+			}
+		} else if status := aws.StringValue(account.Status); status != organizations.AccountStatusPendingClosure && status != organizations.AccountStatusSuspended {
+			continue
+		}
+
+		output = append(output, account)
+	}
+
+	return output
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccountClosuresDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_account_closures.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountClosuresDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accounts.0.id", "data.aws_organizations_organization.current", "master_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_closed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "closed_account_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "missing_account_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "pending_closure_account_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountClosuresDataSource_missing(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_account_closures.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountClosuresDataSourceConfig_missing,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_closed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "missing_account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "missing_account_ids.*", "000000000000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountClosuresDataSourceConfig_basic = `
+data "aws_organizations_organization" "current" {}
+
+data "aws_organizations_account_closures" "test" {
+  account_ids = [data.aws_organizations_organization.current.master_account_id]
+}
+`
+
+const testAccAccountClosuresDataSourceConfig_missing = `
+data "aws_organizations_account_closures" "test" {
+  account_ids = ["000000000000"]
+}
+`
@@ -38,11 +38,13 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource_delegatedAdministrator": testAccOrganizationDataSource_delegatedAdministrator,
 		},
 		"Account": {
-			"basic":           testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
-			"GovCloud":        testAccAccount_govCloud,
+			"basic":                      testAccAccount_basic,
+			"CloseOnDeletion":            testAccAccount_CloseOnDeletion,
+			"ParentId":                   testAccAccount_ParentID,
+			"Tags":                       testAccAccount_Tags,
+			"GovCloud":                   testAccAccount_govCloud,
+			"ClosuresDataSource_basic":   testAccAccountClosuresDataSource_basic,
+			"ClosuresDataSource_missing": testAccAccountClosuresDataSource_missing,
 		},
 		"OrganizationalUnit": {
 			"basic":                              testAccOrganizationalUnit_basic,
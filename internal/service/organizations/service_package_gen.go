@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAccountClosures,
+			TypeName: "aws_organizations_account_closures",
+		},
 		{
 			Factory:  DataSourceDelegatedAdministrators,
 			TypeName: "aws_organizations_delegated_administrators",
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Directory Registration")
+// @Tags(identifierAttribute="arn")
+func newDirectoryRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directoryRegistrationResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type directoryRegistrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *directoryRegistrationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_directory_registration"
+}
+
+func (r *directoryRegistrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"directory_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *directoryRegistrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directoryRegistrationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	input := &pcaconnectorad.CreateDirectoryRegistrationInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		DirectoryId: fwflex.StringFromFramework(ctx, data.DirectoryID),
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateDirectoryRegistration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating PCA Connector for AD Directory Registration", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.DirectoryRegistrationArn)
+	data.ID = data.ARN
+
+	if _, err := waitDirectoryRegistrationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCA Connector for AD Directory Registration (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryRegistrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directoryRegistrationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findDirectoryRegistrationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Directory Registration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.DirectoryID = fwflex.StringToFramework(ctx, output.DirectoryId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryRegistrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new directoryRegistrationResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags only.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directoryRegistrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directoryRegistrationResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteDirectoryRegistration(ctx, &pcaconnectorad.DeleteDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCA Connector for AD Directory Registration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDirectoryRegistrationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCA Connector for AD Directory Registration (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *directoryRegistrationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type directoryRegistrationResourceModel struct {
+	ARN         types.String   `tfsdk:"arn"`
+	DirectoryID types.String   `tfsdk:"directory_id"`
+	ID          types.String   `tfsdk:"id"`
+	Tags        types.Map      `tfsdk:"tags"`
+	TagsAll     types.Map      `tfsdk:"tags_all"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func findDirectoryRegistrationByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.DirectoryRegistration, error) {
+	input := &pcaconnectorad.GetDirectoryRegistrationInput{
+		DirectoryRegistrationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDirectoryRegistration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DirectoryRegistration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DirectoryRegistration, nil
+}
+
+func statusDirectoryRegistration(ctx context.Context, conn *pcaconnectorad.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDirectoryRegistrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDirectoryRegistrationCreated(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.DirectoryRegistration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectoryRegistrationStatusCreating),
+		Target:  enum.Slice(awstypes.DirectoryRegistrationStatusActive),
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDirectoryRegistrationDeleted(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.DirectoryRegistration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectoryRegistrationStatusActive, awstypes.DirectoryRegistrationStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDirectoryRegistration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DirectoryRegistration); ok {
+		tfresource.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+// Exports for use in tests only.
+var (
+	ResourceConnector                       = newConnectorResource
+	ResourceDirectoryRegistration           = newDirectoryRegistrationResource
+	ResourceServicePrincipalName            = newServicePrincipalNameResource
+	ResourceTemplate                        = newTemplateResource
+	ResourceTemplateGroupAccessControlEntry = newTemplateGroupAccessControlEntryResource
+
+	FindConnectorByARN                              = findConnectorByARN
+	FindDirectoryRegistrationByARN                  = findDirectoryRegistrationByARN
+	FindServicePrincipalNameByTwoPartKey            = findServicePrincipalNameByTwoPartKey
+	FindTemplateByARN                               = findTemplateByARN
+	FindTemplateGroupAccessControlEntryByTwoPartKey = findTemplateGroupAccessControlEntryByTwoPartKey
+)
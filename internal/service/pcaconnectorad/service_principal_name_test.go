@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADServicePrincipalName_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_service_principal_name.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServicePrincipalNameDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalNameConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServicePrincipalNameExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", "aws_pcaconnectorad_connector.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_registration_arn", "aws_pcaconnectorad_directory_registration.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADServicePrincipalName_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_service_principal_name.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServicePrincipalNameDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalNameConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServicePrincipalNameExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceServicePrincipalName, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServicePrincipalNameDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_service_principal_name" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(ctx, conn, rs.Primary.Attributes["directory_registration_arn"], rs.Primary.Attributes["connector_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCA Connector for AD Service Principal Name %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServicePrincipalNameExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		_, err := tfpcaconnectorad.FindServicePrincipalNameByTwoPartKey(ctx, conn, rs.Primary.Attributes["directory_registration_arn"], rs.Primary.Attributes["connector_arn"])
+
+		return err
+	}
+}
+
+func testAccServicePrincipalNameConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), `
+resource "aws_pcaconnectorad_directory_registration" "test" {
+  directory_id = aws_directory_service_directory.test.id
+}
+
+resource "aws_pcaconnectorad_service_principal_name" "test" {
+  connector_arn              = aws_pcaconnectorad_connector.test.arn
+  directory_registration_arn = aws_pcaconnectorad_directory_registration.test.arn
+}
+`)
+}
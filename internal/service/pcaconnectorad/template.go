@@ -0,0 +1,1274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("template_v3"),
+									path.MatchRelative().AtParent().AtName("template_v4"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": templateCertificateValidityBlock(ctx),
+									"enrollment_flags":     templateEnrollmentFlagsBlock(ctx),
+									"extensions":           templateExtensionsBlock(ctx),
+									"general_flags":        templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"crypto_providers": templateCryptoProvidersAttribute(),
+												"key_spec":         templateKeySpecAttribute(),
+												"minimal_key_length": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV2](),
+													Required:   true,
+												},
+												"exportable_key":                 templateFlagAttribute(),
+												"strong_key_protection_required": templateFlagAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV3Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Required:   true,
+									},
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": templateCertificateValidityBlock(ctx),
+									"enrollment_flags":     templateEnrollmentFlagsBlock(ctx),
+									"extensions":           templateExtensionsBlock(ctx),
+									"general_flags":        templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"algorithm": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+													Required:   true,
+												},
+												"crypto_providers": templateCryptoProvidersAttribute(),
+												"key_spec":         templateKeySpecAttribute(),
+												"minimal_key_length": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"key_usage_property": templateKeyUsagePropertyBlock(ctx, true),
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV3](),
+													Required:   true,
+												},
+												"exportable_key":                        templateFlagAttribute(),
+												"require_alternate_signature_algorithm": templateFlagAttribute(),
+												"strong_key_protection_required":        templateFlagAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v4": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV4Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"superseded_templates": templateSupersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": templateCertificateValidityBlock(ctx),
+									"enrollment_flags":     templateEnrollmentFlagsBlock(ctx),
+									"extensions":           templateExtensionsBlock(ctx),
+									"general_flags":        templateGeneralFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"algorithm": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+													Optional:   true,
+													Computed:   true,
+													PlanModifiers: []planmodifier.String{
+														stringplanmodifier.UseStateForUnknown(),
+													},
+												},
+												"crypto_providers": templateCryptoProvidersAttribute(),
+												"key_spec":         templateKeySpecAttribute(),
+												"minimal_key_length": schema.Int64Attribute{
+													Required: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"key_usage_property": templateKeyUsagePropertyBlock(ctx, false),
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV4Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV4](),
+													Required:   true,
+												},
+												"exportable_key":                        templateFlagAttribute(),
+												"require_alternate_signature_algorithm": templateFlagAttribute(),
+												"require_same_key_renewal":              templateFlagAttribute(),
+												"strong_key_protection_required":        templateFlagAttribute(),
+												"use_legacy_provider":                   templateFlagAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": templateSubjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateFlagAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func templateCryptoProvidersAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func templateKeySpecAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+		Required:   true,
+	}
+}
+
+func templateSupersededTemplatesAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func templateCertificateValidityBlock(ctx context.Context) schema.ListNestedBlock {
+	validityPeriodBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"period": schema.Int64Attribute{
+						Required: true,
+					},
+					"period_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"renewal_period":  validityPeriodBlock(),
+				"validity_period": validityPeriodBlock(),
+			},
+		},
+	}
+}
+
+func templateEnrollmentFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"enable_key_reuse_on_nt_token_keyset_storage_full": templateFlagAttribute(),
+				"include_symmetric_algorithms":                     templateFlagAttribute(),
+				"no_security_extension":                            templateFlagAttribute(),
+				"remove_invalid_certificate_from_personal_store":   templateFlagAttribute(),
+				"user_interaction_required":                        templateFlagAttribute(),
+			},
+		},
+	}
+}
+
+func templateExtensionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"application_policies": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": templateFlagAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"policy": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 100),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"policy_object_identifier": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.ExactlyOneOf(
+													path.MatchRelative().AtParent().AtName("policy_type"),
+												),
+											},
+										},
+										"policy_type": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"key_usage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": templateFlagAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"usage_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"data_encipherment": templateFlagAttribute(),
+										"digital_signature": templateFlagAttribute(),
+										"key_agreement":     templateFlagAttribute(),
+										"key_encipherment":  templateFlagAttribute(),
+										"non_repudiation":   templateFlagAttribute(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateGeneralFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"auto_enrollment": templateFlagAttribute(),
+				"machine_type":    templateFlagAttribute(),
+			},
+		},
+	}
+}
+
+func templateKeyUsagePropertyBlock(ctx context.Context, required bool) schema.ListNestedBlock {
+	validators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if required {
+		validators = append(validators, listvalidator.IsRequired(), listvalidator.SizeAtLeast(1))
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"property_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+					Optional:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"property_flags": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("property_type"),
+						),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"decrypt":       templateFlagAttribute(),
+							"key_agreement": templateFlagAttribute(),
+							"sign":          templateFlagAttribute(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateSubjectNameFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"require_common_name":        templateFlagAttribute(),
+				"require_directory_path":     templateFlagAttribute(),
+				"require_dns_as_cn":          templateFlagAttribute(),
+				"require_email":              templateFlagAttribute(),
+				"san_require_directory_guid": templateFlagAttribute(),
+				"san_require_dns":            templateFlagAttribute(),
+				"san_require_domain_dns":     templateFlagAttribute(),
+				"san_require_email":          templateFlagAttribute(),
+				"san_require_spn":            templateFlagAttribute(),
+				"san_require_upn":            templateFlagAttribute(),
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	// We can't use AutoFlEx with the top-level resource model because the API structure uses Go interfaces.
+	definition, diags := expandTemplateDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pcaconnectorad.CreateTemplateInput{
+		ClientToken:  aws.String(sdkid.UniqueId()),
+		ConnectorArn: fwflex.StringFromFramework(ctx, data.ConnectorARN),
+		Definition:   definition,
+		Name:         fwflex.StringFromFramework(ctx, data.Name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating PCA Connector for AD Template", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.ID = data.ARN
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, template)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	data.ARN = fwflex.StringToFramework(ctx, template.Arn)
+	data.ConnectorARN = fwflex.StringToFrameworkARN(ctx, template.ConnectorArn)
+	data.Name = fwflex.StringToFramework(ctx, template.Name)
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, template)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := expandTemplateDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: fwflex.BoolFromFramework(ctx, new.ReenrollAllCertificateHolders),
+			TemplateArn:                   fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCA Connector for AD Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	template, err := findTemplateByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.refreshFromOutput(ctx, template)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteTemplate(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCA Connector for AD Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Template.Status; status == awstypes.TemplateStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Template, nil
+}
+
+type templateResourceModel struct {
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          types.Map                                                `tfsdk:"tags"`
+	TagsAll                       types.Map                                                `tfsdk:"tags_all"`
+}
+
+func (data *templateResourceModel) refreshFromOutput(ctx context.Context, apiObject *awstypes.Template) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definition, d := flattenTemplateDefinition(ctx, apiObject.Definition)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Definition = definition
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, apiObject.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, apiObject.PolicySchema)
+
+	return diags
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                             `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                           `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                           `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool `tfsdk:"critical"`
+	// The field name deliberately doesn't match the AWS API structure's
+	// Policies union list so that AutoFlEx skips it.
+	ApplicationPolicy fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListValueOf[types.String]    `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+// privateKeyAttributesModel is used by both version 3 and version 4 templates.
+type privateKeyAttributesModel struct {
+	Algorithm       fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm] `tfsdk:"algorithm"`
+	CryptoProviders fwtypes.ListValueOf[types.String]                `tfsdk:"crypto_providers"`
+	KeySpec         fwtypes.StringEnum[awstypes.KeySpec]             `tfsdk:"key_spec"`
+	// The field name deliberately doesn't match the AWS API structure's
+	// KeyUsageProperty union so that AutoFlEx skips it.
+	UsageProperty    fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int64                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SanRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SanRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SanRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SanRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SanRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SanRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
+
+func expandTemplateDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[templateDefinitionModel]) (awstypes.TemplateDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if v2, d := data.TemplateV2.ToPtr(ctx); v2 != nil {
+		diags.Append(d...)
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV2{}
+		diags.Append(fwflex.Expand(ctx, v2, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, v2.Extensions)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	if v3, d := data.TemplateV3.ToPtr(ctx); v3 != nil {
+		diags.Append(d...)
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV3{}
+		diags.Append(fwflex.Expand(ctx, v3, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, v3.Extensions)
+			diags.Append(d...)
+		}
+
+		if v := apiObject.Value.PrivateKeyAttributes; v != nil {
+			v.KeyUsageProperty, d = expandKeyUsageProperty(ctx, v3.PrivateKeyAttributes)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	if v4, d := data.TemplateV4.ToPtr(ctx); v4 != nil {
+		diags.Append(d...)
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV4{}
+		diags.Append(fwflex.Expand(ctx, v4, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, v4.Extensions)
+			diags.Append(d...)
+		}
+
+		if v := apiObject.Value.PrivateKeyAttributes; v != nil {
+			v.KeyUsageProperty, d = expandKeyUsageProperty(ctx, v4.PrivateKeyAttributes)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandApplicationPolicies(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[extensionsModel]) ([]awstypes.ApplicationPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	extensions, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || extensions == nil {
+		return nil, diags
+	}
+
+	applicationPolicies, d := extensions.ApplicationPolicies.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || applicationPolicies == nil {
+		return nil, diags
+	}
+
+	policies, d := applicationPolicies.ApplicationPolicy.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.ApplicationPolicy
+
+	for _, policy := range policies {
+		if v := policy.PolicyObjectIdentifier; !v.IsNull() {
+			apiObjects = append(apiObjects, &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+				Value: v.ValueString(),
+			})
+		} else if v := policy.PolicyType; !v.IsNull() {
+			apiObjects = append(apiObjects, &awstypes.ApplicationPolicyMemberPolicyType{
+				Value: v.ValueEnum(),
+			})
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func expandKeyUsageProperty(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel]) (awstypes.KeyUsageProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	privateKeyAttributes, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || privateKeyAttributes == nil {
+		return nil, diags
+	}
+
+	keyUsageProperty, d := privateKeyAttributes.UsageProperty.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || keyUsageProperty == nil {
+		return nil, diags
+	}
+
+	if v := keyUsageProperty.PropertyType; !v.IsNull() {
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: v.ValueEnum(),
+		}, diags
+	}
+
+	propertyFlags, d := keyUsageProperty.PropertyFlags.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || propertyFlags == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.KeyUsagePropertyMemberPropertyFlags{}
+	diags.Append(fwflex.Expand(ctx, propertyFlags, &apiObject.Value)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenTemplateDefinition(ctx context.Context, apiObject awstypes.TemplateDefinition) (fwtypes.ListNestedObjectValueOf[templateDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &templateDefinitionModel{
+		TemplateV2: fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx),
+		TemplateV3: fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx),
+		TemplateV4: fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx),
+	}
+
+	// Structures containing unions are flattened separately.
+	switch v := apiObject.(type) {
+	case *awstypes.TemplateDefinitionMemberTemplateV2:
+		var v2 templateV2Model
+		value := v.Value
+		value.Extensions = nil
+		diags.Append(fwflex.Flatten(ctx, &value, &v2)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			var d diag.Diagnostics
+			v2.Extensions, d = flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+		}
+
+		data.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &v2)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV3:
+		var v3 templateV3Model
+		value := v.Value
+		value.Extensions, value.PrivateKeyAttributes = nil, nil
+		diags.Append(fwflex.Flatten(ctx, &value, &v3)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			var d diag.Diagnostics
+			v3.Extensions, d = flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+		}
+
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			var d diag.Diagnostics
+			v3.PrivateKeyAttributes, d = flattenPrivateKeyAttributes(ctx, v, v.KeyUsageProperty)
+			diags.Append(d...)
+		}
+
+		data.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &v3)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV4:
+		var v4 templateV4Model
+		value := v.Value
+		value.Extensions, value.PrivateKeyAttributes = nil, nil
+		diags.Append(fwflex.Flatten(ctx, &value, &v4)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			var d diag.Diagnostics
+			v4.Extensions, d = flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+		}
+
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			var d diag.Diagnostics
+			v4.PrivateKeyAttributes, d = flattenPrivateKeyAttributes(ctx, v, v.KeyUsageProperty)
+			diags.Append(d...)
+		}
+
+		data.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &v4)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+func flattenExtensions(ctx context.Context, keyUsage *awstypes.KeyUsage, applicationPolicies *awstypes.ApplicationPolicies) (fwtypes.ListNestedObjectValueOf[extensionsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	extensions := &extensionsModel{
+		ApplicationPolicies: fwtypes.NewListNestedObjectValueOfNull[applicationPoliciesModel](ctx),
+		KeyUsage:            fwtypes.NewListNestedObjectValueOfNull[keyUsageModel](ctx),
+	}
+
+	if keyUsage != nil {
+		var data keyUsageModel
+		diags.Append(fwflex.Flatten(ctx, keyUsage, &data)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx), diags
+		}
+
+		extensions.KeyUsage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	if applicationPolicies != nil {
+		var policies []*applicationPolicyModel
+
+		for _, apiObject := range applicationPolicies.Policies {
+			policy := &applicationPolicyModel{
+				PolicyObjectIdentifier: types.StringNull(),
+				PolicyType:             fwtypes.StringEnumNull[awstypes.ApplicationPolicyType](),
+			}
+
+			switch v := apiObject.(type) {
+			case *awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+				policy.PolicyObjectIdentifier = types.StringValue(v.Value)
+			case *awstypes.ApplicationPolicyMemberPolicyType:
+				policy.PolicyType = fwtypes.StringEnumValue(v.Value)
+			default:
+				continue
+			}
+
+			policies = append(policies, policy)
+		}
+
+		extensions.ApplicationPolicies = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &applicationPoliciesModel{
+			ApplicationPolicy: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policies),
+			Critical:          fwflex.BoolToFramework(ctx, applicationPolicies.Critical),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, extensions), diags
+}
+
+func flattenPrivateKeyAttributes[T awstypes.PrivateKeyAttributesV3 | awstypes.PrivateKeyAttributesV4](ctx context.Context, apiObject *T, keyUsageProperty awstypes.KeyUsageProperty) (fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data privateKeyAttributesModel
+
+	// The KeyUsageProperty union has no matching model field and is skipped.
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesModel](ctx), diags
+	}
+
+	data.UsageProperty = fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyModel](ctx)
+
+	switch v := keyUsageProperty.(type) {
+	case *awstypes.KeyUsagePropertyMemberPropertyFlags:
+		var propertyFlags keyUsagePropertyFlagsModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &propertyFlags)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesModel](ctx), diags
+		}
+
+		data.UsageProperty = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyModel{
+			PropertyFlags: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &propertyFlags),
+			PropertyType:  fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType](),
+		})
+	case *awstypes.KeyUsagePropertyMemberPropertyType:
+		data.UsageProperty = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyModel{
+			PropertyFlags: fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx),
+			PropertyType:  fwtypes.StringEnumValue(v.Value),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
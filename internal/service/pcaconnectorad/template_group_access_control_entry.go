@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template Group Access Control Entry")
+func newTemplateGroupAccessControlEntryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateGroupAccessControlEntryResource{}
+
+	return r, nil
+}
+
+type templateGroupAccessControlEntryResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *templateGroupAccessControlEntryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template_group_access_control_entry"
+}
+
+func (r *templateGroupAccessControlEntryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_display_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 256),
+				},
+			},
+			"group_security_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(7, 256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"template_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_rights": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessRightsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_enroll": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"enroll": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateGroupAccessControlEntryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	input := &pcaconnectorad.CreateTemplateGroupAccessControlEntryInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	_, err := conn.CreateTemplateGroupAccessControlEntry(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating PCA Connector for AD Template Group Access Control Entry", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, data.TemplateARN.ValueString(), data.GroupSecurityIdentifier.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template Group Access Control Entry (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, data.TemplateARN.ValueString(), data.GroupSecurityIdentifier.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template Group Access Control Entry (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateGroupAccessControlEntryResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.AccessRights.Equal(old.AccessRights) || !new.GroupDisplayName.Equal(old.GroupDisplayName) {
+		input := &pcaconnectorad.UpdateTemplateGroupAccessControlEntryInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTemplateGroupAccessControlEntry(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCA Connector for AD Template Group Access Control Entry (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, new.TemplateARN.ValueString(), new.GroupSecurityIdentifier.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template Group Access Control Entry (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateGroupAccessControlEntryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteTemplateGroupAccessControlEntry(ctx, &pcaconnectorad.DeleteTemplateGroupAccessControlEntryInput{
+		GroupSecurityIdentifier: fwflex.StringFromFramework(ctx, data.GroupSecurityIdentifier),
+		TemplateArn:             fwflex.StringFromFramework(ctx, data.TemplateARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCA Connector for AD Template Group Access Control Entry (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type templateGroupAccessControlEntryResourceModel struct {
+	AccessRights            fwtypes.ListNestedObjectValueOf[accessRightsModel] `tfsdk:"access_rights"`
+	GroupDisplayName        types.String                                       `tfsdk:"group_display_name"`
+	GroupSecurityIdentifier types.String                                       `tfsdk:"group_security_identifier"`
+	ID                      types.String                                       `tfsdk:"id"`
+	TemplateARN             fwtypes.ARN                                        `tfsdk:"template_arn"`
+}
+
+type accessRightsModel struct {
+	AutoEnroll fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"auto_enroll"`
+	Enroll     fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"enroll"`
+}
+
+const (
+	templateGroupAccessControlEntryResourceIDPartCount = 2
+)
+
+func (data *templateGroupAccessControlEntryResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, templateGroupAccessControlEntryResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	templateARN, diags := fwtypes.ARNValue(parts[0])
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	data.TemplateARN = templateARN
+	data.GroupSecurityIdentifier = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *templateGroupAccessControlEntryResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.TemplateARN.ValueString(), data.GroupSecurityIdentifier.ValueString()}, templateGroupAccessControlEntryResourceIDPartCount, false)))
+}
+
+func findTemplateGroupAccessControlEntryByTwoPartKey(ctx context.Context, conn *pcaconnectorad.Client, templateARN, groupSecurityIdentifier string) (*awstypes.AccessControlEntry, error) {
+	input := &pcaconnectorad.GetTemplateGroupAccessControlEntryInput{
+		GroupSecurityIdentifier: aws.String(groupSecurityIdentifier),
+		TemplateArn:             aws.String(templateARN),
+	}
+
+	output, err := conn.GetTemplateGroupAccessControlEntry(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessControlEntry == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessControlEntry, nil
+}
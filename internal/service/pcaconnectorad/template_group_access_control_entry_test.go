@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.auto_enroll", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.enroll", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "group_display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "template_arn", "aws_pcaconnectorad_template.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "DENY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.auto_enroll", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.enroll", "ALLOW"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplateGroupAccessControlEntry, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entry" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCA Connector for AD Template Group Access Control Entry %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntryExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+		return err
+	}
+}
+
+func testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, autoEnroll string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entry" "test" {
+  group_display_name        = %[1]q
+  group_security_identifier = "S-1-5-21-1234567890-1234567890-1234567890-1234"
+  template_arn              = aws_pcaconnectorad_template.test.arn
+
+  access_rights {
+    auto_enroll = %[2]q
+    enroll      = "ALLOW"
+  }
+}
+`, rName, autoEnroll))
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_arn", "aws_pcaconnectorad_connector.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v2.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.certificate_validity.0.validity_period.0.period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.certificate_validity.0.validity_period.0.period_type", "YEARS"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.extensions.0.key_usage.0.usage_flags.0.digital_signature", "true"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.key_spec", "SIGNATURE"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.template_v4.0.private_key_attributes.0.minimal_key_length", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "object_identifier"),
+					resource.TestCheckResourceAttr(resourceName, "policy_schema", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reenroll_all_certificate_holders"},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Template
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCA Connector for AD Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string, v *awstypes.Template) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTemplateConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template" "test" {
+  connector_arn = aws_pcaconnectorad_connector.test.arn
+  name          = %[1]q
+
+  definition {
+    template_v4 {
+      certificate_validity {
+        renewal_period {
+          period      = 6
+          period_type = "WEEKS"
+        }
+
+        validity_period {
+          period      = 1
+          period_type = "YEARS"
+        }
+      }
+
+      enrollment_flags {
+        include_symmetric_algorithms = true
+        user_interaction_required    = true
+      }
+
+      extensions {
+        key_usage {
+          usage_flags {
+            digital_signature = true
+            key_encipherment  = true
+          }
+        }
+      }
+
+      general_flags {
+        auto_enrollment = true
+      }
+
+      private_key_attributes {
+        key_spec           = "SIGNATURE"
+        minimal_key_length = 2048
+      }
+
+      private_key_flags {
+        client_version = "WINDOWS_SERVER_2012"
+      }
+
+      subject_name_flags {
+        require_common_name = true
+      }
+    }
+  }
+}
+`, rName))
+}
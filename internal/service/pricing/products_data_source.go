@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	termTypeOnDemand = "OnDemand"
+	termTypeReserved = "Reserved"
+)
+
+func termType_Values() []string {
+	return []string{
+		termTypeOnDemand,
+		termTypeReserved,
+	}
+}
+
+// @SDKDataSource("aws_pricing_products", name="Products")
+func dataSourceProducts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProductsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.FilterTypeTermMatch),
+							ValidateDiagFunc: enum.Validate[types.FilterType](),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"product_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sku": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"terms": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"effective_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"offer_term_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"price_dimensions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"begin_range": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"description": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"end_range": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"price_per_unit": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rate_code": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"unit": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"term_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(termType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PricingClient(ctx)
+
+	input := &pricing.GetProductsInput{
+		Filters:     []types.Filter{},
+		ServiceCode: aws.String(d.Get("service_code").(string)),
+	}
+
+	for _, v := range d.Get("filters").([]interface{}) {
+		m := v.(map[string]interface{})
+		input.Filters = append(input.Filters, types.Filter{
+			Field: aws.String(m["field"].(string)),
+			Type:  types.FilterType(m["type"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	priceList, err := findPriceList(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pricing Products: %s", err)
+	}
+
+	termTypes := flex.ExpandStringValueSet(d.Get("term_types").(*schema.Set))
+	if len(termTypes) == 0 {
+		termTypes = termType_Values()
+	}
+
+	products := make([]interface{}, 0, len(priceList))
+	for _, v := range priceList {
+		product, err := flattenPriceListItem(v, termTypes)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Pricing Products: %s", err)
+		}
+
+		products = append(products, product)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(fmt.Sprintf("%#v", input))))
+	if err := d.Set("products", products); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting products: %s", err)
+	}
+
+	return diags
+}
+
+func findPriceList(ctx context.Context, conn *pricing.Client, input *pricing.GetProductsInput) ([]string, error) {
+	var output []string
+
+	pages := pricing.NewGetProductsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PriceList...)
+	}
+
+	return output, nil
+}
+
+// priceListItem is the subset of a GetProducts price list JSON document that is exposed as structured attributes.
+type priceListItem struct {
+	Product struct {
+		Attributes    map[string]string `json:"attributes"`
+		ProductFamily string            `json:"productFamily"`
+		SKU           string            `json:"sku"`
+	} `json:"product"`
+	ServiceCode string                                  `json:"serviceCode"`
+	Terms       map[string]map[string]priceListItemTerm `json:"terms"`
+}
+
+type priceListItemTerm struct {
+	EffectiveDate   string                                     `json:"effectiveDate"`
+	OfferTermCode   string                                     `json:"offerTermCode"`
+	PriceDimensions map[string]priceListItemTermPriceDimension `json:"priceDimensions"`
+	TermAttributes  map[string]string                          `json:"termAttributes"`
+}
+
+type priceListItemTermPriceDimension struct {
+	BeginRange   string            `json:"beginRange"`
+	Description  string            `json:"description"`
+	EndRange     string            `json:"endRange"`
+	PricePerUnit map[string]string `json:"pricePerUnit"`
+	RateCode     string            `json:"rateCode"`
+	Unit         string            `json:"unit"`
+}
+
+func flattenPriceListItem(v string, termTypes []string) (map[string]interface{}, error) {
+	var item priceListItem
+
+	if err := json.Unmarshal([]byte(v), &item); err != nil {
+		return nil, fmt.Errorf("decoding price list item: %w", err)
+	}
+
+	tfMap := map[string]interface{}{
+		"attributes":     item.Product.Attributes,
+		"product_family": item.Product.ProductFamily,
+		"result":         v,
+		"service_code":   item.ServiceCode,
+		"sku":            item.Product.SKU,
+	}
+
+	var terms []interface{}
+	for _, termType := range termTypes {
+		offerTermCodes := make([]string, 0, len(item.Terms[termType]))
+		for k := range item.Terms[termType] {
+			offerTermCodes = append(offerTermCodes, k)
+		}
+		slices.Sort(offerTermCodes)
+
+		for _, k := range offerTermCodes {
+			term := item.Terms[termType][k]
+
+			rateCodes := make([]string, 0, len(term.PriceDimensions))
+			for k := range term.PriceDimensions {
+				rateCodes = append(rateCodes, k)
+			}
+			slices.Sort(rateCodes)
+
+			var priceDimensions []interface{}
+			for _, k := range rateCodes {
+				priceDimension := term.PriceDimensions[k]
+				priceDimensions = append(priceDimensions, map[string]interface{}{
+					"begin_range":    priceDimension.BeginRange,
+					"description":    priceDimension.Description,
+					"end_range":      priceDimension.EndRange,
+					"price_per_unit": priceDimension.PricePerUnit,
+					"rate_code":      priceDimension.RateCode,
+					"unit":           priceDimension.Unit,
+				})
+			}
+
+			terms = append(terms, map[string]interface{}{
+				"attributes":       term.TermAttributes,
+				"effective_date":   term.EffectiveDate,
+				"offer_term_code":  term.OfferTermCode,
+				"price_dimensions": priceDimensions,
+				"type":             termType,
+			})
+		}
+	}
+	tfMap["terms"] = terms
+
+	return tfMap, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPricingProductsDataSource_ec2(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_pricing_products.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PricingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductsDataSourceConfig_ec2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.service_code", "AmazonEC2"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.product_family", "Compute Instance"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.attributes.operatingSystem", "Linux"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "products.0.sku"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.terms.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.terms.0.type", "OnDemand"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "products.0.terms.0.price_dimensions.0.price_per_unit.USD"),
+					acctest.CheckResourceAttrIsJSONString(dataSourceName, "products.0.result"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProductsDataSourceConfig_ec2 = `
+data "aws_region" "current" {}
+
+data "aws_pricing_products" "test" {
+  service_code = "AmazonEC2"
+  term_types   = ["OnDemand"]
+
+  filters {
+    field = "instanceType"
+    value = "c5.large"
+  }
+
+  filters {
+    field = "operatingSystem"
+    value = "Linux"
+  }
+
+  filters {
+    field = "location"
+    value = data.aws_region.current.description
+  }
+
+  filters {
+    field = "preInstalledSw"
+    value = "NA"
+  }
+
+  filters {
+    field = "licenseModel"
+    value = "No License required"
+  }
+
+  filters {
+    field = "tenancy"
+    value = "Shared"
+  }
+
+  filters {
+    field = "marketoption"
+    value = "OnDemand"
+  }
+
+  filters {
+    field = "capacitystatus"
+    value = "Used"
+  }
+}
+`
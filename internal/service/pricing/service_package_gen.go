@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceProduct,
 			TypeName: "aws_pricing_product",
 		},
+		{
+			Factory:  dataSourceProducts,
+			TypeName: "aws_pricing_products",
+			Name:     "Products",
+		},
 	}
 }
 
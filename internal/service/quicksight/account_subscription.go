@@ -30,6 +30,7 @@ func ResourceAccountSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountSubscriptionCreate,
 		ReadWithoutTimeout:   resourceAccountSubscriptionRead,
+		UpdateWithoutTimeout: resourceAccountSubscriptionUpdate,
 		DeleteWithoutTimeout: resourceAccountSubscriptionDelete,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -86,6 +87,11 @@ func ResourceAccountSubscription() *schema.Resource {
 					Optional: true,
 					ForceNew: true,
 				},
+				"default_namespace": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
 				"directory_id": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -115,7 +121,6 @@ func ResourceAccountSubscription() *schema.Resource {
 				"notification_email": {
 					Type:     schema.TypeString,
 					Required: true,
-					ForceNew: true,
 				},
 				"reader_group": {
 					Type:     schema.TypeList,
@@ -129,6 +134,11 @@ func ResourceAccountSubscription() *schema.Resource {
 					Optional: true,
 					ForceNew: true,
 				},
+				"termination_protection_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
 			}
 		},
 	}
@@ -209,6 +219,38 @@ func resourceAccountSubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAccountSubscription, d.Id(), err)
 	}
 
+	// Account settings can only be configured once the subscription exists.
+	settingsIn := &quicksight.UpdateAccountSettingsInput{
+		AwsAccountId:      aws.String(d.Id()),
+		NotificationEmail: aws.String(d.Get("notification_email").(string)),
+	}
+	updateSettings := false
+
+	if v, ok := d.GetOk("default_namespace"); ok {
+		settingsIn.DefaultNamespace = aws.String(v.(string))
+		updateSettings = true
+	}
+
+	if v := d.GetRawConfig().GetAttr("termination_protection_enabled"); v.IsKnown() && !v.IsNull() {
+		settingsIn.TerminationProtectionEnabled = aws.Bool(v.True())
+		updateSettings = true
+	}
+
+	if updateSettings {
+		if settingsIn.DefaultNamespace == nil {
+			settings, err := findAccountSettingsByID(ctx, conn, d.Id())
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAccountSubscription, d.Id(), err)
+			}
+
+			settingsIn.DefaultNamespace = settings.DefaultNamespace
+		}
+
+		if _, err := conn.UpdateAccountSettingsWithContext(ctx, settingsIn); err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameAccountSubscription, d.Id(), err)
+		}
+	}
+
 	return resourceAccountSubscriptionRead(ctx, d, meta)
 }
 
@@ -238,9 +280,36 @@ func resourceAccountSubscriptionRead(ctx context.Context, d *schema.ResourceData
 	d.Set("notification_email", out.NotificationEmail)
 	d.Set("account_subscription_status", out.AccountSubscriptionStatus)
 
+	settings, err := findAccountSettingsByID(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameAccountSubscription, d.Id(), err)
+	}
+
+	d.Set("default_namespace", settings.DefaultNamespace)
+	d.Set("termination_protection_enabled", settings.TerminationProtectionEnabled)
+
 	return nil
 }
 
+func resourceAccountSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	if d.HasChanges("default_namespace", "notification_email", "termination_protection_enabled") {
+		in := &quicksight.UpdateAccountSettingsInput{
+			AwsAccountId:                 aws.String(d.Id()),
+			DefaultNamespace:             aws.String(d.Get("default_namespace").(string)),
+			NotificationEmail:            aws.String(d.Get("notification_email").(string)),
+			TerminationProtectionEnabled: aws.Bool(d.Get("termination_protection_enabled").(bool)),
+		}
+
+		if _, err := conn.UpdateAccountSettingsWithContext(ctx, in); err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameAccountSubscription, d.Id(), err)
+		}
+	}
+
+	return resourceAccountSubscriptionRead(ctx, d, meta)
+}
+
 func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
 
@@ -345,3 +414,26 @@ func FindAccountSubscriptionByID(ctx context.Context, conn *quicksight.QuickSigh
 
 	return out.AccountInfo, nil
 }
+
+func findAccountSettingsByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.AccountSettings, error) {
+	in := &quicksight.DescribeAccountSettingsInput{
+		AwsAccountId: aws.String(id),
+	}
+	out, err := conn.DescribeAccountSettingsWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AccountSettings == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AccountSettings, nil
+}
@@ -54,6 +54,44 @@ func testAccAccountSubscription_basic(t *testing.T) {
 	})
 }
 
+func testAccAccountSubscription_accountSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription quicksight.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+	email := acctest.RandomEmailAddress(acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_accountSettings(rName, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "default_namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAccountSubscriptionConfig_accountSettings(rName, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "default_namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", email),
+					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAccountSubscription_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var accountsubscription quicksight.AccountInfo
@@ -170,3 +208,15 @@ resource "aws_quicksight_account_subscription" "test" {
 }
 `, rName, acctest.DefaultEmailAddress)
 }
+
+func testAccAccountSubscriptionConfig_accountSettings(rName, email string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name                   = %[1]q
+  authentication_method          = "IAM_AND_QUICKSIGHT"
+  edition                        = "ENTERPRISE"
+  notification_email             = %[2]q
+  termination_protection_enabled = false
+}
+`, rName, email)
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -59,7 +60,7 @@ func ResourceDataSource() *schema.Resource {
 								Type:          schema.TypeString,
 								Optional:      true,
 								ValidateFunc:  verify.ValidARN,
-								ConflictsWith: []string{"credentials.0.credential_pair"},
+								ConflictsWith: []string{"credentials.0.credential_pair", "credentials.0.secret_arn"},
 							},
 							"credential_pair": {
 								Type:     schema.TypeList,
@@ -87,7 +88,13 @@ func ResourceDataSource() *schema.Resource {
 										},
 									},
 								},
-								ConflictsWith: []string{"credentials.0.copy_source_arn"},
+								ConflictsWith: []string{"credentials.0.copy_source_arn", "credentials.0.secret_arn"},
+							},
+							"secret_arn": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ValidateFunc:  verify.ValidARN,
+								ConflictsWith: []string{"credentials.0.copy_source_arn", "credentials.0.credential_pair"},
 							},
 						},
 					},
@@ -205,6 +212,30 @@ func ResourceDataSource() *schema.Resource {
 									},
 								},
 							},
+							"databricks": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"host": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										"port": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"sql_endpoint_path": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
 							"jira": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -494,6 +525,35 @@ func ResourceDataSource() *schema.Resource {
 									},
 								},
 							},
+							"starburst": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"catalog": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										"host": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										"port": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"product_type": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(quicksight.StarburstProductType_Values(), false),
+										},
+									},
+								},
+							},
 							"teradata": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -518,6 +578,30 @@ func ResourceDataSource() *schema.Resource {
 									},
 								},
 							},
+							"trino": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"catalog": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										"host": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										"port": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
 							"twitter": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -595,9 +679,12 @@ func ResourceDataSource() *schema.Resource {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"vpc_connection_arn": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: verify.ValidARN,
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									verify.ValidARN,
+									validation.StringMatch(regexache.MustCompile(`:quicksight:[^:]*:[0-9]{12}:vpcConnection/.+$`), "must be the ARN of a QuickSight VPC connection"),
+								),
 							},
 						},
 					},
@@ -848,6 +935,10 @@ func expandDataSourceCredentials(tfList []interface{}) *quicksight.DataSourceCre
 		credentials.CredentialPair = expandDataSourceCredentialPair(v)
 	}
 
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		credentials.SecretArn = aws.String(v)
+	}
+
 	return credentials
 }
 
@@ -966,6 +1057,25 @@ func expandDataSourceParameters(tfList []interface{}) *quicksight.DataSourcePara
 		}
 	}
 
+	if v := tfMap["databricks"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m, ok := v[0].(map[string]interface{})
+
+		if ok {
+			ps := &quicksight.DatabricksParameters{}
+			if v, ok := m["host"].(string); ok && v != "" {
+				ps.Host = aws.String(v)
+			}
+			if v, ok := m["port"].(int); ok {
+				ps.Port = aws.Int64(int64(v))
+			}
+			if v, ok := m["sql_endpoint_path"].(string); ok && v != "" {
+				ps.SqlEndpointPath = aws.String(v)
+			}
+
+			dataSourceParams.DatabricksParameters = ps
+		}
+	}
+
 	if v := tfMap["jira"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		m, ok := v[0].(map[string]interface{})
 
@@ -1208,6 +1318,28 @@ func expandDataSourceParameters(tfList []interface{}) *quicksight.DataSourcePara
 		}
 	}
 
+	if v := tfMap["starburst"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m, ok := v[0].(map[string]interface{})
+
+		if ok {
+			ps := &quicksight.StarburstParameters{}
+			if v, ok := m["catalog"].(string); ok && v != "" {
+				ps.Catalog = aws.String(v)
+			}
+			if v, ok := m["host"].(string); ok && v != "" {
+				ps.Host = aws.String(v)
+			}
+			if v, ok := m["port"].(int); ok {
+				ps.Port = aws.Int64(int64(v))
+			}
+			if v, ok := m["product_type"].(string); ok && v != "" {
+				ps.ProductType = aws.String(v)
+			}
+
+			dataSourceParams.StarburstParameters = ps
+		}
+	}
+
 	if v := tfMap["teradata"].([]interface{}); ok && len(v) > 0 && v != nil {
 		m, ok := v[0].(map[string]interface{})
 
@@ -1228,6 +1360,25 @@ func expandDataSourceParameters(tfList []interface{}) *quicksight.DataSourcePara
 		}
 	}
 
+	if v := tfMap["trino"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m, ok := v[0].(map[string]interface{})
+
+		if ok {
+			ps := &quicksight.TrinoParameters{}
+			if v, ok := m["catalog"].(string); ok && v != "" {
+				ps.Catalog = aws.String(v)
+			}
+			if v, ok := m["host"].(string); ok && v != "" {
+				ps.Host = aws.String(v)
+			}
+			if v, ok := m["port"].(int); ok {
+				ps.Port = aws.Int64(int64(v))
+			}
+
+			dataSourceParams.TrinoParameters = ps
+		}
+	}
+
 	if v := tfMap["twitter"].([]interface{}); ok && len(v) > 0 && v != nil {
 		m, ok := v[0].(map[string]interface{})
 
@@ -1348,6 +1499,18 @@ func flattenParameters(parameters *quicksight.DataSourceParameters) []interface{
 		})
 	}
 
+	if parameters.DatabricksParameters != nil {
+		params = append(params, map[string]interface{}{
+			"databricks": []interface{}{
+				map[string]interface{}{
+					"host":              parameters.DatabricksParameters.Host,
+					"port":              parameters.DatabricksParameters.Port,
+					"sql_endpoint_path": parameters.DatabricksParameters.SqlEndpointPath,
+				},
+			},
+		})
+	}
+
 	if parameters.JiraParameters != nil {
 		params = append(params, map[string]interface{}{
 			"jira": []interface{}{
@@ -1502,6 +1665,19 @@ func flattenParameters(parameters *quicksight.DataSourceParameters) []interface{
 		})
 	}
 
+	if parameters.StarburstParameters != nil {
+		params = append(params, map[string]interface{}{
+			"starburst": []interface{}{
+				map[string]interface{}{
+					"catalog":      parameters.StarburstParameters.Catalog,
+					"host":         parameters.StarburstParameters.Host,
+					"port":         parameters.StarburstParameters.Port,
+					"product_type": parameters.StarburstParameters.ProductType,
+				},
+			},
+		})
+	}
+
 	if parameters.TeradataParameters != nil {
 		params = append(params, map[string]interface{}{
 			"teradata": []interface{}{
@@ -1514,6 +1690,18 @@ func flattenParameters(parameters *quicksight.DataSourceParameters) []interface{
 		})
 	}
 
+	if parameters.TrinoParameters != nil {
+		params = append(params, map[string]interface{}{
+			"trino": []interface{}{
+				map[string]interface{}{
+					"catalog": parameters.TrinoParameters.Catalog,
+					"host":    parameters.TrinoParameters.Host,
+					"port":    parameters.TrinoParameters.Port,
+				},
+			},
+		})
+	}
+
 	if parameters.TwitterParameters != nil {
 		params = append(params, map[string]interface{}{
 			"twitter": []interface{}{
@@ -238,6 +238,25 @@ func TestAccQuickSightDataSource_name(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSource_vpcConnectionPropertiesInvalidARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceConfig_vpcConnectionPropertiesInvalidARN(rId, rName),
+				ExpectError: regexache.MustCompile(`must be the ARN of a QuickSight VPC connection`),
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceExists(ctx context.Context, resourceName string, dataSource *quicksight.DataSource) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -591,3 +610,26 @@ resource "aws_quicksight_data_source" "test" {
 }
 `, rId))
 }
+
+func testAccDataSourceConfig_vpcConnectionPropertiesInvalidARN(rId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  parameters {
+    trino {
+      catalog = "example"
+      host    = "trino.example.com"
+      port    = 443
+    }
+  }
+
+  vpc_connection_properties {
+    vpc_connection_arn = "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"
+  }
+
+  type = "TRINO"
+}
+`, rId, rName)
+}
@@ -14,8 +14,9 @@ func TestAccQuickSight_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"AccountSubscription": {
-			"basic":      testAccAccountSubscription_basic,
-			"disappears": testAccAccountSubscription_disappears,
+			"basic":           testAccAccountSubscription_basic,
+			"accountSettings": testAccAccountSubscription_accountSettings,
+			"disappears":      testAccAccountSubscription_disappears,
 		},
 	}
 
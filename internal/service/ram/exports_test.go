@@ -5,15 +5,23 @@ package ram
 
 // Exports for use in tests only.
 var (
+	ResourcePermission              = resourcePermission
+	ResourcePermissionAssociation   = resourcePermissionAssociation
+	ResourcePermissionVersion       = resourcePermissionVersion
 	ResourcePrincipalAssociation    = resourcePrincipalAssociation
 	ResourceResourceAssociation     = resourceResourceAssociation
 	ResourceResourceShare           = resourceResourceShare
 	ResourceResourceShareAccepter   = resourceResourceShareAccepter
 	ResourceSharingWithOrganization = resourceSharingWithOrganization
 
+	FindPermissionAssociationByTwoPartKey    = findPermissionAssociationByTwoPartKey
+	FindPermissionByARN                      = findPermissionByARN
+	FindPermissionVersionByTwoPartKey        = findPermissionVersionByTwoPartKey
 	FindPrincipalAssociationByTwoPartKey     = findPrincipalAssociationByTwoPartKey
 	FindResourceAssociationByTwoPartKey      = findResourceAssociationByTwoPartKey
 	FindResourceShareOwnerOtherAccountsByARN = findResourceShareOwnerOtherAccountsByARN
 	FindResourceShareOwnerSelfByARN          = findResourceShareOwnerSelfByARN
 	FindSharingWithOrganization              = findSharingWithOrganization
+
+	PermissionVersionParseResourceID = permissionVersionParseResourceID
 )
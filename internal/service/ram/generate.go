@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/tags/main.go -ListTagsInIDElem=ResourceShareArn -ServiceTagsSlice -TagInIDElem=ResourceShareArn -UpdateTags
+//go:generate go run ../../generate/tags/main.go -TagInIDElem=ResourceArn -UpdateTags -UpdateTagsFunc=updatePermissionTags -SkipTypesImp -- permission_tags_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ram_permission", name="Permission")
+// @Tags
+func resourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionCreate,
+		ReadWithoutTimeout:   resourcePermissionRead,
+		UpdateWithoutTimeout: resourcePermissionUpdate,
+		DeleteWithoutTimeout: resourcePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_template": permissionPolicyTemplateSchema(false),
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func permissionPolicyTemplateSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:                  schema.TypeString,
+		Required:              true,
+		ForceNew:              forceNew,
+		ValidateFunc:          validation.StringIsJSON,
+		DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+		DiffSuppressOnRefresh: true,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get("name").(string)
+	input := &ram.CreatePermissionInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		Name:           aws.String(name),
+		PolicyTemplate: aws.String(policyTemplate),
+		ResourceType:   aws.String(d.Get("resource_type").(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Permission.Arn))
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	permission, err := findPermissionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", permission.Arn)
+	d.Set("default_version", permission.DefaultVersion)
+	d.Set("name", permission.Name)
+	d.Set("permission_type", permission.PermissionType)
+	d.Set("policy_template", permission.Permission)
+	d.Set("resource_type", permission.ResourceType)
+	d.Set("status", permission.Status)
+	d.Set("version", flex.StringToIntValue(permission.Version))
+
+	setTagsOut(ctx, permission.Tags)
+
+	return diags
+}
+
+func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	if d.HasChange("policy_template") {
+		policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		output, err := conn.CreatePermissionVersionWithContext(ctx, &ram.CreatePermissionVersionInput{
+			ClientToken:    aws.String(sdkid.UniqueId()),
+			PermissionArn:  aws.String(d.Id()),
+			PolicyTemplate: aws.String(policyTemplate),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s) version: %s", d.Id(), err)
+		}
+
+		version := flex.StringValueToInt64Value(aws.StringValue(output.Permission.Version))
+
+		if err := setDefaultPermissionVersion(ctx, conn, d.Id(), version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// Remove the version that was previously the default so that updates don't exhaust the permission's version quota.
+		oldVersion := int64(d.Get("version").(int))
+		_, err = conn.DeletePermissionVersionWithContext(ctx, &ram.DeletePermissionVersionInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			PermissionArn:     aws.String(d.Id()),
+			PermissionVersion: aws.Int64(oldVersion),
+		})
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException):
+		case tfawserr.ErrCodeEquals(err, ram.ErrCodeOperationNotPermittedException):
+			// The version is still in use by a resource share.
+			log.Printf("[WARN] Unable to delete RAM Permission (%s) version (%d): %s", d.Id(), oldVersion, err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s) version (%d): %s", d.Id(), oldVersion, err)
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := updatePermissionTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	log.Printf("[DEBUG] Deleting RAM Permission: %s", d.Id())
+	_, err := conn.DeletePermissionWithContext(ctx, &ram.DeletePermissionInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		PermissionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPermissionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func setDefaultPermissionVersion(ctx context.Context, conn *ram.RAM, arn string, version int64) error {
+	_, err := conn.SetDefaultPermissionVersionWithContext(ctx, &ram.SetDefaultPermissionVersionInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		PermissionArn:     aws.String(arn),
+		PermissionVersion: aws.Int64(version),
+	})
+
+	if err != nil {
+		return fmt.Errorf("setting RAM Permission (%s) default version (%d): %w", arn, version, err)
+	}
+
+	return nil
+}
+
+func findPermissionByARN(ctx context.Context, conn *ram.RAM, arn string) (*ram.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn: aws.String(arn),
+	}
+
+	return findPermission(ctx, conn, input)
+}
+
+func findPermissionVersionByTwoPartKey(ctx context.Context, conn *ram.RAM, arn string, version int64) (*ram.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn:     aws.String(arn),
+		PermissionVersion: aws.Int64(version),
+	}
+
+	return findPermission(ctx, conn, input)
+}
+
+func findPermission(ctx context.Context, conn *ram.RAM, input *ram.GetPermissionInput) (*ram.ResourceSharePermissionDetail, error) {
+	output, err := conn.GetPermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Permission.Status); status == ram.PermissionStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Permission, nil
+}
+
+func statusPermission(ctx context.Context, conn *ram.RAM, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPermissionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPermissionDeleted(ctx context.Context, conn *ram.RAM, arn string, timeout time.Duration) (*ram.ResourceSharePermissionDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.PermissionStatusAttachable, ram.PermissionStatusUnattachable, ram.PermissionStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPermission(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ram.ResourceSharePermissionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ram_permission_association", name="Permission Association")
+func resourcePermissionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionAssociationCreate,
+		ReadWithoutTimeout:   resourcePermissionAssociationRead,
+		UpdateWithoutTimeout: resourcePermissionAssociationUpdate,
+		DeleteWithoutTimeout: resourcePermissionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	permissionAssociationResourceIDPartCount = 2
+)
+
+func resourcePermissionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	resourceShareARN, permissionARN := d.Get("resource_share_arn").(string), d.Get("permission_arn").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{resourceShareARN, permissionARN}, permissionAssociationResourceIDPartCount, false))
+	_, err := findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+	switch {
+	case err == nil:
+		return sdkdiag.AppendFromErr(diags, fmt.Errorf("RAM Permission Association (%s) already exists", id))
+	case tfresource.NotFound(err):
+		break
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association: %s", err)
+	}
+
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		Replace:          aws.Bool(d.Get("replace").(bool)),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	if v, ok := d.GetOk("permission_version"); ok {
+		input.PermissionVersion = aws.Int64(int64(v.(int)))
+	}
+
+	_, err = conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, resourceSharePropagationTimeout, func() (interface{}, error) {
+		return findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	permission, err := findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission Association %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("permission_arn", permission.Arn)
+	d.Set("permission_version", flex.StringToIntValue(permission.Version))
+	d.Set("resource_share_arn", resourceShareARN)
+
+	return diags
+}
+
+func resourcePermissionAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	if d.HasChange("permission_version") {
+		// Switching the version of an associated permission requires replacing the existing association.
+		input := &ram.AssociateResourceSharePermissionInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			PermissionArn:     aws.String(d.Get("permission_arn").(string)),
+			PermissionVersion: aws.Int64(int64(d.Get("permission_version").(int))),
+			Replace:           aws.Bool(true),
+			ResourceShareArn:  aws.String(d.Get("resource_share_arn").(string)),
+		}
+
+		_, err := conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), permissionAssociationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceShareARN, permissionARN := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting RAM Permission Association: %s", d.Id())
+	_, err = conn.DisassociateResourceSharePermissionWithContext(ctx, &ram.DisassociateResourceSharePermissionInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		ResourceShareArn: aws.String(resourceShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, resourceSharePropagationTimeout, func() (interface{}, error) {
+		return findPermissionAssociationByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPermissionAssociationByTwoPartKey(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	output, err := findResourceSharePermissions(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.StringValue(v.Arn) == permissionARN {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findResourceSharePermissions(ctx context.Context, conn *ram.RAM, input *ram.ListResourceSharePermissionsInput) ([]*ram.ResourceSharePermissionSummary, error) {
+	var output []*ram.ResourceSharePermissionSummary
+
+	err := conn.ListResourceSharePermissionsPagesWithContext(ctx, input, func(page *ram.ListResourceSharePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException, ram.ErrCodeUnknownResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
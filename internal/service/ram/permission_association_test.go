@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermissionAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission ram.ResourceSharePermissionSummary
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_arn", "aws_ram_permission.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", "aws_ram_resource_share.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace"},
+			},
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "permission_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission ram.ResourceSharePermissionSummary
+	resourceName := "aws_ram_permission_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionAssociationExists(ctx, resourceName, &permission),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfram.ResourcePermissionAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionAssociationExists(ctx context.Context, n string, v *ram.ResourceSharePermissionSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		output, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ram_permission_association" {
+				continue
+			}
+
+			_, err := tfram.FindPermissionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_share_arn"], rs.Primary.Attributes["permission_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RAM Permission Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionAssociationConfig_basic(rName string, version int) string {
+	return acctest.ConfigCompose(testAccPermissionVersionConfig_basic(rName, false), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_pool" "test" {
+  address_family = "ipv4"
+  ipam_scope_id  = aws_vpc_ipam.test.private_default_scope_id
+}
+
+resource "aws_ram_resource_share" "test" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_vpc_ipam_pool.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_permission_association" "test" {
+  permission_arn     = aws_ram_permission.test.arn
+  permission_version = %[2]d
+  replace            = true
+  resource_share_arn = aws_ram_resource_association.test.resource_share_arn
+
+  depends_on = [aws_ram_permission_version.test]
+}
+`, rName, version))
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_ram_permission_version", name="Permission Version")
+func resourcePermissionVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionVersionCreate,
+		ReadWithoutTimeout:   resourcePermissionVersionRead,
+		UpdateWithoutTimeout: resourcePermissionVersionUpdate,
+		DeleteWithoutTimeout: resourcePermissionVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"policy_template": permissionPolicyTemplateSchema(true),
+			"set_as_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	permissionVersionResourceIDPartCount = 2
+)
+
+func resourcePermissionVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	permissionARN := d.Get("permission_arn").(string)
+	input := &ram.CreatePermissionVersionInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		PermissionArn:  aws.String(permissionARN),
+		PolicyTemplate: aws.String(policyTemplate),
+	}
+
+	output, err := conn.CreatePermissionVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s) version: %s", permissionARN, err)
+	}
+
+	version := aws.StringValue(output.Permission.Version)
+	id := errs.Must(flex.FlattenResourceId([]string{permissionARN, version}, permissionVersionResourceIDPartCount, false))
+
+	d.SetId(id)
+
+	if d.Get("set_as_default").(bool) {
+		if err := setDefaultPermissionVersion(ctx, conn, permissionARN, flex.StringValueToInt64Value(version)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourcePermissionVersionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	permissionARN, version, err := permissionVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	permission, err := findPermissionVersionByTwoPartKey(ctx, conn, permissionARN, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default_version", permission.DefaultVersion)
+	d.Set("permission_arn", permission.Arn)
+	d.Set("policy_template", permission.Permission)
+	d.Set("status", permission.Status)
+	d.Set("version", version)
+
+	return diags
+}
+
+func resourcePermissionVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	// A version stops being the default only when another version is made the default.
+	if d.HasChange("set_as_default") && d.Get("set_as_default").(bool) {
+		permissionARN, version, err := permissionVersionParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := setDefaultPermissionVersion(ctx, conn, permissionARN, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourcePermissionVersionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	permissionARN, version, err := permissionVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RAM Permission Version: %s", d.Id())
+	_, err = conn.DeletePermissionVersionWithContext(ctx, &ram.DeletePermissionVersionInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		PermissionArn:     aws.String(permissionARN),
+		PermissionVersion: aws.Int64(version),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func permissionVersionParseResourceID(id string) (string, int64, error) {
+	parts, err := flex.ExpandResourceId(id, permissionVersionResourceIDPartCount, false)
+	if err != nil {
+		return "", 0, err
+	}
+
+	version, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return parts[0], version, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfram "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRAMPermissionVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission ram.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission_version.test"
+	permissionResourceName := "aws_ram_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionVersionConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionVersionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_arn", permissionResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"set_as_default"},
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission ram.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionVersionConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionVersionExists(ctx, resourceName, &permission),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfram.ResourcePermissionVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRAMPermissionVersion_setAsDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	var permission ram.ResourceSharePermissionDetail
+	resourceName := "aws_ram_permission_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionVersionConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionVersionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+				),
+			},
+			{
+				Config: testAccPermissionVersionConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionVersionExists(ctx, resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionVersionExists(ctx context.Context, n string, v *ram.ResourceSharePermissionDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		permissionARN, version, err := tfram.PermissionVersionParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		output, err := tfram.FindPermissionVersionByTwoPartKey(ctx, conn, permissionARN, version)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ram_permission_version" {
+				continue
+			}
+
+			permissionARN, version, err := tfram.PermissionVersionParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfram.FindPermissionVersionByTwoPartKey(ctx, conn, permissionARN, version)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RAM Permission Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionVersionConfig_basic(rName string, setAsDefault bool) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_basic(rName, `"ec2:GetIpamPoolAllocations"`), fmt.Sprintf(`
+resource "aws_ram_permission_version" "test" {
+  permission_arn = aws_ram_permission.test.arn
+  set_as_default = %[1]t
+
+  policy_template = jsonencode({
+    Effect = "Allow"
+    Action = ["ec2:GetIpamPoolAllocations", "ec2:GetIpamPoolCidrs"]
+  })
+}
+`, setAsDefault))
+}
@@ -36,6 +36,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourcePermission,
+			TypeName: "aws_ram_permission",
+			Name:     "Permission",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  resourcePermissionAssociation,
+			TypeName: "aws_ram_permission_association",
+			Name:     "Permission Association",
+		},
+		{
+			Factory:  resourcePermissionVersion,
+			TypeName: "aws_ram_permission_version",
+			Name:     "Permission Version",
+		},
 		{
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
@@ -15,6 +15,14 @@ import (
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_ram_permission", &resource.Sweeper{
+		Name: "aws_ram_permission",
+		F:    sweepPermissions,
+		Dependencies: []string{
+			"aws_ram_resource_share",
+		},
+	})
+
 	resource.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
 		Name: "aws_ram_resource_share",
 		F:    sweepResourceShares,
@@ -70,3 +78,53 @@ func sweepResourceShares(region string) error {
 
 	return nil
 }
+
+func sweepPermissions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.RAMConn(ctx)
+	input := &ram.ListPermissionsInput{
+		PermissionType: aws.String(ram.PermissionTypeFilterCustomerManaged),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPermissionsPagesWithContext(ctx, input, func(page *ram.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if aws.StringValue(v.Status) == ram.PermissionStatusDeleted {
+				continue
+			}
+
+			r := resourcePermission()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping RAM Permission sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing RAM Permissions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping RAM Permissions (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -175,6 +175,10 @@ func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		in.ResourceTags = expandResourceTags(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.LockConfiguration = expandLockConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	out, err := conn.CreateRule(ctx, in)
 	if err != nil {
 		return create.DiagError(names.RBin, create.ErrActionCreating, ResNameRule, d.Get("resource_type").(string), err)
@@ -218,6 +222,20 @@ func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("arn", ruleArn)
 
 	d.Set("description", out.Description)
+	// A rule that is pending unlock still reports its lock configuration.
+	if out.LockConfiguration != nil && out.LockState != types.LockStatePendingUnlock {
+		if err := d.Set("lock_configuration", []interface{}{flattenLockConfiguration(out.LockConfiguration)}); err != nil {
+			return create.DiagError(names.RBin, create.ErrActionSetting, ResNameRule, d.Id(), err)
+		}
+	} else {
+		d.Set("lock_configuration", nil)
+	}
+	if out.LockEndTime != nil {
+		d.Set("lock_end_time", aws.ToTime(out.LockEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("lock_end_time", nil)
+	}
+	d.Set("lock_state", string(out.LockState))
 	d.Set("resource_type", string(out.ResourceType))
 	d.Set("status", string(out.Status))
 
@@ -256,18 +274,38 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		update = true
 	}
 
-	if !update {
-		return nil
-	}
+	if update {
+		log.Printf("[DEBUG] Updating RBin Rule (%s): %#v", d.Id(), in)
+		out, err := conn.UpdateRule(ctx, in)
+		if err != nil {
+			return create.DiagError(names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), err)
+		}
 
-	log.Printf("[DEBUG] Updating RBin Rule (%s): %#v", d.Id(), in)
-	out, err := conn.UpdateRule(ctx, in)
-	if err != nil {
-		return create.DiagError(names.RBin, create.ErrActionUpdating, ResNameRule, d.Id(), err)
+		if _, err := waitRuleUpdated(ctx, conn, aws.ToString(out.Identifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), err)
+		}
 	}
 
-	if _, err := waitRuleUpdated(ctx, conn, aws.ToString(out.Identifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
-		return create.DiagError(names.RBin, create.ErrActionWaitingForUpdate, ResNameRule, d.Id(), err)
+	if d.HasChange("lock_configuration") {
+		if v, ok := d.GetOk("lock_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			_, err := conn.LockRule(ctx, &rbin.LockRuleInput{
+				Identifier:        aws.String(d.Id()),
+				LockConfiguration: expandLockConfiguration(v.([]interface{})[0].(map[string]interface{})),
+			})
+
+			if err != nil {
+				return create.DiagError(names.RBin, "locking", ResNameRule, d.Id(), err)
+			}
+		} else {
+			// The rule remains locked until the unlock delay period expires.
+			_, err := conn.UnlockRule(ctx, &rbin.UnlockRuleInput{
+				Identifier: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return create.DiagError(names.RBin, "unlocking", ResNameRule, d.Id(), err)
+			}
+		}
 	}
 
 	return resourceRuleRead(ctx, d, meta)
@@ -493,3 +531,60 @@ func expandRetentionPeriod(tfList []interface{}) *types.RetentionPeriod {
 
 	return &a
 }
+
+func expandLockConfiguration(tfMap map[string]interface{}) *types.LockConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.LockConfiguration{}
+
+	if v, ok := tfMap["unlock_delay"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.UnlockDelay = expandUnlockDelay(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandUnlockDelay(tfMap map[string]interface{}) *types.UnlockDelay {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.UnlockDelay{}
+
+	if v, ok := tfMap["unlock_delay_unit"].(string); ok && v != "" {
+		a.UnlockDelayUnit = types.UnlockDelayUnit(v)
+	}
+
+	if v, ok := tfMap["unlock_delay_value"].(int); ok {
+		a.UnlockDelayValue = aws.Int32(int32(v))
+	}
+
+	return a
+}
+
+func flattenLockConfiguration(apiObject *types.LockConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.UnlockDelay; v != nil {
+		m["unlock_delay"] = []interface{}{flattenUnlockDelay(v)}
+	}
+
+	return m
+}
+
+func flattenUnlockDelay(apiObject *types.UnlockDelay) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"unlock_delay_unit":  string(apiObject.UnlockDelayUnit),
+		"unlock_delay_value": aws.ToInt32(apiObject.UnlockDelayValue),
+	}
+}
@@ -182,6 +182,7 @@ func TestAccRBinRule_lock_config(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_unit", "DAYS"),
 					resource.TestCheckResourceAttr(resourceName, "lock_configuration.0.unlock_delay.0.unlock_delay_value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "locked"),
 				),
 			},
 		},
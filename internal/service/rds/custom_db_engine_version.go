@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -168,9 +169,10 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
+	engine, engineVersion := d.Get("engine").(string), d.Get("engine_version").(string)
 	input := rds.CreateCustomDBEngineVersionInput{
-		Engine:        aws.String(d.Get("engine").(string)),
-		EngineVersion: aws.String(d.Get("engine_version").(string)),
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
 		Tags:          getTagsIn(ctx),
 	}
 
@@ -212,11 +214,11 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 
 	output, err := conn.CreateCustomDBEngineVersionWithContext(ctx, &input)
 	if err != nil {
-		return append(diags, create.DiagError(names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", aws.StringValue(output.Engine), aws.StringValue(output.EngineVersion)), err)...)
+		return append(diags, create.DiagError(names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", engine, engineVersion), err)...)
 	}
 
 	if output == nil {
-		return append(diags, create.DiagError(names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", aws.StringValue(output.Engine), aws.StringValue(output.EngineVersion)), errors.New("empty output"))...)
+		return append(diags, create.DiagError(names.RDS, create.ErrActionCreating, ResNameCustomDBEngineVersion, fmt.Sprintf("%s:%s", engine, engineVersion), errors.New("empty output"))...)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(output.Engine), aws.StringValue(output.EngineVersion)))
@@ -304,7 +306,7 @@ func resourceCustomDBEngineVersionUpdate(ctx context.Context, d *schema.Resource
 		return append(diags, create.DiagError(names.RDS, create.ErrActionUpdating, ResNameCustomDBEngineVersion, d.Id(), errors.New("empty output"))...)
 	}
 
-	if _, err := waitCustomDBEngineVersionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitCustomDBEngineVersionUpdated(ctx, conn, d.Id(), d.Get("status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return append(diags, create.DiagError(names.RDS, create.ErrActionWaitingForUpdate, ResNameCustomDBEngineVersion, d.Id(), err)...)
 	}
 
@@ -321,7 +323,32 @@ func resourceCustomDBEngineVersionDelete(ctx context.Context, d *schema.Resource
 	if e != nil {
 		return append(diags, create.DiagError(names.RDS, create.ErrActionUpdating, ResNameCustomDBEngineVersion, d.Id(), e)...)
 	}
-	_, err := conn.DeleteCustomDBEngineVersionWithContext(ctx, &rds.DeleteCustomDBEngineVersionInput{
+
+	// A CEV can't be deleted while DB instances still use it.
+	instances, err := findDBInstancesSDKv1(ctx, conn, &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: aws.StringSlice([]string{engine}),
+			},
+		},
+	}, func(v *rds.DBInstance) bool {
+		return aws.StringValue(v.EngineVersion) == engineVersion
+	})
+
+	if err != nil && !tfresource.NotFound(err) {
+		return append(diags, create.DiagError(names.RDS, create.ErrActionDeleting, ResNameCustomDBEngineVersion, d.Id(), err)...)
+	}
+
+	if len(instances) > 0 {
+		ids := tfslices.ApplyToAll(instances, func(v *rds.DBInstance) string {
+			return aws.StringValue(v.DBInstanceIdentifier)
+		})
+
+		return append(diags, create.DiagError(names.RDS, create.ErrActionDeleting, ResNameCustomDBEngineVersion, d.Id(), fmt.Errorf("in use by DB instances: %s", strings.Join(ids, ", ")))...)
+	}
+
+	_, err = conn.DeleteCustomDBEngineVersionWithContext(ctx, &rds.DeleteCustomDBEngineVersionInput{
 		Engine:        aws.String(engine),
 		EngineVersion: aws.String(engineVersion),
 	})
@@ -368,10 +395,15 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.RDS, id str
 	return nil, err
 }
 
-func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.RDS, id, status string, timeout time.Duration) (*rds.DBEngineVersion, error) {
+	target := []string{statusAvailable, statusPendingValidation}
+	if status != "" && status != statusAvailable {
+		target = []string{status}
+	}
+
 	stateConf := &retry.StateChangeConf{
-		Pending:                   []string{statusAvailable},
-		Target:                    []string{statusAvailable, statusPendingValidation},
+		Pending:                   tfslices.RemoveAll(append(rds.CustomEngineVersionStatus_Values(), statusPendingValidation), target...),
+		Target:                    target,
 		Refresh:                   statusCustomDBEngineVersion(ctx, conn, id),
 		Timeout:                   timeout,
 		NotFoundChecks:            20,
@@ -125,6 +125,50 @@ func TestAccRDSCustomDBEngineVersion_sqlServerUpdate(t *testing.T) {
 	})
 }
 
+func TestAccRDSCustomDBEngineVersion_sqlServerStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	// Requires an existing Windows SQL Server AMI owned by operating account set as environmental variable
+	key := "RDS_CUSTOM_WINDOWS_SQLSERVER_AMI"
+	ami := os.Getenv(key)
+	if ami == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	var customdbengineversion rds.DBEngineVersion
+	rName := fmt.Sprintf("%s%s%d", "15.00.4249.2.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, rds.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_sqlServer(rName, ami),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &customdbengineversion),
+					resource.TestCheckResourceAttr(resourceName, "status", "pending-validation"),
+				),
+			},
+			{
+				Config: testAccCustomDBEngineVersionConfig_sqlServerStatus(rName, ami, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &customdbengineversion),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCustomDBEngineVersion_oracle(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -396,6 +440,26 @@ resource "aws_rds_custom_db_engine_version" "test" {
 `, rName, ami, description)
 }
 
+func testAccCustomDBEngineVersionConfig_sqlServerStatus(rName, ami, status string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+# Copy the Amazon AMI for Windows SQL Server, CEV creation requires an AMI owned by the operator
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  source_ami_id     = %[2]q
+  source_ami_region = data.aws_region.current.name
+}
+
+resource "aws_rds_custom_db_engine_version" "test" {
+  engine          = "custom-sqlserver-se"
+  engine_version  = %[1]q
+  source_image_id = aws_ami_copy.test.id
+  status          = %[3]q
+}
+`, rName, ami, status)
+}
+
 func testAccCustomDBEngineVersionConfig_oracle(rName, bucket string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "rdscfo_kms_key" {
@@ -6,6 +6,7 @@ package rds
 // Exports for use in tests only.
 var (
 	ResourceEventSubscription       = resourceEventSubscription
+	ResourceIntegration             = newIntegrationResource
 	ResourceProxy                   = resourceProxy
 	ResourceProxyDefaultTargetGroup = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint           = resourceProxyEndpoint
@@ -19,6 +20,7 @@ var (
 	FindDBSubnetGroupByName                    = findDBSubnetGroupByName
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
+	FindIntegrationByARN                       = findIntegrationByARN
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParseDBInstanceARN                         = parseDBInstanceARN
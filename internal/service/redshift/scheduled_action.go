@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_redshift_scheduled_action")
@@ -48,8 +49,9 @@ func ResourceScheduledAction() *schema.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 			"iam_role": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -60,6 +62,10 @@ func ResourceScheduledAction() *schema.Resource {
 			"schedule": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexache.MustCompile(`^(at\(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\)|cron\(\S+( \S+){5}\))$`),
+					"must be an at(yyyy-mm-ddThh:mm:ss) or cron(Minutes Hours Day-of-month Month Day-of-week Year) expression",
+				),
 			},
 			"start_time": {
 				Type:         schema.TypeString,
@@ -184,13 +190,7 @@ func resourceScheduledActionCreate(ctx context.Context, d *schema.ResourceData,
 		func() (interface{}, error) {
 			return conn.CreateScheduledActionWithContext(ctx, input)
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
-				return true, err
-			}
-
-			return false, err
-		},
+		retryScheduledActionIAMPropagation,
 	)
 
 	if err != nil {
@@ -290,7 +290,12 @@ func resourceScheduledActionUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Updating Redshift Scheduled Action: %s", input)
-	_, err := conn.ModifyScheduledActionWithContext(ctx, input)
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.ModifyScheduledActionWithContext(ctx, input)
+		},
+		retryScheduledActionIAMPropagation,
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Redshift Scheduled Action (%s): %s", d.Id(), err)
@@ -319,6 +324,15 @@ func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// retryScheduledActionIAMPropagation retries on errors caused by IAM eventual consistency.
+func retryScheduledActionIAMPropagation(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
+		return true, err
+	}
+
+	return false, err
+}
+
 func expandScheduledActionType(tfMap map[string]interface{}) *redshift.ScheduledActionType {
 	if tfMap == nil {
 		return nil
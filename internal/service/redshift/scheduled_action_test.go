@@ -293,6 +293,28 @@ func TestAccRedshiftScheduledAction_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftScheduledAction_invalidSchedule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduledActionConfig_pauseCluster(rName, "rate(1 day)"),
+				ExpectError: regexache.MustCompile(`must be an at\(yyyy-mm-ddThh:mm:ss\) or cron`),
+			},
+			{
+				Config:      testAccScheduledActionConfig_pauseCluster(rName, "cron(00 23 * * ?)"),
+				ExpectError: regexache.MustCompile(`must be an at\(yyyy-mm-ddThh:mm:ss\) or cron`),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduledActionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn(ctx)
@@ -137,3 +137,61 @@ func FindResourcePolicyByARN(ctx context.Context, conn *redshiftserverless.Redsh
 
 	return output.ResourcePolicy, nil
 }
+
+func FindScheduledActionByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.ScheduledActionResponse, error) {
+	input := &redshiftserverless.GetScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	output, err := conn.GetScheduledActionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledAction, nil
+}
+
+func FindSnapshotCopyConfigurationByID(ctx context.Context, conn *redshiftserverless.RedshiftServerless, id string) (*redshiftserverless.SnapshotCopyConfiguration, error) {
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+	var output *redshiftserverless.SnapshotCopyConfiguration
+
+	err := conn.ListSnapshotCopyConfigurationsPagesWithContext(ctx, input, func(page *redshiftserverless.ListSnapshotCopyConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			if v != nil && aws.StringValue(v.SnapshotCopyConfigurationId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
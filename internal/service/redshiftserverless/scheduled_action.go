@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_redshiftserverless_scheduled_action")
+func ResourceScheduledAction() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduledActionCreate,
+		ReadWithoutTimeout:   resourceScheduledActionRead,
+		UpdateWithoutTimeout: resourceScheduledActionUpdate,
+		DeleteWithoutTimeout: resourceScheduledActionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9a-z-]{3,60}$`), ""),
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"at": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							ExactlyOneOf: []string{"schedule.0.at", "schedule.0.cron"},
+						},
+						"cron": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule.0.at", "schedule.0.cron"},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create_snapshot": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"retention_period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  -1,
+									},
+									"snapshot_name_prefix": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduledActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &redshiftserverless.CreateScheduledActionInput{
+		Enabled:             aws.Bool(d.Get("enabled").(bool)),
+		NamespaceName:       aws.String(d.Get("namespace_name").(string)),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+		Schedule:            expandSchedule(d.Get("schedule").([]interface{})[0].(map[string]interface{})),
+		ScheduledActionName: aws.String(name),
+		TargetAction:        expandTargetAction(d.Get("target_action").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ScheduledActionDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.EndTime = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartTime = aws.Time(t)
+	}
+
+	_, err := conn.CreateScheduledActionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Scheduled Action (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceScheduledActionRead(ctx, d, meta)...)
+}
+
+func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	out, err := FindScheduledActionByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Scheduled Action (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Scheduled Action (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", out.ScheduledActionDescription)
+	d.Set("enabled", aws.StringValue(out.State) == redshiftserverless.StateActive)
+	if out.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(out.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	d.Set("name", out.ScheduledActionName)
+	d.Set("namespace_name", out.NamespaceName)
+	d.Set("role_arn", out.RoleArn)
+	if out.Schedule != nil {
+		if err := d.Set("schedule", []interface{}{flattenSchedule(out.Schedule)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+		}
+	} else {
+		d.Set("schedule", nil)
+	}
+	if out.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(out.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("state", out.State)
+	if out.TargetAction != nil {
+		if err := d.Set("target_action", []interface{}{flattenTargetAction(out.TargetAction)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting target_action: %s", err)
+		}
+	} else {
+		d.Set("target_action", nil)
+	}
+
+	return diags
+}
+
+func resourceScheduledActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	input := &redshiftserverless.UpdateScheduledActionInput{
+		ScheduledActionName: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.ScheduledActionDescription = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("enabled") {
+		input.Enabled = aws.Bool(d.Get("enabled").(bool))
+	}
+
+	if hasChange, v := d.HasChange("end_time"), d.Get("end_time").(string); hasChange && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		input.EndTime = aws.Time(t)
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("schedule") {
+		input.Schedule = expandSchedule(d.Get("schedule").([]interface{})[0].(map[string]interface{}))
+	}
+
+	if hasChange, v := d.HasChange("start_time"), d.Get("start_time").(string); hasChange && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		input.StartTime = aws.Time(t)
+	}
+
+	if d.HasChange("target_action") {
+		input.TargetAction = expandTargetAction(d.Get("target_action").([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.UpdateScheduledActionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Redshift Serverless Scheduled Action (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceScheduledActionRead(ctx, d, meta)...)
+}
+
+func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Scheduled Action: %s", d.Id())
+	_, err := conn.DeleteScheduledActionWithContext(ctx, &redshiftserverless.DeleteScheduledActionInput{
+		ScheduledActionName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Serverless Scheduled Action (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandSchedule(tfMap map[string]interface{}) *redshiftserverless.Schedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &redshiftserverless.Schedule{}
+
+	if v, ok := tfMap["at"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.At = aws.Time(t)
+	}
+
+	if v, ok := tfMap["cron"].(string); ok && v != "" {
+		apiObject.Cron = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTargetAction(tfMap map[string]interface{}) *redshiftserverless.TargetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &redshiftserverless.TargetAction{}
+
+	if v, ok := tfMap["create_snapshot"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CreateSnapshot = expandCreateSnapshotScheduleActionParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCreateSnapshotScheduleActionParameters(tfMap map[string]interface{}) *redshiftserverless.CreateSnapshotScheduleActionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &redshiftserverless.CreateSnapshotScheduleActionParameters{}
+
+	if v, ok := tfMap["namespace_name"].(string); ok && v != "" {
+		apiObject.NamespaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["retention_period"].(int); ok && v != 0 {
+		apiObject.RetentionPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["snapshot_name_prefix"].(string); ok && v != "" {
+		apiObject.SnapshotNamePrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSchedule(apiObject *redshiftserverless.Schedule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.At; v != nil {
+		tfMap["at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Cron; v != nil {
+		tfMap["cron"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenTargetAction(apiObject *redshiftserverless.TargetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CreateSnapshot; v != nil {
+		tfMap["create_snapshot"] = []interface{}{flattenCreateSnapshotScheduleActionParameters(v)}
+	}
+
+	return tfMap
+}
+
+func flattenCreateSnapshotScheduleActionParameters(apiObject *redshiftserverless.CreateSnapshotScheduleActionParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.NamespaceName; v != nil {
+		tfMap["namespace_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RetentionPeriod; v != nil {
+		tfMap["retention_period"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.SnapshotNamePrefix; v != nil {
+		tfMap["snapshot_name_prefix"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessScheduledAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_cron(rName, "(0 23 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", "aws_redshiftserverless_namespace.test", "namespace_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", "(0 23 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.retention_period", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.snapshot_name_prefix", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledActionConfig_at(rName, "2060-03-04T17:27:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.at", "2060-03-04T17:27:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron", ""),
+					resource.TestCheckResourceAttr(resourceName, "target_action.0.create_snapshot.0.retention_period", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessScheduledAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshiftserverless_scheduled_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledActionConfig_cron(rName, "(0 23 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledActionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshiftserverless.ResourceScheduledAction(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshiftserverless_scheduled_action" {
+				continue
+			}
+			_, err := tfredshiftserverless.FindScheduledActionByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Serverless Scheduled Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledActionExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Redshift Serverless Scheduled Action ID is not set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+		_, err := tfredshiftserverless.FindScheduledActionByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccScheduledActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "scheduler.redshift.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonRedshiftFullAccess"
+}
+
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+`, rName)
+}
+
+func testAccScheduledActionConfig_cron(rName, cron string) string {
+	return acctest.ConfigCompose(testAccScheduledActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = aws_redshiftserverless_workgroup.test.namespace_name
+  role_arn       = aws_iam_role.test.arn
+
+  schedule {
+    cron = %[2]q
+  }
+
+  target_action {
+    create_snapshot {
+      namespace_name       = aws_redshiftserverless_workgroup.test.namespace_name
+      snapshot_name_prefix = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, cron))
+}
+
+func testAccScheduledActionConfig_at(rName, at string) string {
+	return acctest.ConfigCompose(testAccScheduledActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_scheduled_action" "test" {
+  name           = %[1]q
+  namespace_name = aws_redshiftserverless_workgroup.test.namespace_name
+  role_arn       = aws_iam_role.test.arn
+  description    = "test"
+  enabled        = false
+
+  schedule {
+    at = %[2]q
+  }
+
+  target_action {
+    create_snapshot {
+      namespace_name       = aws_redshiftserverless_workgroup.test.namespace_name
+      retention_period     = 7
+      snapshot_name_prefix = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, at))
+}
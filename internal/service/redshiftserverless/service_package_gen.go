@@ -61,10 +61,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceResourcePolicy,
 			TypeName: "aws_redshiftserverless_resource_policy",
 		},
+		{
+			Factory:  ResourceScheduledAction,
+			TypeName: "aws_redshiftserverless_scheduled_action",
+		},
 		{
 			Factory:  ResourceSnapshot,
 			TypeName: "aws_redshiftserverless_snapshot",
 		},
+		{
+			Factory:  ResourceSnapshotCopyConfiguration,
+			TypeName: "aws_redshiftserverless_snapshot_copy_configuration",
+		},
 		{
 			Factory:  ResourceUsageLimit,
 			TypeName: "aws_redshiftserverless_usage_limit",
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_redshiftserverless_snapshot_copy_configuration")
+func ResourceSnapshotCopyConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnapshotCopyConfigurationCreate,
+		ReadWithoutTimeout:   resourceSnapshotCopyConfigurationRead,
+		UpdateWithoutTimeout: resourceSnapshotCopyConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSnapshotCopyConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"namespace_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCopyConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	namespaceName := d.Get("namespace_name").(string)
+	input := &redshiftserverless.CreateSnapshotCopyConfigurationInput{
+		DestinationRegion: aws.String(d.Get("destination_region").(string)),
+		NamespaceName:     aws.String(namespaceName),
+	}
+
+	if v, ok := d.GetOk("destination_kms_key_id"); ok {
+		input.DestinationKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_period"); ok {
+		input.SnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.CreateSnapshotCopyConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Snapshot Copy Configuration (%s): %s", namespaceName, err)
+	}
+
+	d.SetId(aws.StringValue(output.SnapshotCopyConfiguration.SnapshotCopyConfigurationId))
+
+	return append(diags, resourceSnapshotCopyConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCopyConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	out, err := FindSnapshotCopyConfigurationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Serverless Snapshot Copy Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Snapshot Copy Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.SnapshotCopyConfigurationArn)
+	d.Set("destination_kms_key_id", out.DestinationKmsKeyId)
+	d.Set("destination_region", out.DestinationRegion)
+	d.Set("namespace_name", out.NamespaceName)
+	d.Set("snapshot_retention_period", out.SnapshotRetentionPeriod)
+
+	return diags
+}
+
+func resourceSnapshotCopyConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	input := &redshiftserverless.UpdateSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: aws.String(d.Id()),
+		SnapshotRetentionPeriod:     aws.Int64(int64(d.Get("snapshot_retention_period").(int))),
+	}
+
+	_, err := conn.UpdateSnapshotCopyConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Redshift Serverless Snapshot Copy Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSnapshotCopyConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCopyConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Redshift Serverless Snapshot Copy Configuration: %s", d.Id())
+	_, err := conn.DeleteSnapshotCopyConfigurationWithContext(ctx, &redshiftserverless.DeleteSnapshotCopyConfigurationInput{
+		SnapshotCopyConfigurationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Serverless Snapshot Copy Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
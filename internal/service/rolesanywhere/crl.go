@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rolesanywhere_crl", name="CRL")
+// @Tags(identifierAttribute="arn")
+func ResourceCRL() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCRLCreate,
+		ReadWithoutTimeout:   resourceCRLRead,
+		UpdateWithoutTimeout: resourceCRLUpdate,
+		DeleteWithoutTimeout: resourceCRLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"crl_data", "crl_s3_bucket"},
+			},
+			"crl_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"crl_data", "crl_s3_bucket"},
+				RequiredWith: []string{"crl_s3_key"},
+			},
+			"crl_s3_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"crl_s3_bucket"},
+			},
+			"crl_s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_s3_object_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"crl_s3_bucket"},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_anchor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceCRLCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceCRLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	crlData, err := getCRLData(ctx, d, meta)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	input := &rolesanywhere.ImportCrlInput{
+		CrlData:        crlData,
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+		TrustAnchorArn: aws.String(d.Get("trust_anchor_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("enabled"); ok {
+		input.Enabled = aws.Bool(v.(bool))
+	}
+
+	output, err := conn.ImportCrl(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Crl.CrlId))
+
+	if err := setCRLS3ObjectETag(ctx, d, meta); err != nil {
+		return diag.Errorf("reading RolesAnywhere CRL (%s) S3 object: %s", d.Id(), err)
+	}
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	crl, err := FindCRLByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere CRL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", crl.CrlArn)
+	d.Set("enabled", crl.Enabled)
+	d.Set("name", crl.Name)
+	d.Set("trust_anchor_arn", crl.TrustAnchorArn)
+
+	return nil
+}
+
+func resourceCRLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	if d.HasChanges("crl_data", "crl_s3_bucket", "crl_s3_key", "crl_s3_object_etag", "crl_s3_object_version", "name") {
+		input := &rolesanywhere.UpdateCrlInput{
+			CrlId: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("crl_data", "crl_s3_bucket", "crl_s3_key", "crl_s3_object_etag", "crl_s3_object_version") {
+			crlData, err := getCRLData(ctx, d, meta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			input.CrlData = crlData
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		_, err := conn.UpdateCrl(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+		}
+
+		if err := setCRLS3ObjectETag(ctx, d, meta); err != nil {
+			return diag.Errorf("reading RolesAnywhere CRL (%s) S3 object: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.EnableCrl(ctx, &rolesanywhere.EnableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.DisableCrl(ctx, &rolesanywhere.DisableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return diag.Errorf("updating RolesAnywhere CRL (%s) enabled: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RolesAnywhere CRL (%s)", d.Id())
+	_, err := conn.DeleteCrl(ctx, &rolesanywhere.DeleteCrlInput{
+		CrlId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceCRLCustomizeDiff plans an update when the content of the CRL's S3 object
+// has changed since it was last imported.
+func resourceCRLCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	keys := []string{"crl_s3_bucket", "crl_s3_key", "crl_s3_object_version"}
+
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("crl_s3_object_etag")
+		}
+	}
+
+	if d.HasChanges(keys...) {
+		return d.SetNewComputed("crl_s3_object_etag")
+	}
+
+	bucket := d.Get("crl_s3_bucket").(string)
+
+	if bucket == "" {
+		return nil
+	}
+
+	etag, err := findCRLS3ObjectETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), bucket, d.Get("crl_s3_key").(string), d.Get("crl_s3_object_version").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RolesAnywhere CRL (%s) S3 object: %w", d.Id(), err)
+	}
+
+	if etag != d.Get("crl_s3_object_etag").(string) {
+		return d.SetNew("crl_s3_object_etag", etag)
+	}
+
+	return nil
+}
+
+func getCRLData(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]byte, error) {
+	if v, ok := d.GetOk("crl_data"); ok {
+		return []byte(v.(string)), nil
+	}
+
+	bucket, key := d.Get("crl_s3_bucket").(string), d.Get("crl_s3_key").(string)
+	input := &s3.GetObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+
+	if v, ok := d.GetOk("crl_s3_object_version"); ok {
+		input.VersionId = aws_sdkv2.String(v.(string))
+	}
+
+	output, err := meta.(*conns.AWSClient).S3Client(ctx).GetObject(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 Object (%s/%s): %w", bucket, key, err)
+	}
+
+	defer output.Body.Close()
+
+	crlData, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 Object (%s/%s) body: %w", bucket, key, err)
+	}
+
+	return crlData, nil
+}
+
+func setCRLS3ObjectETag(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("crl_s3_bucket").(string)
+
+	if bucket == "" {
+		d.Set("crl_s3_object_etag", nil)
+
+		return nil
+	}
+
+	etag, err := findCRLS3ObjectETag(ctx, meta.(*conns.AWSClient).S3Client(ctx), bucket, d.Get("crl_s3_key").(string), d.Get("crl_s3_object_version").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("crl_s3_object_etag", etag)
+
+	return nil
+}
+
+func findCRLS3ObjectETag(ctx context.Context, conn *s3.Client, bucket, key, version string) (string, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+
+	if version != "" {
+		input.VersionId = aws_sdkv2.String(version)
+	}
+
+	output, err := conn.HeadObject(ctx, input)
+
+	if errs.IsA[*s3types.NotFound](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return strings.Trim(aws_sdkv2.ToString(output.ETag), `"`), nil
+}
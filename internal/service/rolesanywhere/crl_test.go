@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRolesAnywhereCRL_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(t, rName, caKey, caCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rolesanywhere", regexache.MustCompile(`crl/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_anchor_arn", "aws_rolesanywhere_trust_anchor.test", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"crl_data"},
+			},
+			{
+				Config: testAccCRLConfig_basic(t, rName, caKey, caCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(t, rName, caKey, caCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrolesanywhere.ResourceCRL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_s3ObjectUpdatedInPlace(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl1 := acctest.TLSRSAX509CertificateRevocationListPEM(t, caKey, caCertificate)
+	crl2 := acctest.TLSRSAX509CertificateRevocationListPEM(t, caKey, caCertificate)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_s3(rName, caCertificate, crl1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "crl_s3_object_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"crl_s3_bucket", "crl_s3_key", "crl_s3_object_etag"},
+			},
+			{
+				// The object is replaced in place after the plan is made, so the CRL change
+				// is only detected by the next plan.
+				Config: testAccCRLConfig_s3(rName, caCertificate, crl2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCRLConfig_s3(rName, caCertificate, crl2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "crl_s3_object_etag", "aws_s3_object.test", "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCRLDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rolesanywhere_crl" {
+				continue
+			}
+
+			_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RolesAnywhere CRL %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCRLExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere CRL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCRLConfig_trustAnchor(rName, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
+}
+
+func testAccCRLConfig_basic(t *testing.T, rName, caKey, caCertificate string, enabled bool) string {
+	crl := acctest.TLSRSAX509CertificateRevocationListPEM(t, caKey, caCertificate)
+
+	return acctest.ConfigCompose(testAccCRLConfig_trustAnchor(rName, caCertificate), fmt.Sprintf(`
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_data         = "%[2]s"
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+  enabled          = %[3]t
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl), enabled))
+}
+
+func testAccCRLConfig_s3(rName, caCertificate, crl string) string {
+	return acctest.ConfigCompose(testAccCRLConfig_trustAnchor(rName, caCertificate), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s.pem"
+  content = "%[2]s"
+}
+
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_s3_bucket    = aws_s3_object.test.bucket
+  crl_s3_key       = aws_s3_object.test.key
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl)))
+}
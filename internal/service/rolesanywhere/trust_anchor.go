@@ -8,6 +8,7 @@ import (
 	"errors"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -48,6 +50,40 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(types.NotificationChannelAll),
+							ValidateFunc: validation.StringInSlice(enum.Values[types.NotificationChannel](), false),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(enum.Values[types.NotificationEvent](), false),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			"source": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -101,6 +137,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -131,6 +171,9 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", trustAnchor.TrustAnchorArn)
 	d.Set("enabled", trustAnchor.Enabled)
 	d.Set("name", trustAnchor.Name)
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return diag.Errorf("setting notification_settings: %s", err)
+	}
 
 	if err := d.Set("source", flattenSource(trustAnchor.Source)); err != nil {
 		return diag.Errorf("setting source: %s", err)
@@ -142,7 +185,52 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Reset settings for events that are no longer configured.
+		configured := make(map[string]bool)
+		for _, tfMapRaw := range ns.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			configured[tfMap["event"].(string)+"/"+tfMap["channel"].(string)] = true
+		}
+
+		var keys []types.NotificationSettingKey
+		for _, tfMapRaw := range os.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			if !configured[tfMap["event"].(string)+"/"+tfMap["channel"].(string)] {
+				keys = append(keys, types.NotificationSettingKey{
+					Channel: types.NotificationChannel(tfMap["channel"].(string)),
+					Event:   types.NotificationEvent(tfMap["event"].(string)),
+				})
+			}
+		}
+
+		if len(keys) > 0 {
+			_, err := conn.ResetNotificationSettings(ctx, &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: keys,
+				TrustAnchorId:           aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+
+		if ns.Len() > 0 {
+			_, err := conn.PutNotificationSettings(ctx, &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: expandNotificationSettings(ns.List()),
+				TrustAnchorId:        aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all", "notification_settings") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get("name").(string)),
@@ -193,6 +281,50 @@ func resourceTrustAnchorDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws_sdkv2.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"channel":       string(apiObject.Channel),
+			"configured_by": aws.StringValue(apiObject.ConfiguredBy),
+			"enabled":       aws.BoolValue(apiObject.Enabled),
+			"event":         string(apiObject.Event),
+			"threshold":     aws_sdkv2.ToInt32(apiObject.Threshold),
+		})
+	}
+
+	return tfList
+}
+
 func flattenSource(apiObject *types.Source) []interface{} {
 	if apiObject == nil {
 		return nil
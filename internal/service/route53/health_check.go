@@ -10,11 +10,14 @@ import (
 	"net"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,8 +68,9 @@ func ResourceHealthCheck() *schema.Resource {
 				Optional: true,
 			},
 			"cloudwatch_alarm_region": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
 			},
 			"disabled": {
 				Type:     schema.TypeBool,
@@ -173,7 +177,11 @@ func ResourceHealthCheck() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceHealthCheckCustomizeDiff,
+			resourceHealthCheckCustomizeDiffAlarmExists,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -462,3 +470,94 @@ func FindHealthCheckByID(ctx context.Context, conn *route53.Route53, id string)
 
 	return output.HealthCheck, nil
 }
+
+func resourceHealthCheckCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	healthCheckType := strings.ToUpper(d.Get("type").(string))
+
+	if d.NewValueKnown("routing_control_arn") {
+		routingControlARN := d.Get("routing_control_arn").(string)
+
+		switch {
+		case healthCheckType == route53.HealthCheckTypeRecoveryControl && routingControlARN == "":
+			return fmt.Errorf(`"routing_control_arn" is required when "type" is %q`, route53.HealthCheckTypeRecoveryControl)
+		case healthCheckType != route53.HealthCheckTypeRecoveryControl && routingControlARN != "":
+			return fmt.Errorf(`"routing_control_arn" can only be set when "type" is %q`, route53.HealthCheckTypeRecoveryControl)
+		}
+	}
+
+	if healthCheckType == route53.HealthCheckTypeCloudwatchMetric {
+		for _, k := range []string{"cloudwatch_alarm_name", "cloudwatch_alarm_region"} {
+			if d.NewValueKnown(k) && d.Get(k).(string) == "" {
+				return fmt.Errorf("%q is required when \"type\" is %q", k, route53.HealthCheckTypeCloudwatchMetric)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceHealthCheckCustomizeDiffAlarmExists verifies at plan time that the CloudWatch alarm referenced by a health check
+// exists in the specified Region. Route 53 accepts alarms that don't exist and reports the
+// health check as having insufficient data indefinitely.
+func resourceHealthCheckCustomizeDiffAlarmExists(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || strings.ToUpper(d.Get("type").(string)) != route53.HealthCheckTypeCloudwatchMetric {
+		return nil
+	}
+
+	if !d.NewValueKnown("cloudwatch_alarm_name") || !d.NewValueKnown("cloudwatch_alarm_region") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("cloudwatch_alarm_name", "cloudwatch_alarm_region") {
+		return nil
+	}
+
+	name, region := d.Get("cloudwatch_alarm_name").(string), d.Get("cloudwatch_alarm_region").(string)
+
+	if name == "" || region == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	_, err := findMetricAlarmByName(ctx, conn, name, func(o *cloudwatch.Options) {
+		o.Region = region
+	})
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("CloudWatch Alarm (%s) not found in Region (%s)", name, region)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CloudWatch Alarm (%s) in Region (%s): %w", name, region, err)
+	}
+
+	return nil
+}
+
+func findMetricAlarmByName(ctx context.Context, conn *cloudwatch.Client, name string, optFns ...func(*cloudwatch.Options)) (*cloudwatchtypes.MetricAlarm, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: []string{name},
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeMetricAlarm},
+	}
+
+	output, err := conn.DescribeAlarms(ctx, input, optFns...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.MetricAlarms) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.MetricAlarms); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.MetricAlarms[0], nil
+}
@@ -289,6 +289,24 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_cloudWatchAlarmNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_cloudWatchAlarmNotFound(rName),
+				ExpectError: regexache.MustCompile(`CloudWatch Alarm \(.+\) not found in Region`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withSNI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
@@ -399,6 +417,23 @@ func TestAccRoute53HealthCheck_withRoutingControlARN(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_routingControlARNRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_routingControlARNMissing,
+				ExpectError: regexache.MustCompile(`"routing_control_arn" is required`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
@@ -607,6 +642,19 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccHealthCheckConfig_cloudWatchAlarmNotFound(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = %[1]q
+  cloudwatch_alarm_region         = data.aws_region.current.name
+  insufficient_data_health_status = "Healthy"
+}
+`, rName)
+}
+
 func testAccHealthCheckConfig_searchString(search string, invert bool) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -693,3 +741,9 @@ resource "aws_route53_health_check" "test" {
 }
 `, rName)
 }
+
+const testAccHealthCheckConfig_routingControlARNMissing = `
+resource "aws_route53_health_check" "test" {
+  type = "RECOVERY_CONTROL"
+}
+`
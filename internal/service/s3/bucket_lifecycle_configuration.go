@@ -45,6 +45,8 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 			Update: schema.DefaultTimeout(3 * time.Minute),
 		},
 
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -425,6 +427,119 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rule") {
+		return nil
+	}
+
+	// Rules with values that aren't known until apply are validated by Amazon S3.
+	var rules []interface{}
+	for i, v := range d.Get("rule").([]interface{}) {
+		known := true
+		for _, k := range []string{"expiration", "filter", "filter.0.and.0.prefix", "filter.0.prefix", "prefix", "status"} {
+			if !d.NewValueKnown(fmt.Sprintf("rule.%d.%s", i, k)) {
+				known = false
+				break
+			}
+		}
+
+		if known {
+			rules = append(rules, v)
+		}
+	}
+
+	return validateLifecycleRulesExpirationOverlap(rules)
+}
+
+// validateLifecycleRulesExpirationOverlap returns an error if two enabled rules that filter
+// only on key prefix have overlapping prefixes and both expire objects.
+// Amazon S3 rejects such configurations on apply.
+func validateLifecycleRulesExpirationOverlap(l []interface{}) error {
+	type rule struct {
+		id, prefix string
+	}
+	var rules []rule
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if tfMap["status"].(string) != lifecycleRuleStatusEnabled {
+			continue
+		}
+
+		if !lifecycleRuleHasExpiration(tfMap) {
+			continue
+		}
+
+		prefix, ok := lifecycleRulePrefixOnlyFilter(tfMap)
+		if !ok {
+			continue
+		}
+
+		id := tfMap["id"].(string)
+
+		for _, v := range rules {
+			if strings.HasPrefix(prefix, v.prefix) || strings.HasPrefix(v.prefix, prefix) {
+				return fmt.Errorf("lifecycle rules %q and %q have overlapping prefixes (%q and %q) and both specify an expiration", v.id, id, v.prefix, prefix)
+			}
+		}
+
+		rules = append(rules, rule{id: id, prefix: prefix})
+	}
+
+	return nil
+}
+
+func lifecycleRuleHasExpiration(tfMap map[string]interface{}) bool {
+	v, ok := tfMap["expiration"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return false
+	}
+
+	expiration := v[0].(map[string]interface{})
+
+	return expiration["date"].(string) != "" || expiration["days"].(int) > 0
+}
+
+// lifecycleRulePrefixOnlyFilter returns the key prefix of a rule whose filter (if any)
+// matches objects on key prefix alone.
+func lifecycleRulePrefixOnlyFilter(tfMap map[string]interface{}) (string, bool) {
+	v, ok := tfMap["filter"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		prefix, _ := tfMap["prefix"].(string)
+		return prefix, true
+	}
+
+	filter := v[0].(map[string]interface{})
+
+	if v, ok := filter["tag"].([]interface{}); ok && len(v) > 0 {
+		return "", false
+	}
+
+	if filter["object_size_greater_than"].(string) != "" || filter["object_size_less_than"].(string) != "" {
+		return "", false
+	}
+
+	if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		and := v[0].(map[string]interface{})
+
+		if tags, ok := and["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+			return "", false
+		}
+
+		if and["object_size_greater_than"].(int) > 0 || and["object_size_less_than"].(int) > 0 {
+			return "", false
+		}
+
+		return and["prefix"].(string), true
+	}
+
+	return filter["prefix"].(string), true
+}
+
 // suppressMissingFilterConfigurationBlock suppresses the diff that results from an omitted
 // filter configuration block and one returned from the S3 API.
 // To work around the issue, https://github.com/hashicorp/terraform-plugin-sdk/issues/743,
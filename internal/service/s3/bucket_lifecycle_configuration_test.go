@@ -1067,6 +1067,119 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_overlappingExpirations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_overlappingExpirations(rName),
+				ExpectError: regexache.MustCompile(`lifecycle rules "all" and "logs" have overlapping prefixes`),
+			},
+		},
+	})
+}
+
+func TestValidateLifecycleRulesExpirationOverlap(t *testing.T) {
+	t.Parallel()
+
+	rule := func(id, status string, days int, filter map[string]interface{}) map[string]interface{} {
+		tfMap := map[string]interface{}{
+			"id":     id,
+			"prefix": "",
+			"status": status,
+		}
+
+		if days > 0 {
+			tfMap["expiration"] = []interface{}{map[string]interface{}{
+				"date": "",
+				"days": days,
+			}}
+		}
+
+		if filter != nil {
+			tfMap["filter"] = []interface{}{filter}
+		}
+
+		return tfMap
+	}
+	prefixFilter := func(prefix string) map[string]interface{} {
+		return map[string]interface{}{
+			"object_size_greater_than": "",
+			"object_size_less_than":    "",
+			"prefix":                   prefix,
+		}
+	}
+	tagFilter := func(prefix string) map[string]interface{} {
+		tfMap := prefixFilter(prefix)
+		tfMap["tag"] = []interface{}{map[string]interface{}{"key": "k", "value": "v"}}
+		return tfMap
+	}
+
+	testCases := map[string]struct {
+		rules       []interface{}
+		expectError bool
+	}{
+		"distinct prefixes": {
+			rules: []interface{}{
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+				rule("tmp", tfs3.LifecycleRuleStatusEnabled, 1, prefixFilter("tmp/")),
+			},
+		},
+		"nested prefixes": {
+			rules: []interface{}{
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+				rule("app-logs", tfs3.LifecycleRuleStatusEnabled, 7, prefixFilter("logs/app/")),
+			},
+			expectError: true,
+		},
+		"no filter": {
+			rules: []interface{}{
+				rule("all", tfs3.LifecycleRuleStatusEnabled, 365, nil),
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+			},
+			expectError: true,
+		},
+		"disabled rule": {
+			rules: []interface{}{
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+				rule("app-logs", tfs3.LifecycleRuleStatusDisabled, 7, prefixFilter("logs/app/")),
+			},
+		},
+		"no expiration": {
+			rules: []interface{}{
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+				rule("app-logs", tfs3.LifecycleRuleStatusEnabled, 0, prefixFilter("logs/app/")),
+			},
+		},
+		"tag filter": {
+			rules: []interface{}{
+				rule("logs", tfs3.LifecycleRuleStatusEnabled, 30, prefixFilter("logs/")),
+				rule("app-logs", tfs3.LifecycleRuleStatusEnabled, 7, tagFilter("logs/app/")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateLifecycleRulesExpirationOverlap(testCase.rules)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
 func testAccCheckBucketLifecycleConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
@@ -1381,6 +1494,40 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName, date)
 }
 
+func testAccBucketLifecycleConfigurationConfig_overlappingExpirations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = "all"
+    status = "Enabled"
+
+    expiration {
+      days = 365
+    }
+  }
+
+  rule {
+    id     = "logs"
+    status = "Enabled"
+
+    filter {
+      prefix = "logs/"
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBucketLifecycleConfigurationConfig_nonCurrentVersionExpiration(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -28,39 +28,40 @@ var (
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
-	BucketListTags                        = bucketListTags
-	BucketUpdateTags                      = bucketUpdateTags
-	BucketRegionalDomainName              = bucketRegionalDomainName
-	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
-	DeleteAllObjectVersions               = deleteAllObjectVersions
-	EmptyBucket                           = emptyBucket
-	FindAnalyticsConfiguration            = findAnalyticsConfiguration
-	FindBucket                            = findBucket
-	FindBucketACL                         = findBucketACL
-	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
-	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
-	FindBucketPolicy                      = findBucketPolicy
-	FindBucketRequestPayment              = findBucketRequestPayment
-	FindBucketVersioning                  = findBucketVersioning
-	FindBucketWebsite                     = findBucketWebsite
-	FindCORSRules                         = findCORSRules
-	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
-	FindInventoryConfiguration            = findInventoryConfiguration
-	FindLifecycleRules                    = findLifecycleRules
-	FindLoggingEnabled                    = findLoggingEnabled
-	FindMetricsConfiguration              = findMetricsConfiguration
-	FindObjectByBucketAndKey              = findObjectByBucketAndKey
-	FindObjectLockConfiguration           = findObjectLockConfiguration
-	FindOwnershipControls                 = findOwnershipControls
-	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
-	FindReplicationConfiguration          = findReplicationConfiguration
-	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
-	HostedZoneIDForRegion                 = hostedZoneIDForRegion
-	IsDirectoryBucket                     = isDirectoryBucket
-	ObjectListTags                        = objectListTags
-	ObjectUpdateTags                      = objectUpdateTags
-	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
-	ValidBucketName                       = validBucketName
+	BucketListTags                          = bucketListTags
+	BucketUpdateTags                        = bucketUpdateTags
+	BucketRegionalDomainName                = bucketRegionalDomainName
+	BucketWebsiteEndpointAndDomain          = bucketWebsiteEndpointAndDomain
+	DeleteAllObjectVersions                 = deleteAllObjectVersions
+	EmptyBucket                             = emptyBucket
+	FindAnalyticsConfiguration              = findAnalyticsConfiguration
+	FindBucket                              = findBucket
+	FindBucketACL                           = findBucketACL
+	FindBucketAccelerateConfiguration       = findBucketAccelerateConfiguration
+	FindBucketNotificationConfiguration     = findBucketNotificationConfiguration
+	FindBucketPolicy                        = findBucketPolicy
+	FindBucketRequestPayment                = findBucketRequestPayment
+	FindBucketVersioning                    = findBucketVersioning
+	FindBucketWebsite                       = findBucketWebsite
+	FindCORSRules                           = findCORSRules
+	FindIntelligentTieringConfiguration     = findIntelligentTieringConfiguration
+	FindInventoryConfiguration              = findInventoryConfiguration
+	FindLifecycleRules                      = findLifecycleRules
+	FindLoggingEnabled                      = findLoggingEnabled
+	FindMetricsConfiguration                = findMetricsConfiguration
+	FindObjectByBucketAndKey                = findObjectByBucketAndKey
+	FindObjectLockConfiguration             = findObjectLockConfiguration
+	FindOwnershipControls                   = findOwnershipControls
+	FindPublicAccessBlockConfiguration      = findPublicAccessBlockConfiguration
+	FindReplicationConfiguration            = findReplicationConfiguration
+	FindServerSideEncryptionConfiguration   = findServerSideEncryptionConfiguration
+	HostedZoneIDForRegion                   = hostedZoneIDForRegion
+	IsDirectoryBucket                       = isDirectoryBucket
+	ObjectListTags                          = objectListTags
+	ObjectUpdateTags                        = objectUpdateTags
+	SDKv1CompatibleCleanKey                 = sdkv1CompatibleCleanKey
+	ValidBucketName                         = validBucketName
+	ValidateLifecycleRulesExpirationOverlap = validateLifecycleRulesExpirationOverlap
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
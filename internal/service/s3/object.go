@@ -21,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -214,6 +215,11 @@ func resourceObject() *schema.Resource {
 					},
 				},
 			},
+			"request_payer": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestPayer](),
+			},
 			"server_side_encryption": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -267,6 +273,9 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
+	if v, ok := d.GetOk("request_payer"); ok {
+		optFns = append(optFns, withRequestPayer(types.RequestPayer(v.(string))))
+	}
 	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", d.Get("checksum_algorithm").(string), optFns...)
 
@@ -336,6 +345,9 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
+	if v, ok := d.GetOk("request_payer"); ok {
+		optFns = append(optFns, withRequestPayer(types.RequestPayer(v.(string))))
+	}
 	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 
 	if d.HasChange("acl") {
@@ -411,6 +423,9 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
+	if v, ok := d.GetOk("request_payer"); ok {
+		optFns = append(optFns, withRequestPayer(types.RequestPayer(v.(string))))
+	}
 	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 
 	var err error
@@ -459,6 +474,9 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
+	if v, ok := d.GetOk("request_payer"); ok {
+		optFns = append(optFns, withRequestPayer(types.RequestPayer(v.(string))))
+	}
 
 	var body io.ReadSeeker
 
@@ -723,6 +741,14 @@ func flattenObjectDate(t *time.Time) string {
 	return t.Format(time.RFC3339)
 }
 
+// withRequestPayer returns an S3 client option that sets the x-amz-request-payer header on each request,
+// confirming that the requester knows that they will be charged for requests to a Requester Pays bucket.
+func withRequestPayer(requestPayer types.RequestPayer) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, smithyhttp.SetHeaderValue("x-amz-request-payer", string(requestPayer)))
+	}
+}
+
 // sdkv1CompatibleCleanKey returns an AWS SDK for Go v1 compatible clean key.
 // DisableRestProtocolURICleaning was false on the standard S3Conn, so to ensure backwards
 // compatibility we must "clean" the configured key before passing to AWS SDK for Go v2 APIs.
@@ -134,6 +134,11 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"request_payer": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.RequestPayer](),
+			},
 			"server_side_encryption": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,6 +179,9 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
+	if v, ok := d.GetOk("request_payer"); ok {
+		optFns = append(optFns, withRequestPayer(types.RequestPayer(v.(string))))
+	}
 	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
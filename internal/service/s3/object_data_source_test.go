@@ -54,6 +54,31 @@ func TestAccS3ObjectDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_requestPayer(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_requestPayer(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "body", "Hello World"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "request_payer", "requester"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -601,6 +626,33 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_requestPayer(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_request_payment_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  payer  = "Requester"
+}
+
+resource "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket_request_payment_configuration.test.bucket
+  key           = "%[1]s-key"
+  content       = "Hello World"
+  content_type  = "text/plain"
+  request_payer = "requester"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_object.test.bucket
+  key           = aws_s3_object.test.key
+  request_payer = "requester"
+}
+`, rName)
+}
+
 func testAccObjectDataSourceConfig_basicViaAccessPoint(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
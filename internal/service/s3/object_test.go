@@ -168,6 +168,45 @@ func TestAccS3Object_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_requestPayer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_requestPayer(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "request_payer", string(types.RequestPayerRequester)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "force_destroy", "request_payer"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_requestPayer(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "request_payer", string(types.RequestPayerRequester)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2541,6 +2580,27 @@ resource "aws_s3_object" "object" {
 `, rName)
 }
 
+func testAccObjectConfig_requestPayer(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_request_payment_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  payer  = "Requester"
+}
+
+resource "aws_s3_object" "object" {
+  bucket        = aws_s3_bucket_request_payment_configuration.test.bucket
+  key           = "test-key"
+  content       = %[2]q
+  request_payer = "requester"
+}
+`, rName, content)
+}
+
 func testAccObjectConfig_source(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -57,6 +57,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
 		},
+		{
+			Factory:  dataSourceBucketRequestPaymentConfiguration,
+			TypeName: "aws_s3_bucket_request_payment_configuration",
+			Name:     "Bucket Request Payment Configuration",
+		},
 		{
 			Factory:  dataSourceObject,
 			TypeName: "aws_s3_object",
@@ -177,7 +177,7 @@ func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier, res
 		if err != nil {
 			return err
 		}
-		tags, err = objectListTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key)
+		tags, err = objectListTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key, objectTagsOptFns(resourceType)...)
 
 	default:
 		return nil
@@ -206,13 +206,24 @@ func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier, r
 		if err != nil {
 			return err
 		}
-		return objectUpdateTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key, oldTags, newTags)
+		return objectUpdateTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key, oldTags, newTags, objectTagsOptFns(resourceType)...)
 
 	default:
 		return nil
 	}
 }
 
+// objectTagsOptFns returns the S3 client options used for transparent tagging of the specified object resource type.
+// aws_s3_object objects can be in Requester Pays buckets owned by other accounts. Its own reads of such objects
+// only succeed when `request_payer` is configured, so the tagging requests that follow can always accept the charges.
+func objectTagsOptFns(resourceType string) []func(*s3.Options) {
+	if resourceType == "Object" {
+		return []func(*s3.Options){withRequestPayer(awstypes.RequestPayerRequester)}
+	}
+
+	return nil
+}
+
 func getContextTags(ctx context.Context) tftags.KeyValueTags {
 	if inContext, ok := tftags.FromContext(ctx); ok {
 		return inContext.TagsIn.UnwrapOrDefault()
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -207,6 +208,17 @@ func (r *accessGrantsInstanceResource) Update(ctx context.Context, request resou
 				return
 			}
 		}
+
+		output, err := findAccessGrantsInstance(ctx, conn, new.AccountID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grants Instance (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		new.IdentityCenterApplicationARN = flex.StringToFramework(ctx, output.IdentityCenterArn)
 	}
 
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
@@ -255,6 +267,25 @@ func (r *accessGrantsInstanceResource) Delete(ctx context.Context, request resou
 }
 
 func (r *accessGrantsInstanceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var plan, state accessGrantsInstanceResourceModel
+
+		response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Changing the associated IAM Identity Center instance changes the IAM Identity Center application.
+		if !plan.IdentityCenterARN.Equal(state.IdentityCenterARN) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("identity_center_application_arn"), types.StringUnknown())...)
+		}
+	}
+
 	r.SetTagsAll(ctx, request, response)
 }
 
@@ -641,3 +641,49 @@ func FindPipelineByName(ctx context.Context, conn *sagemaker.SageMaker, name str
 
 	return output, nil
 }
+
+func FindInferenceComponentByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	input := &sagemaker.DescribeInferenceComponentInput{
+		InferenceComponentName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceComponentWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindHubContent(ctx context.Context, conn *sagemaker.SageMaker, input *sagemaker.DescribeHubContentInput) (*sagemaker.DescribeHubContentOutput, error) {
+	output, err := conn.DescribeHubContentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
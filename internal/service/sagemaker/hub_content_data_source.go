@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_sagemaker_hub_content")
+func DataSourceHubContent() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHubContentRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_schema_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_markdown": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hub_content_search_keywords": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hub_content_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hub_content_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.HubContentType_Values(), false),
+			},
+			"hub_content_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"hub_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceHubContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	hubName := d.Get("hub_name").(string)
+	contentName := d.Get("hub_content_name").(string)
+	input := &sagemaker.DescribeHubContentInput{
+		HubContentName: aws.String(contentName),
+		HubContentType: aws.String(d.Get("hub_content_type").(string)),
+		HubName:        aws.String(hubName),
+	}
+
+	if v, ok := d.GetOk("hub_content_version"); ok {
+		input.HubContentVersion = aws.String(v.(string))
+	}
+
+	output, err := FindHubContent(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("SageMaker Hub Content", err))
+	}
+
+	d.SetId(aws.StringValue(output.HubContentArn))
+	d.Set("arn", output.HubContentArn)
+	d.Set("creation_time", aws.TimeValue(output.CreationTime).Format(time.RFC3339))
+	d.Set("document_schema_version", output.DocumentSchemaVersion)
+	d.Set("hub_arn", output.HubArn)
+	d.Set("hub_content_description", output.HubContentDescription)
+	d.Set("hub_content_display_name", output.HubContentDisplayName)
+	d.Set("hub_content_document", output.HubContentDocument)
+	d.Set("hub_content_markdown", output.HubContentMarkdown)
+	d.Set("hub_content_name", output.HubContentName)
+	d.Set("hub_content_search_keywords", aws.StringValueSlice(output.HubContentSearchKeywords))
+	d.Set("hub_content_status", output.HubContentStatus)
+	d.Set("hub_content_type", output.HubContentType)
+	d.Set("hub_content_version", output.HubContentVersion)
+	d.Set("hub_name", output.HubName)
+
+	return diags
+}
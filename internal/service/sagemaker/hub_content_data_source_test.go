@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerHubContentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sagemaker_hub_content.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHubContentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "arn", regexache.MustCompile(`:hub-content/SageMakerPublicHub/Model/pytorch-ic-mobilenet-v2/.+$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hub_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hub_content_document"),
+					resource.TestCheckResourceAttr(dataSourceName, "hub_content_name", "pytorch-ic-mobilenet-v2"),
+					resource.TestCheckResourceAttr(dataSourceName, "hub_content_status", "Available"),
+					resource.TestCheckResourceAttr(dataSourceName, "hub_content_type", "Model"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "hub_content_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "hub_name", "SageMakerPublicHub"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHubContentDataSourceConfig_basic = `
+data "aws_sagemaker_hub_content" "test" {
+  hub_name         = "SageMakerPublicHub"
+  hub_content_type = "Model"
+  hub_content_name = "pytorch-ic-mobilenet-v2"
+}
+`
@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_inference_component", name="Inference Component")
+// @Tags(identifierAttribute="arn")
+func ResourceInferenceComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInferenceComponentCreate,
+		ReadWithoutTimeout:   resourceInferenceComponentRead,
+		UpdateWithoutTimeout: resourceInferenceComponentUpdate,
+		DeleteWithoutTimeout: resourceInferenceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"runtime_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_resource_requirements": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"min_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"number_of_accelerator_devices_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"number_of_cpu_cores_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0.25),
+									},
+								},
+							},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"artifact_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validModelDataURL,
+									},
+									"environment": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validImage,
+									},
+								},
+							},
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"startup_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_startup_health_check_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+									"model_data_download_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"variant_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceInferenceComponentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &sagemaker.CreateInferenceComponentInput{
+		EndpointName:           aws.String(d.Get("endpoint_name").(string)),
+		InferenceComponentName: aws.String(name),
+		RuntimeConfig:          expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{})),
+		Specification:          expandInferenceComponentSpecification(d.Get("specification").([]interface{})),
+		Tags:                   getTagsIn(ctx),
+		VariantName:            aws.String(d.Get("variant_name").(string)),
+	}
+
+	_, err := conn.CreateInferenceComponentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Inference Component (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := WaitInferenceComponentInService(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	output, err := FindInferenceComponentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Inference Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.InferenceComponentArn)
+	d.Set("endpoint_name", output.EndpointName)
+	d.Set("name", output.InferenceComponentName)
+	if err := d.Set("runtime_config", flattenInferenceComponentRuntimeConfig(output.RuntimeConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_config: %s", err)
+	}
+	if err := d.Set("specification", flattenInferenceComponentSpecification(output.Specification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting specification: %s", err)
+	}
+	d.Set("variant_name", output.VariantName)
+
+	return diags
+}
+
+func resourceInferenceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	if d.HasChanges("runtime_config", "specification") {
+		input := &sagemaker.UpdateInferenceComponentInput{
+			InferenceComponentName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("runtime_config") {
+			input.RuntimeConfig = expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]interface{}))
+		}
+
+		if d.HasChange("specification") {
+			input.Specification = expandInferenceComponentSpecification(d.Get("specification").([]interface{}))
+		}
+
+		_, err := conn.UpdateInferenceComponentWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Inference Component (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitInferenceComponentInService(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	log.Printf("[DEBUG] Deleting SageMaker Inference Component: %s", d.Id())
+	_, err := conn.DeleteInferenceComponentWithContext(ctx, &sagemaker.DeleteInferenceComponentInput{
+		InferenceComponentName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Inference Component (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitInferenceComponentDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Inference Component (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandInferenceComponentRuntimeConfig(l []interface{}) *sagemaker.InferenceComponentRuntimeConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.InferenceComponentRuntimeConfig{
+		CopyCount: aws.Int64(int64(m["copy_count"].(int))),
+	}
+
+	return config
+}
+
+func expandInferenceComponentSpecification(l []interface{}) *sagemaker.InferenceComponentSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.InferenceComponentSpecification{
+		ComputeResourceRequirements: expandInferenceComponentComputeResourceRequirements(m["compute_resource_requirements"].([]interface{})),
+	}
+
+	if v, ok := m["container"].([]interface{}); ok && len(v) > 0 {
+		config.Container = expandInferenceComponentContainerSpecification(v)
+	}
+
+	if v, ok := m["model_name"].(string); ok && v != "" {
+		config.ModelName = aws.String(v)
+	}
+
+	if v, ok := m["startup_parameters"].([]interface{}); ok && len(v) > 0 {
+		config.StartupParameters = expandInferenceComponentStartupParameters(v)
+	}
+
+	return config
+}
+
+func expandInferenceComponentComputeResourceRequirements(l []interface{}) *sagemaker.InferenceComponentComputeResourceRequirements {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.InferenceComponentComputeResourceRequirements{
+		MinMemoryRequiredInMb: aws.Int64(int64(m["min_memory_required_in_mb"].(int))),
+	}
+
+	if v, ok := m["max_memory_required_in_mb"].(int); ok && v > 0 {
+		config.MaxMemoryRequiredInMb = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["number_of_accelerator_devices_required"].(float64); ok && v > 0 {
+		config.NumberOfAcceleratorDevicesRequired = aws.Float64(v)
+	}
+
+	if v, ok := m["number_of_cpu_cores_required"].(float64); ok && v > 0 {
+		config.NumberOfCpuCoresRequired = aws.Float64(v)
+	}
+
+	return config
+}
+
+func expandInferenceComponentContainerSpecification(l []interface{}) *sagemaker.InferenceComponentContainerSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.InferenceComponentContainerSpecification{}
+
+	if v, ok := m["artifact_url"].(string); ok && v != "" {
+		config.ArtifactUrl = aws.String(v)
+	}
+
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		config.Environment = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := m["image"].(string); ok && v != "" {
+		config.Image = aws.String(v)
+	}
+
+	return config
+}
+
+func expandInferenceComponentStartupParameters(l []interface{}) *sagemaker.InferenceComponentStartupParameters {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.InferenceComponentStartupParameters{}
+
+	if v, ok := m["container_startup_health_check_timeout_in_seconds"].(int); ok && v > 0 {
+		config.ContainerStartupHealthCheckTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["model_data_download_timeout_in_seconds"].(int); ok && v > 0 {
+		config.ModelDataDownloadTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func flattenInferenceComponentRuntimeConfig(config *sagemaker.InferenceComponentRuntimeConfigSummary) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"copy_count": aws.Int64Value(config.DesiredCopyCount),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenInferenceComponentSpecification(config *sagemaker.InferenceComponentSpecificationSummary) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_resource_requirements": flattenInferenceComponentComputeResourceRequirements(config.ComputeResourceRequirements),
+		"model_name":                    aws.StringValue(config.ModelName),
+	}
+
+	if config.Container != nil {
+		m["container"] = flattenInferenceComponentContainerSpecification(config.Container)
+	}
+
+	if config.StartupParameters != nil {
+		m["startup_parameters"] = flattenInferenceComponentStartupParameters(config.StartupParameters)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenInferenceComponentComputeResourceRequirements(config *sagemaker.InferenceComponentComputeResourceRequirements) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_memory_required_in_mb":              aws.Int64Value(config.MaxMemoryRequiredInMb),
+		"min_memory_required_in_mb":              aws.Int64Value(config.MinMemoryRequiredInMb),
+		"number_of_accelerator_devices_required": aws.Float64Value(config.NumberOfAcceleratorDevicesRequired),
+		"number_of_cpu_cores_required":           aws.Float64Value(config.NumberOfCpuCoresRequired),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenInferenceComponentContainerSpecification(config *sagemaker.InferenceComponentContainerSpecificationSummary) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"artifact_url": aws.StringValue(config.ArtifactUrl),
+		"environment":  aws.StringValueMap(config.Environment),
+	}
+
+	if config.DeployedImage != nil {
+		m["image"] = aws.StringValue(config.DeployedImage.SpecifiedImage)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenInferenceComponentStartupParameters(config *sagemaker.InferenceComponentStartupParameters) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_startup_health_check_timeout_in_seconds": aws.Int64Value(config.ContainerStartupHealthCheckTimeoutInSeconds),
+		"model_data_download_timeout_in_seconds":            aws.Int64Value(config.ModelDataDownloadTimeoutInSeconds),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerInferenceComponent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_inference_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInferenceComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceComponentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("inference-component/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_name", "aws_sagemaker_endpoint.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_config.0.copy_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "specification.0.compute_resource_requirements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "specification.0.compute_resource_requirements.0.min_memory_required_in_mb", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "specification.0.compute_resource_requirements.0.number_of_cpu_cores_required", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "specification.0.model_name", "aws_sagemaker_model.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "variant_name", "variant-1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerInferenceComponent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_inference_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInferenceComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceComponentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourceInferenceComponent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerInferenceComponent_copyCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_inference_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInferenceComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceComponentConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_config.0.copy_count", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInferenceComponentConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_config.0.copy_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerInferenceComponent_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_inference_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInferenceComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceComponentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInferenceComponentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInferenceComponentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInferenceComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInferenceComponentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_inference_component" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindInferenceComponentByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker Inference Component (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInferenceComponentExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no SageMaker Inference Component ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+		_, err := tfsagemaker.FindInferenceComponentByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInferenceComponentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "access" {
+  statement {
+    effect = "Allow"
+
+    actions = [
+      "cloudwatch:PutMetricData",
+      "logs:CreateLogStream",
+      "logs:PutLogEvents",
+      "logs:CreateLogGroup",
+      "logs:DescribeLogStreams",
+      "ecr:GetAuthorizationToken",
+      "ecr:BatchCheckLayerAvailability",
+      "ecr:GetDownloadUrlForLayer",
+      "ecr:BatchGetImage",
+      "s3:GetObject",
+    ]
+
+    resources = ["*"]
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_iam_role_policy" "test" {
+  role   = aws_iam_role.test.name
+  policy = data.aws_iam_policy_document.access.json
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "model.tar.gz"
+  source = "test-fixtures/sagemaker-tensorflow-serving-test-model.tar.gz"
+}
+
+data "aws_sagemaker_prebuilt_ecr_image" "test" {
+  repository_name = "sagemaker-tensorflow-serving"
+  image_tag       = "1.12-cpu"
+}
+
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image          = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+    model_data_url = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  production_variants {
+    initial_instance_count = 1
+    instance_type          = "ml.m5.large"
+    variant_name           = "variant-1"
+
+    managed_instance_scaling {
+      max_instance_count = 2
+      min_instance_count = 1
+      status             = "ENABLED"
+    }
+
+    routing_config {
+      routing_strategy = "LEAST_OUTSTANDING_REQUESTS"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_sagemaker_endpoint" "test" {
+  endpoint_config_name = aws_sagemaker_endpoint_configuration.test.name
+  name                 = %[1]q
+}
+`, rName)
+}
+
+func testAccInferenceComponentConfig_basic(rName string, copyCount int) string {
+	return acctest.ConfigCompose(testAccInferenceComponentConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_inference_component" "test" {
+  name          = %[1]q
+  endpoint_name = aws_sagemaker_endpoint.test.name
+  variant_name  = "variant-1"
+
+  runtime_config {
+    copy_count = %[2]d
+  }
+
+  specification {
+    model_name = aws_sagemaker_model.test.name
+
+    compute_resource_requirements {
+      min_memory_required_in_mb    = 1024
+      number_of_cpu_cores_required = 1
+    }
+  }
+}
+`, rName, copyCount))
+}
+
+func testAccInferenceComponentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccInferenceComponentConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_inference_component" "test" {
+  name          = %[1]q
+  endpoint_name = aws_sagemaker_endpoint.test.name
+  variant_name  = "variant-1"
+
+  runtime_config {
+    copy_count = 1
+  }
+
+  specification {
+    model_name = aws_sagemaker_model.test.name
+
+    compute_resource_requirements {
+      min_memory_required_in_mb    = 1024
+      number_of_cpu_cores_required = 1
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccInferenceComponentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccInferenceComponentConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_inference_component" "test" {
+  name          = %[1]q
+  endpoint_name = aws_sagemaker_endpoint.test.name
+  variant_name  = "variant-1"
+
+  runtime_config {
+    copy_count = 1
+  }
+
+  specification {
+    model_name = aws_sagemaker_model.test.name
+
+    compute_resource_requirements {
+      min_memory_required_in_mb    = 1024
+      number_of_cpu_cores_required = 1
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
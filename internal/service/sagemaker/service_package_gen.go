@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceHubContent,
+			TypeName: "aws_sagemaker_hub_content",
+		},
 		{
 			Factory:  DataSourcePrebuiltECRImage,
 			TypeName: "aws_sagemaker_prebuilt_ecr_image",
@@ -138,6 +142,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceImageVersion,
 			TypeName: "aws_sagemaker_image_version",
 		},
+		{
+			Factory:  ResourceInferenceComponent,
+			TypeName: "aws_sagemaker_inference_component",
+			Name:     "Inference Component",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceModel,
 			TypeName: "aws_sagemaker_model",
@@ -269,3 +269,19 @@ func StatusMonitoringSchedule(ctx context.Context, conn *sagemaker.SageMaker, na
 		return output, aws.StringValue(output.MonitoringScheduleStatus), nil
 	}
 }
+
+func StatusInferenceComponent(ctx context.Context, conn *sagemaker.SageMaker, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInferenceComponentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.InferenceComponentStatus), nil
+	}
+}
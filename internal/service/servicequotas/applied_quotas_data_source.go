@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	appliedQuotaStateApplied = "APPLIED"
+	appliedQuotaStateDenied  = "DENIED"
+	appliedQuotaStatePending = "PENDING"
+)
+
+// @SDKDataSource("aws_servicequotas_applied_quotas")
+func DataSourceAppliedQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAppliedQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_applied": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"denied_quota_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"pending_quota_codes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"quota_codes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"applied_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"quota_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"request_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requested_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAppliedQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceQuotasClient(ctx)
+
+	serviceCode := d.Get("service_code").(string)
+	quotaCodes := flex.ExpandStringValueSet(d.Get("quota_codes").(*schema.Set))
+
+	var quotas []interface{}
+	var deniedQuotaCodes, pendingQuotaCodes []string
+
+	for _, quotaCode := range quotaCodes {
+		// A Service Quota will always have a default value, but will only have a current value if it has been set.
+		quota, err := findServiceQuotaByID(ctx, conn, serviceCode, quotaCode)
+		if tfresource.NotFound(err) {
+			quota, err = findServiceQuotaDefaultByID(ctx, conn, serviceCode, quotaCode)
+		}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting Service Quota for (%s/%s): %s", serviceCode, quotaCode, err)
+		}
+
+		request, err := findLatestRequestedServiceQuotaChangeByQuota(ctx, conn, serviceCode, quotaCode)
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "listing Service Quota (%s/%s) change requests: %s", serviceCode, quotaCode, err)
+		}
+
+		tfMap := map[string]interface{}{
+			"applied_value": aws.ToFloat64(quota.Value),
+			"quota_code":    quotaCode,
+			"quota_name":    aws.ToString(quota.QuotaName),
+		}
+
+		state := appliedQuotaStateApplied
+		if request != nil {
+			tfMap["request_id"] = aws.ToString(request.Id)
+			tfMap["request_status"] = string(request.Status)
+			tfMap["requested_value"] = aws.ToFloat64(request.DesiredValue)
+
+			state = appliedQuotaState(aws.ToFloat64(quota.Value), aws.ToFloat64(request.DesiredValue), request.Status)
+		}
+		tfMap["state"] = state
+
+		switch state {
+		case appliedQuotaStateDenied:
+			deniedQuotaCodes = append(deniedQuotaCodes, quotaCode)
+		case appliedQuotaStatePending:
+			pendingQuotaCodes = append(pendingQuotaCodes, quotaCode)
+		}
+
+		quotas = append(quotas, tfMap)
+	}
+
+	d.SetId(serviceCode)
+	d.Set("all_applied", len(deniedQuotaCodes) == 0 && len(pendingQuotaCodes) == 0)
+	d.Set("denied_quota_codes", deniedQuotaCodes)
+	d.Set("pending_quota_codes", pendingQuotaCodes)
+	if err := d.Set("quotas", quotas); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting quotas: %s", err)
+	}
+
+	return diags
+}
+
+// appliedQuotaState returns whether the latest change request for a quota has been applied,
+// is still pending, or was denied.
+func appliedQuotaState(appliedValue, requestedValue float64, status types.RequestStatus) string {
+	switch status {
+	case types.RequestStatusDenied, types.RequestStatusNotApproved, types.RequestStatusInvalidRequest:
+		return appliedQuotaStateDenied
+	case types.RequestStatusPending, types.RequestStatusCaseOpened:
+		return appliedQuotaStatePending
+	}
+
+	// An approved request can take some time to be reflected in the applied value.
+	if appliedValue < requestedValue {
+		return appliedQuotaStatePending
+	}
+
+	return appliedQuotaStateApplied
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAppliedQuotaState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		appliedValue   float64
+		requestedValue float64
+		status         types.RequestStatus
+		expected       string
+	}{
+		"pending": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusPending,
+			expected:       "PENDING",
+		},
+		"case opened": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusCaseOpened,
+			expected:       "PENDING",
+		},
+		"approved not yet applied": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusApproved,
+			expected:       "PENDING",
+		},
+		"approved and applied": {
+			appliedValue:   10,
+			requestedValue: 10,
+			status:         types.RequestStatusApproved,
+			expected:       "APPLIED",
+		},
+		"case closed and applied": {
+			appliedValue:   20,
+			requestedValue: 10,
+			status:         types.RequestStatusCaseClosed,
+			expected:       "APPLIED",
+		},
+		"denied": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusDenied,
+			expected:       "DENIED",
+		},
+		"not approved": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusNotApproved,
+			expected:       "DENIED",
+		},
+		"invalid request": {
+			appliedValue:   5,
+			requestedValue: 10,
+			status:         types.RequestStatusInvalidRequest,
+			expected:       "DENIED",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfservicequotas.AppliedQuotaState(testCase.appliedValue, testCase.requestedValue, testCase.status), testCase.expected; got != want {
+				t.Errorf("AppliedQuotaState(%v, %v, %s) = %s, want %s", testCase.appliedValue, testCase.requestedValue, testCase.status, got, want)
+			}
+		})
+	}
+}
+
+func TestAccServiceQuotasAppliedQuotasDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	const dataSourceName = "data.aws_servicequotas_applied_quotas.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceQuotasEndpointID)
+			testAccPreCheckServiceQuotaSet(ctx, t, setQuotaServiceCode, setQuotaQuotaCode)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppliedQuotasDataSourceConfig_basic(setQuotaServiceCode, setQuotaQuotaCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "all_applied"),
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "quotas.0.applied_value", regexache.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.0.quota_code", setQuotaQuotaCode),
+					resource.TestCheckResourceAttr(dataSourceName, "quotas.0.quota_name", setQuotaQuotaName),
+					resource.TestMatchResourceAttr(dataSourceName, "quotas.0.state", regexache.MustCompile(`^(APPLIED|DENIED|PENDING)$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppliedQuotasDataSourceConfig_basic(serviceCode, quotaCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_applied_quotas" "test" {
+  quota_codes  = [%[1]q]
+  service_code = %[2]q
+}
+`, quotaCode, serviceCode)
+}
@@ -55,6 +55,36 @@ func findServiceQuotaDefaultByName(ctx context.Context, conn *servicequotas.Clie
 	return nil, tfresource.NewEmptyResultError(input)
 }
 
+func findLatestRequestedServiceQuotaChangeByQuota(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*types.RequestedServiceQuotaChange, error) {
+	input := &servicequotas.ListRequestedServiceQuotaChangeHistoryByQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	}
+
+	var latest *types.RequestedServiceQuotaChange
+
+	paginator := servicequotas.NewListRequestedServiceQuotaChangeHistoryByQuotaPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RequestedQuotas {
+			if latest == nil || aws.ToTime(v.Created).After(aws.ToTime(latest.Created)) {
+				v := v
+				latest = &v
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return latest, nil
+}
+
 func findServiceQuotaByID(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*types.ServiceQuota, error) {
 	input := &servicequotas.GetServiceQuotaInput{
 		ServiceCode: aws.String(serviceCode),
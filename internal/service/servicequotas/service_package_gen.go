@@ -38,6 +38,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAppliedQuotas,
+			TypeName: "aws_servicequotas_applied_quotas",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_servicequotas_service",
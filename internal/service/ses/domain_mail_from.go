@@ -5,11 +5,14 @@ package ses
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -26,6 +29,11 @@ func ResourceDomainMailFrom() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:     schema.TypeString,
@@ -36,11 +44,27 @@ func ResourceDomainMailFrom() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"mail_from_domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mx_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spf_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"behavior_on_mx_failure": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  ses.BehaviorOnMXFailureUseDefaultValue,
 			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -66,6 +90,17 @@ func resourceDomainMailFromSet(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(domainName)
 
+	if d.Get("wait_for_verification").(bool) {
+		timeout := d.Timeout(schema.TimeoutCreate)
+		if !d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutUpdate)
+		}
+
+		if _, err := waitMailFromDomainVerified(ctx, conn, domainName, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SES MAIL FROM domain (%s) verification: %s", mailFromDomain, err)
+		}
+	}
+
 	return append(diags, resourceDomainMailFromRead(ctx, d, meta)...)
 }
 
@@ -102,6 +137,9 @@ func resourceDomainMailFromRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("behavior_on_mx_failure", attributes.BehaviorOnMXFailure)
 	d.Set("domain", domainName)
 	d.Set("mail_from_domain", attributes.MailFromDomain)
+	d.Set("mail_from_domain_status", attributes.MailFromDomainStatus)
+	d.Set("mx_record", fmt.Sprintf("10 feedback-smtp.%s.amazonses.com", meta.(*conns.AWSClient).Region))
+	d.Set("spf_record", "v=spf1 include:amazonses.com ~all")
 
 	return diags
 }
@@ -124,3 +162,41 @@ func resourceDomainMailFromDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+func statusMailFromDomain(ctx context.Context, conn *ses.SES, identity string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetIdentityMailFromDomainAttributesWithContext(ctx, &ses.GetIdentityMailFromDomainAttributesInput{
+			Identities: aws.StringSlice([]string{identity}),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		attributes, ok := output.MailFromDomainAttributes[identity]
+
+		if !ok || attributes == nil {
+			return nil, "", nil
+		}
+
+		return attributes, aws.StringValue(attributes.MailFromDomainStatus), nil
+	}
+}
+
+func waitMailFromDomainVerified(ctx context.Context, conn *ses.SES, identity string, timeout time.Duration) (*ses.IdentityMailFromDomainAttributes, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ses.CustomMailFromStatusPending, ses.CustomMailFromStatusTemporaryFailure},
+		Target:     []string{ses.CustomMailFromStatusSuccess},
+		Refresh:    statusMailFromDomain(ctx, conn, identity),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ses.IdentityMailFromDomainAttributes); ok {
+		return output, err
+	}
+
+	return nil, err
+}
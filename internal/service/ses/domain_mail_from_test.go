@@ -142,6 +142,39 @@ func TestAccSESDomainMailFrom_behaviorOnMxFailure(t *testing.T) {
 	})
 }
 
+func TestAccSESDomainMailFrom_records(t *testing.T) {
+	ctx := acctest.Context(t)
+	dn := acctest.RandomDomain()
+	domain := dn.String()
+	mailFromDomain := dn.Subdomain("bounce").String()
+	resourceName := "aws_ses_domain_mail_from.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainMailFromDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainMailFromConfig_records(domain, mailFromDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainMailFromExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "mail_from_domain_status"),
+					resource.TestCheckResourceAttr(resourceName, "mx_record", fmt.Sprintf("10 feedback-smtp.%s.amazonses.com", acctest.Region())),
+					resource.TestCheckResourceAttr(resourceName, "spf_record", "v=spf1 include:amazonses.com ~all"),
+					resource.TestCheckResourceAttrPair("aws_route53_record.mx", "records.0", resourceName, "mx_record"),
+					resource.TestCheckResourceAttrPair("aws_route53_record.spf", "records.0", resourceName, "spf_record"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDomainMailFromExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -242,3 +275,36 @@ resource "aws_ses_domain_mail_from" "test" {
 }
 `, domain, behaviorOnMxFailure)
 }
+
+func testAccDomainMailFromConfig_records(domain, mailFromDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_ses_domain_identity" "test" {
+  domain = %[1]q
+}
+
+resource "aws_ses_domain_mail_from" "test" {
+  domain           = aws_ses_domain_identity.test.domain
+  mail_from_domain = %[2]q
+}
+
+resource "aws_route53_record" "mx" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = aws_ses_domain_mail_from.test.mail_from_domain
+  type    = "MX"
+  ttl     = "600"
+  records = [aws_ses_domain_mail_from.test.mx_record]
+}
+
+resource "aws_route53_record" "spf" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = aws_ses_domain_mail_from.test.mail_from_domain
+  type    = "TXT"
+  ttl     = "600"
+  records = [aws_ses_domain_mail_from.test.spf_record]
+}
+`, domain, mailFromDomain)
+}
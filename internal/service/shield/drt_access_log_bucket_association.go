@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
@@ -60,6 +61,8 @@ func (r *drtAccessLogBucketAssociationResource) Schema(ctx context.Context, requ
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z.-]*[0-9a-z]$`), "must contain only lowercase alphanumeric characters, hyphens and periods, and begin and end with a letter or number"),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^(?:[^.]|\.[^.])*$`), "must not contain consecutive periods"),
 				},
 			},
 			"role_arn_association_id": schema.StringAttribute{
@@ -67,7 +70,6 @@ func (r *drtAccessLogBucketAssociationResource) Schema(ctx context.Context, requ
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Description: `Unused`,
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -93,7 +95,10 @@ func (r *drtAccessLogBucketAssociationResource) Create(ctx context.Context, requ
 		LogBucket: aws.String(logBucket),
 	}
 
-	_, err := conn.AssociateDRTLogBucket(ctx, input)
+	// Wait for a newly associated DRT role to propagate.
+	_, err := tfresource.RetryWhenIsA[*awstypes.NoAssociatedRoleException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateDRTLogBucket(ctx, input)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("creating Shield DRT Log Bucket Association (%s)", logBucket), err.Error())
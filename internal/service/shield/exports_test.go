@@ -10,10 +10,12 @@ var (
 	ResourceApplicationLayerAutomaticResponse = newApplicationLayerAutomaticResponseResource
 	ResourceProactiveEngagement               = newProactiveEngagementResource
 	ResourceProtection                        = resourceProtection
+	ResourceSubscription                      = newSubscriptionResource
 
 	FindApplicationLayerAutomaticResponseByResourceARN = findApplicationLayerAutomaticResponseByResourceARN
 	FindDRTLogBucketAssociation                        = findDRTLogBucketAssociation
 	FindDRTRoleARNAssociation                          = findDRTRoleARNAssociation
 	FindEmergencyContactSettings                       = findEmergencyContactSettings
 	FindProtectionByID                                 = findProtectionByID
+	FindSubscription                                   = findSubscription
 )
@@ -27,6 +27,9 @@ func TestAccShield_serial(t *testing.T) {
 			"disabled":   testAccProactiveEngagement_disabled,
 			"disappears": testAccProactiveEngagement_disappears,
 		},
+		"Subscription": {
+			"basic": testAccSubscription_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/shield"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/shield/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscription")
+func newSubscriptionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &subscriptionResource{}, nil
+}
+
+type subscriptionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *subscriptionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_shield_subscription"
+}
+
+func (r *subscriptionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_renew": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(awstypes.AutoRenewEnabled)),
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AutoRenew](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"skip_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	input := &shield.CreateSubscriptionInput{}
+
+	_, err := conn.CreateSubscription(ctx, input)
+
+	// An existing subscription is adopted.
+	if err != nil && !errs.IsA[*awstypes.ResourceAlreadyExistsException](err) {
+		response.Diagnostics.AddError("creating Shield Subscription", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	// Auto-renew can only be configured once the subscription exists.
+	if err := updateSubscriptionAutoRenew(ctx, conn, awstypes.AutoRenew(data.AutoRenew.ValueString())); err != nil {
+		response.Diagnostics.AddError("updating Shield Subscription", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriptionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	subscription, err := findSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Shield Subscription", err.Error())
+
+		return
+	}
+
+	data.AutoRenew = types.StringValue(string(subscription.AutoRenew))
+	if data.SkipDestroy.IsNull() {
+		data.SkipDestroy = types.BoolValue(false)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriptionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	if !new.AutoRenew.Equal(old.AutoRenew) {
+		if err := updateSubscriptionAutoRenew(ctx, conn, awstypes.AutoRenew(new.AutoRenew.ValueString())); err != nil {
+			response.Diagnostics.AddError("updating Shield Subscription", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *subscriptionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SkipDestroy.ValueBool() {
+		return
+	}
+
+	conn := r.Meta().ShieldClient(ctx)
+
+	input := &shield.DeleteSubscriptionInput{}
+
+	_, err := conn.DeleteSubscription(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	// Subscriptions can't be cancelled during their commitment period.
+	if errs.IsA[*awstypes.LockedSubscriptionException](err) {
+		response.Diagnostics.AddError("deleting Shield Subscription", fmt.Sprintf("%s; set skip_destroy to remove the subscription from state only", err))
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("deleting Shield Subscription", err.Error())
+
+		return
+	}
+}
+
+func updateSubscriptionAutoRenew(ctx context.Context, conn *shield.Client, autoRenew awstypes.AutoRenew) error {
+	input := &shield.UpdateSubscriptionInput{
+		AutoRenew: autoRenew,
+	}
+
+	_, err := conn.UpdateSubscription(ctx, input)
+
+	return err
+}
+
+type subscriptionResourceModel struct {
+	AutoRenew   types.String `tfsdk:"auto_renew"`
+	ID          types.String `tfsdk:"id"`
+	SkipDestroy types.Bool   `tfsdk:"skip_destroy"`
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/shield/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+func testAccSubscription_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription types.Subscription
+	resourceName := "aws_shield_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSubscription(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Subscriptions can't be cancelled during their commitment period.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionConfig_basic(string(types.AutoRenewDisabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", string(types.AutoRenewDisabled)),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+			{
+				Config: testAccSubscriptionConfig_basic(string(types.AutoRenewEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", string(types.AutoRenewEnabled)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionExists(ctx context.Context, n string, v *types.Subscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldClient(ctx)
+
+		output, err := tfshield.FindSubscription(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Creating a Shield Advanced subscription incurs a one-year commitment.
+func testAccPreCheckSubscription(t *testing.T) {
+	acctest.SkipIfEnvVarNotSet(t, "AWS_SHIELD_ENABLE_SUBSCRIPTION")
+}
+
+func testAccSubscriptionConfig_basic(autoRenew string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_subscription" "test" {
+  auto_renew   = %[1]q
+  skip_destroy = true
+}
+`, autoRenew)
+}
@@ -5,8 +5,46 @@ package ssm
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	opsItemCategoryAvailability = "Availability"
+	opsItemCategoryCost         = "Cost"
+	opsItemCategoryPerformance  = "Performance"
+	opsItemCategoryRecovery     = "Recovery"
+	opsItemCategorySecurity     = "Security"
+)
+
+func opsItemCategory_Values() []string {
+	return []string{
+		opsItemCategoryAvailability,
+		opsItemCategoryCost,
+		opsItemCategoryPerformance,
+		opsItemCategoryRecovery,
+		opsItemCategorySecurity,
+	}
+}
+
+func opsItemSeverity_Values() []string {
+	return []string{"1", "2", "3", "4"}
+}
+
+// opsItemStatus_Values returns the statuses that can be set on an OpsItem.
+func opsItemStatus_Values() []string {
+	return []string{
+		ssm.OpsItemStatusOpen,
+		ssm.OpsItemStatusInProgress,
+		ssm.OpsItemStatusResolved,
+	}
+}
+
+const (
+	opsItemRelatedItemAssociationTypeIsParentOf = "IsParentOf"
+	opsItemRelatedItemAssociationTypeRelatesTo  = "RelatesTo"
+)
@@ -35,6 +35,8 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 			StateContext: resourceMaintenanceWindowTaskImport,
 		},
 
+		CustomizeDiff: resourceMaintenanceWindowTaskCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -304,6 +306,10 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 													Type:     schema.TypeString,
 													Optional: true,
 													Computed: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 512),
+														validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.#/-]+$`), "must contain only alphanumeric characters, underscores, hyphens, periods, forward slashes and hash signs"),
+													),
 												},
 												"cloudwatch_output_enabled": {
 													Type:     schema.TypeBool,
@@ -864,6 +870,23 @@ func resourceMaintenanceWindowTaskDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func resourceMaintenanceWindowTaskCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Rate controls can only be set on tasks that have targets.
+	targets := d.GetRawConfig().GetAttr("targets")
+
+	if !targets.IsKnown() || (!targets.IsNull() && targets.LengthInt() > 0) {
+		return nil
+	}
+
+	for _, k := range []string{"max_concurrency", "max_errors"} {
+		if !d.GetRawConfig().GetAttr(k).IsNull() {
+			return fmt.Errorf("%q can only be set when \"targets\" are configured", k)
+		}
+	}
+
+	return nil
+}
+
 func resourceMaintenanceWindowTaskImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.SplitN(d.Id(), "/", 2)
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
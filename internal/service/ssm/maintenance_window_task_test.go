@@ -96,6 +96,24 @@ func TestAccSSMMaintenanceWindowTask_noTarget(t *testing.T) {
 	})
 }
 
+func TestAccSSMMaintenanceWindowTask_noTargetRateControls(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMaintenanceWindowTaskConfig_noTargetRateControls(rName),
+				ExpectError: regexache.MustCompile(`"max_concurrency" can only be set when "targets" are configured`),
+			},
+		},
+	})
+}
+
 func TestAccSSMMaintenanceWindowTask_cutoff(t *testing.T) {
 	ctx := acctest.Context(t)
 	var before ssm.MaintenanceWindowTask
@@ -663,6 +681,21 @@ resource "aws_ssm_maintenance_window_task" "test" {
 `)
 }
 
+func testAccMaintenanceWindowTaskConfig_noTargetRateControls(rName string) string {
+	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "AUTOMATION"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+  max_concurrency  = "2"
+  max_errors       = "1"
+}
+`)
+}
+
 func testAccMaintenanceWindowTaskConfig_cutoff(rName, cutoff string) string {
 	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName)+`
 
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	opsItemRelatedItemResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_ssm_ops_item_related_item", name="OpsItem Related Item")
+func ResourceOpsItemRelatedItem() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsItemRelatedItemCreate,
+		ReadWithoutTimeout:   resourceOpsItemRelatedItemRead,
+		DeleteWithoutTimeout: resourceOpsItemRelatedItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  opsItemRelatedItemAssociationTypeRelatesTo,
+				ValidateFunc: validation.StringInSlice([]string{
+					opsItemRelatedItemAssociationTypeIsParentOf,
+					opsItemRelatedItemAssociationTypeRelatesTo,
+				}, false),
+			},
+			"ops_item_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"resource_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceOpsItemRelatedItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	opsItemID := d.Get("ops_item_id").(string)
+	input := &ssm.AssociateOpsItemRelatedItemInput{
+		AssociationType: aws.String(d.Get("association_type").(string)),
+		OpsItemId:       aws.String(opsItemID),
+		ResourceType:    aws.String(d.Get("resource_type").(string)),
+		ResourceUri:     aws.String(d.Get("resource_uri").(string)),
+	}
+
+	output, err := conn.AssociateOpsItemRelatedItemWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s) related item: %s", opsItemID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{opsItemID, aws.StringValue(output.AssociationId)}, opsItemRelatedItemResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceOpsItemRelatedItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemRelatedItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), opsItemRelatedItemResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	opsItemID, associationID := parts[0], parts[1]
+	relatedItem, err := FindOpsItemRelatedItemByTwoPartKey(ctx, conn, opsItemID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem Related Item %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem Related Item (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_id", relatedItem.AssociationId)
+	d.Set("association_type", relatedItem.AssociationType)
+	d.Set("ops_item_id", relatedItem.OpsItemId)
+	d.Set("resource_type", relatedItem.ResourceType)
+	d.Set("resource_uri", relatedItem.ResourceUri)
+
+	return diags
+}
+
+func resourceOpsItemRelatedItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), opsItemRelatedItemResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	opsItemID, associationID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting SSM OpsItem Related Item: %s", d.Id())
+	_, err = conn.DisassociateOpsItemRelatedItemWithContext(ctx, &ssm.DisassociateOpsItemRelatedItemInput{
+		AssociationId: aws.String(associationID),
+		OpsItemId:     aws.String(opsItemID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException, ssm.ErrCodeOpsItemRelatedItemAssociationNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM OpsItem Related Item (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindOpsItemRelatedItemByTwoPartKey(ctx context.Context, conn *ssm.SSM, opsItemID, associationID string) (*ssm.OpsItemRelatedItemSummary, error) {
+	input := &ssm.ListOpsItemRelatedItemsInput{
+		Filters: []*ssm.OpsItemRelatedItemsFilter{{
+			Key:      aws.String(ssm.OpsItemRelatedItemsFilterKeyAssociationId),
+			Operator: aws.String(ssm.OpsItemRelatedItemsFilterOperatorEqual),
+			Values:   aws.StringSlice([]string{associationID}),
+		}},
+		OpsItemId: aws.String(opsItemID),
+	}
+
+	output, err := findOpsItemRelatedItems(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findOpsItemRelatedItems(ctx context.Context, conn *ssm.SSM, input *ssm.ListOpsItemRelatedItemsInput) ([]*ssm.OpsItemRelatedItemSummary, error) {
+	var output []*ssm.OpsItemRelatedItemSummary
+
+	err := conn.ListOpsItemRelatedItemsPagesWithContext(ctx, input, func(page *ssm.ListOpsItemRelatedItemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Summaries {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMOpsItemRelatedItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item_related_item.test"
+	opsItemResourceName := "aws_ssm_ops_item.test"
+	documentResourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemRelatedItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemRelatedItemConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOpsItemRelatedItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttr(resourceName, "association_type", "RelatesTo"),
+					resource.TestCheckResourceAttrPair(resourceName, "ops_item_id", opsItemResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::SSM::Document"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_uri", documentResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItemRelatedItem_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item_related_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemRelatedItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemRelatedItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemRelatedItemExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceOpsItemRelatedItem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemRelatedItemExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		_, err := tfssm.FindOpsItemRelatedItemByTwoPartKey(ctx, conn, rs.Primary.Attributes["ops_item_id"], rs.Primary.Attributes["association_id"])
+
+		return err
+	}
+}
+
+func testAccCheckOpsItemRelatedItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_ops_item_related_item" {
+				continue
+			}
+
+			_, err := tfssm.FindOpsItemRelatedItemByTwoPartKey(ctx, conn, rs.Primary.Attributes["ops_item_id"], rs.Primary.Attributes["association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM OpsItem Related Item %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOpsItemRelatedItemConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  description = "testing"
+  source      = "terraform"
+  title       = %[1]q
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": ["ifconfig"]
+        }
+      ]
+    }
+  }
+}
+DOC
+}
+
+resource "aws_ssm_ops_item_related_item" "test" {
+  ops_item_id   = aws_ssm_ops_item.test.id
+  resource_type = "AWS::SSM::Document"
+  resource_uri  = aws_ssm_document.test.arn
+}
+`, rName)
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexache.MustCompile(`opsitem/oi-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "notification_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_type", "/aws/issue"),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Open"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceOpsItem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_full(rName, "Security", "2", "InProgress", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category", "Security"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "tf-test",
+						"type":  "SearchableString",
+						"value": "value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "3"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "InProgress"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_full(rName, "Performance", "4", "Resolved", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category", "Performance"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "tf-test",
+						"type":  "SearchableString",
+						"value": "value2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "severity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Resolved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_relatedOpsItemIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_relatedOpsItemIDs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "related_ops_item_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "related_ops_item_ids.*", "aws_ssm_ops_item.related", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ssm.OpsItem
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemExists(ctx context.Context, n string, v *ssm.OpsItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOpsItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_ops_item" {
+				continue
+			}
+
+			_, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM OpsItem %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOpsItemConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  description = "testing"
+  source      = "terraform"
+  title       = %[1]q
+}
+`, rName)
+}
+
+func testAccOpsItemConfig_full(rName, category, severity, status, dataValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  category    = %[2]q
+  description = "testing"
+  priority    = 3
+  severity    = %[3]q
+  source      = "terraform"
+  status      = %[4]q
+  title       = %[1]q
+
+  operational_data {
+    key   = "tf-test"
+    value = %[5]q
+  }
+}
+`, rName, category, severity, status, dataValue)
+}
+
+func testAccOpsItemConfig_relatedOpsItemIDs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "related" {
+  description = "related"
+  source      = "terraform"
+  title       = "%[1]s-related"
+}
+
+resource "aws_ssm_ops_item" "test" {
+  description          = "testing"
+  related_ops_item_ids = [aws_ssm_ops_item.related.id]
+  source               = "terraform"
+  title                = %[1]q
+}
+`, rName)
+}
+
+func testAccOpsItemConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  description = "testing"
+  source      = "terraform"
+  title       = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  description = "testing"
+  source      = "terraform"
+  title       = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -97,6 +97,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMaintenanceWindowTask,
 			TypeName: "aws_ssm_maintenance_window_task",
 		},
+		{
+			Factory:  ResourceOpsItem,
+			TypeName: "aws_ssm_ops_item",
+			Name:     "OpsItem",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+				ResourceType:        "OpsItem",
+			},
+		},
+		{
+			Factory:  ResourceOpsItemRelatedItem,
+			TypeName: "aws_ssm_ops_item_related_item",
+			Name:     "OpsItem Related Item",
+		},
 		{
 			Factory:  ResourceParameter,
 			TypeName: "aws_ssm_parameter",
@@ -47,6 +47,7 @@ func ResourceGateway() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,6 +72,56 @@ func ResourceGateway() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtLeast(51200),
 			},
+			"bandwidth_rate_limit_interval": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      20,
+				ConflictsWith: []string{"average_download_rate_limit_in_bits_per_sec", "average_upload_rate_limit_in_bits_per_sec"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_download_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(102400),
+						},
+						"average_upload_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(51200),
+						},
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"end_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"start_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
 			"cloudwatch_log_group_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -255,6 +306,14 @@ func ResourceGateway() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(storagegateway.SMBSecurityStrategy_Values(), false),
 			},
+			"software_update_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"software_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tape_drive_type": {
@@ -482,6 +541,19 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("bandwidth_rate_limit_interval"); ok && len(v.([]interface{})) > 0 {
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(v.([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -594,6 +666,7 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("ec2_instance_id", output.Ec2InstanceId)
 	d.Set("endpoint_type", output.EndpointType)
 	d.Set("host_environment", output.HostEnvironment)
+	d.Set("software_version", output.SoftwareVersion)
 
 	if err := d.Set("gateway_network_interface", flattenGatewayNetworkInterfaces(output.GatewayNetworkInterfaces)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting gateway_network_interface: %s", err)
@@ -620,6 +693,29 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	bandwidthScheduleOutput, err := conn.DescribeBandwidthRateLimitScheduleWithContext(ctx, &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+		GatewayARN: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not supported") ||
+		tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not valid") {
+		err = nil
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Storage Gateway bandwidth rate limit schedule: %s", err)
+	}
+
+	// A flat bandwidth rate limit is reported by the schedule API as a single all-week interval.
+	// Only track the schedule when it is being managed through this argument.
+	if _, ok := d.GetOk("bandwidth_rate_limit_interval"); ok && bandwidthScheduleOutput != nil {
+		if err := d.Set("bandwidth_rate_limit_interval", flattenBandwidthRateLimitIntervals(bandwidthScheduleOutput.BandwidthRateLimitIntervals)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting bandwidth_rate_limit_interval: %s", err)
+		}
+	} else {
+		d.Set("bandwidth_rate_limit_interval", nil)
+	}
+
 	maintenanceStartTimeOutput, err := conn.DescribeMaintenanceStartTimeWithContext(ctx, &storagegateway.DescribeMaintenanceStartTimeInput{
 		GatewayARN: aws.String(d.Id()),
 	})
@@ -783,6 +879,47 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("bandwidth_rate_limit_interval") {
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(d.Get("bandwidth_rate_limit_interval").([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway bandwidth rate limit schedule: %s", input)
+		_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("software_update_trigger") && d.Get("software_update_trigger").(string) != "" {
+		output, err := conn.DescribeGatewayInformationWithContext(ctx, &storagegateway.DescribeGatewayInformationInput{
+			GatewayARN: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Gateway (%s): %s", d.Id(), err)
+		}
+
+		if aws.StringValue(output.NextUpdateAvailabilityDate) == "" {
+			log.Printf("[INFO] Storage Gateway Gateway (%s) has no software update available", d.Id())
+		} else {
+			log.Printf("[DEBUG] Updating Storage Gateway Gateway (%s) software", d.Id())
+			_, err := conn.UpdateGatewaySoftwareNowWithContext(ctx, &storagegateway.UpdateGatewaySoftwareNowInput{
+				GatewayARN: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) software: %s", d.Id(), err)
+			}
+
+			if _, err := waitGatewaySoftwareUpdated(ctx, conn, d.Id(), aws.StringValue(output.SoftwareVersion), aws.StringValue(output.LastSoftwareUpdate), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Storage Gateway Gateway (%s) software update: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -909,6 +1046,66 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 	return tfMap
 }
 
+func expandBandwidthRateLimitIntervals(tfList []interface{}) []*storagegateway.BandwidthRateLimitInterval {
+	apiObjects := []*storagegateway.BandwidthRateLimitInterval{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &storagegateway.BandwidthRateLimitInterval{
+			DaysOfWeek:        flex.ExpandInt64Set(tfMap["days_of_week"].(*schema.Set)),
+			EndHourOfDay:      aws.Int64(int64(tfMap["end_hour_of_day"].(int))),
+			EndMinuteOfHour:   aws.Int64(int64(tfMap["end_minute_of_hour"].(int))),
+			StartHourOfDay:    aws.Int64(int64(tfMap["start_hour_of_day"].(int))),
+			StartMinuteOfHour: aws.Int64(int64(tfMap["start_minute_of_hour"].(int))),
+		}
+
+		if v, ok := tfMap["average_download_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["average_upload_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBandwidthRateLimitIntervals(apiObjects []*storagegateway.BandwidthRateLimitInterval) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"average_download_rate_limit_in_bits_per_sec": aws.Int64Value(apiObject.AverageDownloadRateLimitInBitsPerSec),
+			"average_upload_rate_limit_in_bits_per_sec":   aws.Int64Value(apiObject.AverageUploadRateLimitInBitsPerSec),
+			"days_of_week":         flex.FlattenInt64Set(apiObject.DaysOfWeek),
+			"end_hour_of_day":      aws.Int64Value(apiObject.EndHourOfDay),
+			"end_minute_of_hour":   aws.Int64Value(apiObject.EndMinuteOfHour),
+			"start_hour_of_day":    aws.Int64Value(apiObject.StartHourOfDay),
+			"start_minute_of_hour": aws.Int64Value(apiObject.StartMinuteOfHour),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 // The API returns multiple responses for a missing gateway
 func IsErrGatewayNotFound(err error) bool {
 	if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found.") {
@@ -872,6 +872,90 @@ func TestAccStorageGatewayGateway_maintenanceStartTime(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_bandwidthRateLimitInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_upload_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_hour_of_day", "17"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_minute_of_hour", "59"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_minute_of_hour", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "bandwidth_rate_limit_interval", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 2*102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "204800"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_upload_rate_limit_in_bits_per_sec", "204800"),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_typeCached(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_softwareUpdateTrigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_typeCached(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "software_update_trigger", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "software_version"),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_softwareUpdateTrigger(rName, "update-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "software_update_trigger", "update-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "software_version"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGatewayDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn(ctx)
@@ -1471,6 +1555,39 @@ resource "aws_storagegateway_gateway" "test" {
 `, rName, rate))
 }
 
+func testAccGatewayConfig_bandwidthRateLimitInterval(rName string, rate int) string {
+	return acctest.ConfigCompose(testAcc_TapeAndVolumeGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  bandwidth_rate_limit_interval {
+    average_download_rate_limit_in_bits_per_sec = %[2]d
+    average_upload_rate_limit_in_bits_per_sec   = %[2]d
+    days_of_week                                = [1, 2, 3, 4, 5]
+    start_hour_of_day                           = 9
+    start_minute_of_hour                        = 0
+    end_hour_of_day                             = 17
+    end_minute_of_hour                          = 59
+  }
+}
+`, rName, rate))
+}
+
+func testAccGatewayConfig_softwareUpdateTrigger(rName, trigger string) string {
+	return acctest.ConfigCompose(testAcc_TapeAndVolumeGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address      = aws_instance.test.public_ip
+  gateway_name            = %[1]q
+  gateway_timezone        = "GMT"
+  gateway_type            = "CACHED"
+  software_update_trigger = %[2]q
+}
+`, rName, trigger))
+}
+
 func testAccGatewayConfig_maintenanceStartTime(rName string, hourOfDay, minuteOfHour int, dayOfWeek, dayOfMonth string) string {
 	if dayOfWeek == "" {
 		dayOfWeek = strconv.Quote(dayOfWeek)
@@ -18,6 +18,12 @@ const (
 	storediSCSIVolumeStatusNotFound = "NotFound"
 )
 
+const (
+	gatewaySoftwareUpdateStatusPending  = "Pending"
+	gatewaySoftwareUpdateStatusUpdated  = "Updated"
+	gatewaySoftwareUpdateStatusUpdating = "Updating"
+)
+
 func statusGateway(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &storagegateway.DescribeGatewayInformationInput{
@@ -38,6 +44,32 @@ func statusGateway(ctx context.Context, conn *storagegateway.StorageGateway, gat
 	}
 }
 
+// statusGatewaySoftwareUpdate compares the gateway's software version and last update time
+// against the values observed before the update was requested.
+func statusGatewaySoftwareUpdate(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN, previousVersion, previousUpdate string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &storagegateway.DescribeGatewayInformationInput{
+			GatewayARN: aws.String(gatewayARN),
+		}
+
+		output, err := conn.DescribeGatewayInformationWithContext(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway is not connected") {
+			return output, gatewaySoftwareUpdateStatusUpdating, nil
+		}
+
+		if err != nil {
+			return output, "", err
+		}
+
+		if aws.StringValue(output.SoftwareVersion) == previousVersion && aws.StringValue(output.LastSoftwareUpdate) == previousUpdate {
+			return output, gatewaySoftwareUpdateStatusPending, nil
+		}
+
+		return output, gatewaySoftwareUpdateStatusUpdated, nil
+	}
+}
+
 func statusGatewayJoinDomain(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &storagegateway.DescribeSMBSettingsInput{
@@ -44,6 +44,25 @@ func waitGatewayConnected(ctx context.Context, conn *storagegateway.StorageGatew
 	}
 }
 
+func waitGatewaySoftwareUpdated(ctx context.Context, conn *storagegateway.StorageGateway, gatewayARN, previousVersion, previousUpdate string, timeout time.Duration) (*storagegateway.DescribeGatewayInformationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{gatewaySoftwareUpdateStatusPending, gatewaySoftwareUpdateStatusUpdating},
+		Target:                    []string{gatewaySoftwareUpdateStatusUpdated},
+		Refresh:                   statusGatewaySoftwareUpdate(ctx, conn, gatewayARN, previousVersion, previousUpdate),
+		Timeout:                   timeout,
+		MinTimeout:                gatewayConnectedMinTimeout,
+		ContinuousTargetOccurence: gatewayConnectedContinuousTargetOccurence, // The gateway reboots while the update is applied
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*storagegateway.DescribeGatewayInformationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGatewayJoinDomainJoined(ctx context.Context, conn *storagegateway.StorageGateway, volumeARN string) (*storagegateway.DescribeSMBSettingsOutput, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{storagegateway.ActiveDirectoryStatusJoining},
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func resourceDBParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBParameterGroupCreate,
+		ReadWithoutTimeout:   resourceDBParameterGroupRead,
+		UpdateWithoutTimeout: resourceDBParameterGroupUpdate,
+		DeleteWithoutTimeout: resourceDBParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*(-[0-9A-Za-z]+)*$`), "must start with a letter, contain only alphanumeric characters or hyphens, and not contain consecutive hyphens or end with a hyphen"),
+				),
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"influxdb_v2": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flux_log_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"log_level": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.LogLevel](),
+									},
+									"metrics_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"no_tasks": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"query_concurrency": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"query_queue_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"tracing_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.TracingType](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Parameters = expandParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDbParameterGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Timestream for InfluxDB DB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceDBParameterGroupRead(ctx, d, meta)...)
+}
+
+func resourceDBParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+	output, err := findDBParameterGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Timestream for InfluxDB DB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if output.Parameters != nil {
+		if err := d.Set("parameters", []interface{}{flattenParameters(output.Parameters)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+		}
+	} else {
+		d.Set("parameters", nil)
+	}
+
+	return diags
+}
+
+func resourceDBParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceDBParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Timestream for InfluxDB DB Parameter Group (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	input := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbParameterGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandParameters(tfMap map[string]interface{}) awstypes.Parameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["influxdb_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return &awstypes.ParametersMemberInfluxDBv2{
+			Value: expandInfluxDBv2Parameters(v[0].(map[string]interface{})),
+		}
+	}
+
+	return nil
+}
+
+func expandInfluxDBv2Parameters(tfMap map[string]interface{}) awstypes.InfluxDBv2Parameters {
+	apiObject := awstypes.InfluxDBv2Parameters{}
+
+	if v, ok := tfMap["flux_log_enabled"].(bool); ok {
+		apiObject.FluxLogEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["log_level"].(string); ok && v != "" {
+		apiObject.LogLevel = awstypes.LogLevel(v)
+	}
+
+	if v, ok := tfMap["metrics_disabled"].(bool); ok {
+		apiObject.MetricsDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["no_tasks"].(bool); ok {
+		apiObject.NoTasks = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["query_concurrency"].(int); ok && v != 0 {
+		apiObject.QueryConcurrency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["query_queue_size"].(int); ok && v != 0 {
+		apiObject.QueryQueueSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["tracing_type"].(string); ok && v != "" {
+		apiObject.TracingType = awstypes.TracingType(v)
+	}
+
+	return apiObject
+}
+
+func flattenParameters(apiObject awstypes.Parameters) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ParametersMemberInfluxDBv2:
+		tfMap["influxdb_v2"] = []interface{}{flattenInfluxDBv2Parameters(&v.Value)}
+	}
+
+	return tfMap
+}
+
+func flattenInfluxDBv2Parameters(apiObject *awstypes.InfluxDBv2Parameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FluxLogEnabled; v != nil {
+		tfMap["flux_log_enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.LogLevel; v != "" {
+		tfMap["log_level"] = v
+	}
+
+	if v := apiObject.MetricsDisabled; v != nil {
+		tfMap["metrics_disabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.NoTasks; v != nil {
+		tfMap["no_tasks"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.QueryConcurrency; v != nil {
+		tfMap["query_concurrency"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.QueryQueueSize; v != nil {
+		tfMap["query_queue_size"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.TracingType; v != "" {
+		tfMap["tracing_type"] = v
+	}
+
+	return tfMap
+}
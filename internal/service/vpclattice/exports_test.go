@@ -13,9 +13,13 @@ var (
 	FindTargetByThreePartKey                 = findTargetByThreePartKey
 
 	IDFromIDOrARN                               = idFromIDOrARN
+	ServiceNetworkAssociationIDFromIDOrARN      = serviceNetworkAssociationIDFromIDOrARN
 	SuppressEquivalentCloudWatchLogsLogGroupARN = suppressEquivalentCloudWatchLogsLogGroupARN
 	SuppressEquivalentIDOrARN                   = suppressEquivalentIDOrARN
 
+	ServiceNetworkServiceAssociationIDPrefix = serviceNetworkServiceAssociationIDPrefix
+	ServiceNetworkVPCAssociationIDPrefix     = serviceNetworkVPCAssociationIDPrefix
+
 	ResourceAccessLogSubscription            = resourceAccessLogSubscription
 	ResourceService                          = resourceService
 	ResourceServiceNetwork                   = resourceServiceNetwork
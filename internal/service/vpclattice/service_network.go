@@ -5,6 +5,7 @@ package vpclattice
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -186,6 +187,41 @@ func idFromIDOrARN(idOrARN string) string {
 	return idOrARN[strings.LastIndex(idOrARN, "/")+1:]
 }
 
+// Service network association IDs are prefixed by the association type.
+const (
+	serviceNetworkServiceAssociationIDPrefix = "snsa-"
+	serviceNetworkVPCAssociationIDPrefix     = "snva-"
+)
+
+// serviceNetworkAssociationIDFromIDOrARN returns a service network association ID from an ID or ARN,
+// checking that the association is of the type identified by prefix.
+func serviceNetworkAssociationIDFromIDOrARN(idOrARN, prefix string) (string, error) {
+	// e.g. "snva-1234567890abcdefg" or
+	// "arn:aws:vpc-lattice:us-east-1:123456789012:servicenetworkvpcassociation/snva-1234567890abcdefg".
+	id := idFromIDOrARN(idOrARN)
+
+	if !strings.HasPrefix(id, prefix) {
+		return "", fmt.Errorf("unexpected format of ID (%q), expected %sID or ARN", idOrARN, prefix)
+	}
+
+	return id, nil
+}
+
+// importServiceNetworkAssociation returns an importer for service network associations
+// of the type identified by prefix, accepting either an association ID or ARN.
+func importServiceNetworkAssociation(prefix string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, err := serviceNetworkAssociationIDFromIDOrARN(d.Id(), prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 // suppressEquivalentIDOrARN provides custom difference suppression
 // for strings that represent equal resource IDs or ARNs.
 func suppressEquivalentIDOrARN(_, old, new string, _ *schema.ResourceData) bool {
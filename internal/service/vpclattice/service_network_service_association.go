@@ -36,7 +36,7 @@ func resourceServiceNetworkServiceAssociation() *schema.Resource {
 		DeleteWithoutTimeout: resourceServiceNetworkServiceAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importServiceNetworkAssociation(serviceNetworkServiceAssociationIDPrefix),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -49,6 +49,63 @@ func TestIDFromIDOrARN(t *testing.T) {
 	}
 }
 
+func TestServiceNetworkAssociationIDFromIDOrARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		idOrARN     string
+		prefix      string
+		want        string
+		expectError bool
+	}{
+		{
+			idOrARN:     "",
+			prefix:      tfvpclattice.ServiceNetworkVPCAssociationIDPrefix,
+			expectError: true,
+		},
+		{
+			idOrARN: "snva-1234567890abcdefg",
+			prefix:  tfvpclattice.ServiceNetworkVPCAssociationIDPrefix,
+			want:    "snva-1234567890abcdefg",
+		},
+		{
+			idOrARN: "arn:aws:vpc-lattice:us-east-1:123456789012:servicenetworkvpcassociation/snva-1234567890abcdefg", //lintignore:AWSAT003,AWSAT005
+			prefix:  tfvpclattice.ServiceNetworkVPCAssociationIDPrefix,
+			want:    "snva-1234567890abcdefg",
+		},
+		{
+			idOrARN:     "snsa-1234567890abcdefg",
+			prefix:      tfvpclattice.ServiceNetworkVPCAssociationIDPrefix,
+			expectError: true,
+		},
+		{
+			idOrARN: "arn:aws:vpc-lattice:us-east-1:123456789012:servicenetworkserviceassociation/snsa-1234567890abcdefg", //lintignore:AWSAT003,AWSAT005
+			prefix:  tfvpclattice.ServiceNetworkServiceAssociationIDPrefix,
+			want:    "snsa-1234567890abcdefg",
+		},
+		{
+			idOrARN:     "snva-1234567890abcdefg",
+			prefix:      tfvpclattice.ServiceNetworkServiceAssociationIDPrefix,
+			expectError: true,
+		},
+	}
+	for _, testCase := range testCases {
+		got, err := tfvpclattice.ServiceNetworkAssociationIDFromIDOrARN(testCase.idOrARN, testCase.prefix)
+
+		if err == nil && testCase.expectError {
+			t.Errorf("ServiceNetworkAssociationIDFromIDOrARN(%q, %q): expected an error", testCase.idOrARN, testCase.prefix)
+		}
+
+		if err != nil && !testCase.expectError {
+			t.Errorf("ServiceNetworkAssociationIDFromIDOrARN(%q, %q): unexpected error: %s", testCase.idOrARN, testCase.prefix, err)
+		}
+
+		if got != testCase.want {
+			t.Errorf("ServiceNetworkAssociationIDFromIDOrARN(%q, %q) = %v, want %v", testCase.idOrARN, testCase.prefix, got, testCase.want)
+		}
+	}
+}
+
 func TestSuppressEquivalentIDOrARN(t *testing.T) {
 	t.Parallel()
 
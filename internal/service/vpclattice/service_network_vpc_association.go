@@ -37,7 +37,7 @@ func resourceServiceNetworkVPCAssociation() *schema.Resource {
 		DeleteWithoutTimeout: resourceServiceNetworkVPCAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importServiceNetworkAssociation(serviceNetworkVPCAssociationIDPrefix),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
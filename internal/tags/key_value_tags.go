@@ -41,6 +41,11 @@ type IgnoreConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// RequiredConfig contains tag keys that must be present on all taggable resources.
+type RequiredConfig struct {
+	Keys KeyValueTags
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
@@ -86,6 +91,26 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// Missing returns the sorted list of required tag keys
+// that are not present in the tags passed in as an argument.
+func (rc *RequiredConfig) Missing(tags KeyValueTags) []string {
+	if rc == nil {
+		return nil
+	}
+
+	var missing []string
+
+	for k := range rc.Keys {
+		if _, ok := tags[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+
+	sort.Strings(missing)
+
+	return missing
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
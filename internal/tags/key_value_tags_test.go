@@ -317,6 +317,90 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigMissing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		want           []string
+	}{
+		{
+			name:           "no config",
+			requiredConfig: nil,
+			tags:           New(ctx, map[string]string{}),
+			want:           nil,
+		},
+		{
+			name:           "empty config",
+			requiredConfig: &RequiredConfig{},
+			tags:           New(ctx, map[string]string{}),
+			want:           nil,
+		},
+		{
+			name: "all present",
+			requiredConfig: &RequiredConfig{
+				Keys: New(ctx, []string{
+					"key1",
+					"key2",
+				}),
+			},
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: nil,
+		},
+		{
+			name: "some missing",
+			requiredConfig: &RequiredConfig{
+				Keys: New(ctx, []string{
+					"key3",
+					"key1",
+					"key4",
+				}),
+			},
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: []string{"key3", "key4"},
+		},
+		{
+			name: "no tags",
+			requiredConfig: &RequiredConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+			},
+			tags: New(ctx, map[string]string{}),
+			want: []string{"key1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.requiredConfig.Missing(testCase.tags)
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %v; want %v", got, testCase.want)
+			}
+
+			for i := range got {
+				if got[i] != testCase.want[i] {
+					t.Errorf("got %v; want %v", got, testCase.want)
+				}
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -22,6 +22,8 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// Resources being created or having their tags changed are also checked
+// against any provider-level required tag keys.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
@@ -43,6 +45,14 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	if diff.Id() == "" || diff.HasChange("tags") {
+		requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
+
+		if missing := requiredTagsConfig.Missing(defaultTagsConfig.MergeTags(resourceTags)); len(missing) > 0 {
+			return fmt.Errorf("missing required tags: %s", strings.Join(missing, ", "))
+		}
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
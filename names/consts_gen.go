@@ -50,6 +50,7 @@ const (
 	CodeCommit                   = "codecommit"
 	CodeGuruProfiler             = "codeguruprofiler"
 	CodeGuruReviewer             = "codegurureviewer"
+	CodeGuruSecurity             = "codegurusecurity"
 	CodePipeline                 = "codepipeline"
 	CodeStarConnections          = "codestarconnections"
 	CodeStarNotifications        = "codestarnotifications"
@@ -93,6 +94,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -112,6 +114,7 @@ const (
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IVSChat                      = "ivschat"
+	IVSRealTime                  = "ivsrealtime"
 	IdentityStore                = "identitystore"
 	ImageBuilder                 = "imagebuilder"
 	Inspector                    = "inspector"
@@ -217,6 +220,7 @@ const (
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
@@ -282,6 +286,7 @@ const (
 	CodeCommitServiceID                   = "CodeCommit"
 	CodeGuruProfilerServiceID             = "CodeGuruProfiler"
 	CodeGuruReviewerServiceID             = "CodeGuru Reviewer"
+	CodeGuruSecurityServiceID             = "CodeGuru Security"
 	CodePipelineServiceID                 = "CodePipeline"
 	CodeStarConnectionsServiceID          = "CodeStar connections"
 	CodeStarNotificationsServiceID        = "codestar notifications"
@@ -325,6 +330,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -344,6 +350,7 @@ const (
 	IAMServiceID                          = "IAM"
 	IVSServiceID                          = "ivs"
 	IVSChatServiceID                      = "ivschat"
+	IVSRealTimeServiceID                  = "IVS RealTime"
 	IdentityStoreServiceID                = "identitystore"
 	ImageBuilderServiceID                 = "imagebuilder"
 	InspectorServiceID                    = "Inspector"
@@ -449,6 +456,7 @@ const (
 	SimpleDBServiceID                     = "SimpleDB"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
+	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"